@@ -1,7 +1,11 @@
 Feature: Delete workspaces via REST API
 
-  @skip
   Scenario: users can delete owned workspaces
+    Given An user is onboarded
+    And   The user requests a new private workspace
+    When  The user deletes the workspace
+    Then  The workspace and its Space are deleted
 
-  @skip
   Scenario: users cannot delete default workspace
+    Given An user is onboarded
+    Then  The user can not delete their default workspace
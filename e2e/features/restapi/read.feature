@@ -18,16 +18,34 @@ Feature: Read workspaces via REST API
     When  The user requests their default workspace
     Then  The user retrieves their default workspace
   
-  @skip
   Scenario: users can see just their workspaces, the ones shared with them, and the publicly visibile ones
+    Given An user is onboarded
+    And   Default workspace is created for them
+    And   Another user owns a community workspace
+    And   Another user shares a private workspace with them
+    When  The user requests the list of workspaces
+    Then  The user retrieves a list of workspaces containing the default, the shared, and the community ones
 
-  @skip
   Scenario: users can fetch just their own workspaces
+    Given An user is onboarded
+    And   Default workspace is created for them
+    And   Another user owns a community workspace
+    And   Another user shares a private workspace with them
+    When  The user requests the list of their own workspaces
+    Then  The user retrieves a list of workspaces containing just the default one
 
-  @skip
   Scenario: users can fetch just the workspaces shared with them
+    Given An user is onboarded
+    And   Default workspace is created for them
+    And   Another user owns a community workspace
+    And   Another user shares a private workspace with them
+    When  The user requests the list of the workspaces shared with them
+    Then  The user retrieves a list of workspaces containing just the shared one
 
-  @skip
   Scenario: users can fetch just the public workspaces
-
-
+    Given An user is onboarded
+    And   Default workspace is created for them
+    And   Another user owns a community workspace
+    And   Another user shares a private workspace with them
+    When  The user requests the list of the community workspaces
+    Then  The user retrieves a list of workspaces containing just the community one
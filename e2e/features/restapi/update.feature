@@ -6,9 +6,11 @@ Feature: Update workspaces via REST API
     When  The user changes workspace visibility to "community"
     Then  The workspace visibility is updated to "community" 
 
-  @skip
   Scenario: users can not update visibility of non-owned workspaces
-  
+    Given An user is onboarded
+    And   Another user owns a community workspace
+    Then  The user can not change the community workspace visibility to "private"
+
   Scenario: visibility changes from private to community
     Given A private workspace exists for an user
     When  The owner changes visibility to community
@@ -5,7 +5,7 @@ go 1.22.2
 require (
 	github.com/codeready-toolchain/api v0.0.0-20240708122235-0af5a9a178bb
 	github.com/cucumber/godog v0.14.1
-	github.com/golang-jwt/jwt/v5 v5.2.1
+	github.com/golang-jwt/jwt/v5 v5.2.2
 	github.com/konflux-workspaces/workspaces/operator v0.0.0-00010101000000-000000000000
 	github.com/konflux-workspaces/workspaces/server v0.0.0-00010101000000-000000000000
 	github.com/spf13/pflag v1.0.5
@@ -65,7 +65,7 @@ require (
 	golang.org/x/sys v0.24.0 // indirect
 	golang.org/x/term v0.23.0 // indirect
 	golang.org/x/text v0.17.0 // indirect
-	golang.org/x/time v0.5.0 // indirect
+	golang.org/x/time v0.9.0 // indirect
 	gomodules.xyz/jsonpatch/v2 v2.4.0 // indirect
 	google.golang.org/protobuf v1.34.2 // indirect
 	gopkg.in/inf.v0 v0.9.1 // indirect
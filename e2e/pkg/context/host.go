@@ -25,6 +25,8 @@ const (
 	keyUserWorkspace             ContextKey = "user-workspace"
 	keyUser                      ContextKey = "default-user"
 	keyUserWorkspaces            ContextKey = "workspaces"
+	keyCommunityWorkspace        ContextKey = "community-internal-workspace"
+	keySharedWorkspace           ContextKey = "shared-internal-workspace"
 
 	msgNotFound string = "key not found in context"
 )
@@ -87,6 +89,24 @@ func LookupInternalWorkspace(ctx context.Context) (workspacesv1alpha1.InternalWo
 	return lookup[workspacesv1alpha1.InternalWorkspace](ctx, keyInternalWorkspace)
 }
 
+// Community Workspace owned by another user
+func InjectCommunityInternalWorkspace(ctx context.Context, w workspacesv1alpha1.InternalWorkspace) context.Context {
+	return context.WithValue(ctx, keyCommunityWorkspace, w)
+}
+
+func RetrieveCommunityInternalWorkspace(ctx context.Context) workspacesv1alpha1.InternalWorkspace {
+	return get[workspacesv1alpha1.InternalWorkspace](ctx, keyCommunityWorkspace)
+}
+
+// Private Workspace owned by another user and shared with the default user
+func InjectSharedInternalWorkspace(ctx context.Context, w workspacesv1alpha1.InternalWorkspace) context.Context {
+	return context.WithValue(ctx, keySharedWorkspace, w)
+}
+
+func RetrieveSharedInternalWorkspace(ctx context.Context) workspacesv1alpha1.InternalWorkspace {
+	return get[workspacesv1alpha1.InternalWorkspace](ctx, keySharedWorkspace)
+}
+
 // Default User
 func InjectUser(ctx context.Context, u toolchainv1alpha1.UserSignup) context.Context {
 	return context.WithValue(ctx, keyUser, u)
@@ -11,12 +11,24 @@ func RegisterSteps(ctx *godog.ScenarioContext) {
 	ctx.When(`^An user onboards$`, whenAnUserOnboards)
 
 	ctx.When(`^The user requests the list of workspaces$`, whenUserRequestsTheListOfWorkspaces)
+	ctx.When(`^The user requests the list of their own workspaces$`, whenUserRequestsTheListOfTheirOwnWorkspaces)
+	ctx.When(`^The user requests the list of the workspaces shared with them$`, whenUserRequestsTheListOfTheWorkspacesSharedWithThem)
+	ctx.When(`^The user requests the list of the community workspaces$`, whenUserRequestsTheListOfTheCommunityWorkspaces)
 	ctx.When(`^The user requests their default workspace$`, whenUserRequestsTheirDefaultWorkspace)
+	ctx.When(`^The user requests a new private workspace$`, whenUserRequestsANewPrivateWorkspace)
+	ctx.When(`^The user requests a new community workspace$`, whenUserRequestsANewCommunityWorkspace)
 
 	ctx.When(`^The user changes workspace visibility to "([^"]*)"$`, whenTheUserChangesWorkspaceVisibilityTo)
 	ctx.When(`^The user patches workspace visibility to "([^"]*)"$`, whenTheUserPatchesWorkspaceVisibilityTo)
 
+	ctx.When(`^The user deletes the workspace$`, whenTheUserDeletesTheWorkspace)
+
 	// then
 	ctx.Then(`^The user retrieves a list of workspaces containing just the default one$`, thenTheUserRetrievesAListOfWorkspacesContainingJustTheDefaultOne)
+	ctx.Then(`^The user retrieves a list of workspaces containing just the shared one$`, thenTheUserRetrievesAListOfWorkspacesContainingJustTheSharedOne)
+	ctx.Then(`^The user retrieves a list of workspaces containing just the community one$`, thenTheUserRetrievesAListOfWorkspacesContainingJustTheCommunityOne)
+	ctx.Then(`^The user retrieves a list of workspaces containing the default, the shared, and the community ones$`, thenTheUserRetrievesAListOfWorkspacesContainingTheDefaultTheSharedAndTheCommunityOnes)
 	ctx.Then(`^The user retrieves their default workspace$`, thenTheUserRetrievesTheirDefaultWorkspace)
+	ctx.Then(`^The user can not delete their default workspace$`, thenTheUserCanNotDeleteTheirDefaultWorkspace)
+	ctx.Then(`^The user can not change the community workspace visibility to "([^"]*)"$`, thenTheUserCanNotChangeTheCommunityWorkspaceVisibilityTo)
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/konflux-workspaces/workspaces/e2e/pkg/poll"
 
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
 )
 
 const DefaultUserName string = "default-user"
@@ -100,3 +101,29 @@ func approvedUserSignup(name, namespace, email, sub, preferredUsername string) t
 		},
 	}
 }
+
+// getInternalWorkspaceByDisplayName waits for the InternalWorkspace with the
+// provided DisplayName and owned by the provided user to be reconciled.
+func getInternalWorkspaceByDisplayName(ctx context.Context, owner, displayName string) (*workspacesv1alpha1.InternalWorkspace, error) {
+	cli := tcontext.RetrieveHostClient(ctx)
+	ns := tcontext.RetrieveWorkspacesNamespace(ctx)
+
+	var w *workspacesv1alpha1.InternalWorkspace
+	if err := poll.WaitForConditionImmediately(ctx, func(ctx context.Context) (done bool, err error) {
+		ww := workspacesv1alpha1.InternalWorkspaceList{}
+		if err := cli.Client.List(ctx, &ww, client.InNamespace(ns)); err != nil {
+			return false, err
+		}
+
+		for _, lw := range ww.Items {
+			if lw.Spec.DisplayName == displayName && lw.Status.Owner.Username == owner {
+				w = &lw
+				return true, nil
+			}
+		}
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("error retrieving workspace %s owned by %s: %w", displayName, owner, err)
+	}
+	return w, nil
+}
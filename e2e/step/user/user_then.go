@@ -5,9 +5,16 @@ import (
 	"errors"
 	"fmt"
 
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	tcontext "github.com/konflux-workspaces/workspaces/e2e/pkg/context"
+	wrest "github.com/konflux-workspaces/workspaces/e2e/pkg/rest"
 
 	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
 )
 
 func thenTheUserRetrievesTheirDefaultWorkspace(ctx context.Context) (context.Context, error) {
@@ -35,3 +42,99 @@ func thenTheUserRetrievesAListOfWorkspacesContainingJustTheDefaultOne(ctx contex
 	}
 	return ctx, nil
 }
+
+func thenTheUserRetrievesAListOfWorkspacesContainingJustTheSharedOne(ctx context.Context) (context.Context, error) {
+	return ctx, checkScenarioWorkspaces(ctx, tcontext.RetrieveSharedInternalWorkspace(ctx))
+}
+
+func thenTheUserRetrievesAListOfWorkspacesContainingJustTheCommunityOne(ctx context.Context) (context.Context, error) {
+	return ctx, checkScenarioWorkspaces(ctx, tcontext.RetrieveCommunityInternalWorkspace(ctx))
+}
+
+func thenTheUserRetrievesAListOfWorkspacesContainingTheDefaultTheSharedAndTheCommunityOnes(ctx context.Context) (context.Context, error) {
+	return ctx, checkScenarioWorkspaces(ctx,
+		tcontext.RetrieveInternalWorkspace(ctx),
+		tcontext.RetrieveSharedInternalWorkspace(ctx),
+		tcontext.RetrieveCommunityInternalWorkspace(ctx),
+	)
+}
+
+// checkScenarioWorkspaces checks that the workspaces retrieved by the user and owned by users of the current scenario
+// are exactly the expected ones. Workspaces owned by users of other scenarios are ignored.
+func checkScenarioWorkspaces(ctx context.Context, expected ...workspacesv1alpha1.InternalWorkspace) error {
+	cli := tcontext.RetrieveHostClient(ctx)
+	ww := tcontext.RetrieveUserWorkspaces(ctx)
+
+	ek := sets.New[string]()
+	for _, w := range expected {
+		ek.Insert(fmt.Sprintf("%s/%s", w.Status.Owner.Username, w.Spec.DisplayName))
+	}
+
+	fk := sets.New[string]()
+	for _, w := range ww.Items {
+		if cli.HasScenarioPrefix(w.Namespace) {
+			fk.Insert(fmt.Sprintf("%s/%s", w.Namespace, w.Name))
+		}
+	}
+
+	if !ek.Equal(fk) {
+		return fmt.Errorf("expected workspaces %v, found %v", sets.List(ek), sets.List(fk))
+	}
+	return nil
+}
+
+func thenTheUserCanNotDeleteTheirDefaultWorkspace(ctx context.Context) (context.Context, error) {
+	cli, err := wrest.BuildWorkspacesClient(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	u := tcontext.RetrieveUser(ctx)
+	w := restworkspacesv1alpha1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: u.Status.CompliantUsername,
+			Name:      workspacesv1alpha1.DisplayNameDefaultWorkspace,
+		},
+	}
+	switch err := cli.Delete(ctx, &w, &client.DeleteOptions{}); {
+	case err == nil:
+		return ctx, fmt.Errorf("expected deletion of default workspace to be refused, but it succeeded")
+	case !kerrors.IsForbidden(err):
+		return ctx, fmt.Errorf("expected deletion of default workspace to be forbidden, found: %w", err)
+	default:
+		return ctx, nil
+	}
+}
+
+func thenTheUserCanNotChangeTheCommunityWorkspaceVisibilityTo(ctx context.Context, visibility string) (context.Context, error) {
+	cli, err := wrest.BuildWorkspacesClient(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	// retrieve the community workspace as the user
+	iw := tcontext.RetrieveCommunityInternalWorkspace(ctx)
+	w := restworkspacesv1alpha1.Workspace{}
+	k := client.ObjectKey{Namespace: iw.Status.Owner.Username, Name: iw.Spec.DisplayName}
+	if err := cli.Get(ctx, k, &w); err != nil {
+		return ctx, fmt.Errorf("error retrieving community workspace %s: %w", k, err)
+	}
+
+	w.Spec.Visibility = restworkspacesv1alpha1.WorkspaceVisibility(visibility)
+	switch err := cli.Update(ctx, &w, &client.UpdateOptions{}); {
+	case err == nil:
+		return ctx, fmt.Errorf("expected update of non-owned workspace to be refused, but it succeeded")
+	case !kerrors.IsForbidden(err):
+		return ctx, fmt.Errorf("expected update of non-owned workspace to be forbidden, found: %w", err)
+	}
+
+	// ensure the visibility did not change
+	hcli := tcontext.RetrieveHostClient(ctx)
+	if err := hcli.Get(ctx, client.ObjectKeyFromObject(&iw), &iw); err != nil {
+		return ctx, err
+	}
+	if iw.Spec.Visibility != workspacesv1alpha1.InternalWorkspaceVisibilityCommunity {
+		return ctx, fmt.Errorf("expected workspace visibility to be %s, found %s", workspacesv1alpha1.InternalWorkspaceVisibilityCommunity, iw.Spec.Visibility)
+	}
+	return ctx, nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -25,13 +27,40 @@ func whenAnUserOnboards(ctx context.Context) (context.Context, error) {
 }
 
 func whenUserRequestsTheListOfWorkspaces(ctx context.Context) (context.Context, error) {
+	return userRequestsTheListOfWorkspaces(ctx, &client.ListOptions{})
+}
+
+func whenUserRequestsTheListOfTheirOwnWorkspaces(ctx context.Context) (context.Context, error) {
+	u := tcontext.RetrieveUser(ctx)
+	return userRequestsTheListOfWorkspaces(ctx, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("metadata.namespace", u.Status.CompliantUsername),
+	})
+}
+
+func whenUserRequestsTheListOfTheWorkspacesSharedWithThem(ctx context.Context) (context.Context, error) {
+	u := tcontext.RetrieveUser(ctx)
+	return userRequestsTheListOfWorkspaces(ctx, &client.ListOptions{
+		FieldSelector: fields.AndSelectors(
+			fields.OneTermNotEqualSelector("metadata.namespace", u.Status.CompliantUsername),
+			fields.OneTermEqualSelector("spec.visibility", string(restworkspacesv1alpha1.WorkspaceVisibilityPrivate)),
+		),
+	})
+}
+
+func whenUserRequestsTheListOfTheCommunityWorkspaces(ctx context.Context) (context.Context, error) {
+	return userRequestsTheListOfWorkspaces(ctx, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.visibility", string(restworkspacesv1alpha1.WorkspaceVisibilityCommunity)),
+	})
+}
+
+func userRequestsTheListOfWorkspaces(ctx context.Context, opts *client.ListOptions) (context.Context, error) {
 	c, err := wrest.BuildWorkspacesClient(ctx)
 	if err != nil {
 		return ctx, err
 	}
 
 	ww := restworkspacesv1alpha1.WorkspaceList{}
-	if err := c.List(ctx, &ww, &client.ListOptions{}); err != nil {
+	if err := c.List(ctx, &ww, opts); err != nil {
 		u := tcontext.RetrieveUser(ctx)
 		k := tcontext.RetrieveUnauthKubeconfig(ctx)
 		return ctx, fmt.Errorf("error retrieving workspaces from host %s as user %s: %w", k.Host, u.Status.CompliantUsername, err)
@@ -56,6 +85,48 @@ func whenUserRequestsTheirDefaultWorkspace(ctx context.Context) (context.Context
 	return tcontext.InjectUserWorkspace(ctx, w), nil
 }
 
+func whenUserRequestsANewPrivateWorkspace(ctx context.Context) (context.Context, error) {
+	return userRequestsANewWorkspace(ctx, restworkspacesv1alpha1.WorkspaceVisibilityPrivate)
+}
+
+func whenUserRequestsANewCommunityWorkspace(ctx context.Context) (context.Context, error) {
+	return userRequestsANewWorkspace(ctx, restworkspacesv1alpha1.WorkspaceVisibilityCommunity)
+}
+
+func userRequestsANewWorkspace(ctx context.Context, visibility restworkspacesv1alpha1.WorkspaceVisibility) (context.Context, error) {
+	c, err := wrest.BuildWorkspacesClient(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	// create the workspace via REST API
+	u := tcontext.RetrieveUser(ctx)
+	hc := tcontext.RetrieveHostClient(ctx)
+	w := restworkspacesv1alpha1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      hc.EnsurePrefix("workspace"),
+			Namespace: u.Status.CompliantUsername,
+		},
+		Spec: restworkspacesv1alpha1.WorkspaceSpec{
+			Visibility: visibility,
+		},
+	}
+	if err := c.Create(ctx, &w, &client.CreateOptions{}); err != nil {
+		k := tcontext.RetrieveUnauthKubeconfig(ctx)
+		return ctx, fmt.Errorf("error creating workspace %s/%s on host %s as user %s: %w", w.Namespace, w.Name, k.Host, u.Status.CompliantUsername, err)
+	}
+
+	// retrieve the InternalWorkspace backing the new workspace
+	iw, err := getInternalWorkspaceByDisplayName(ctx, u.Status.CompliantUsername, w.Name)
+	if err != nil {
+		return ctx, err
+	}
+
+	ctx = tcontext.InjectUserWorkspace(ctx, w)
+	ctx = tcontext.InjectInternalWorkspace(ctx, *iw)
+	return ctx, nil
+}
+
 func whenTheUserPatchesWorkspaceVisibilityTo(ctx context.Context, visibility string) (context.Context, error) {
 	cli, err := wrest.BuildWorkspacesClient(ctx)
 	if err != nil {
@@ -110,3 +181,16 @@ func whenTheUserChangesWorkspaceVisibilityTo(ctx context.Context, visibility str
 	}
 	return tcontext.InjectUserWorkspace(ctx, *w), nil
 }
+
+func whenTheUserDeletesTheWorkspace(ctx context.Context) (context.Context, error) {
+	cli, err := wrest.BuildWorkspacesClient(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	w := tcontext.RetrieveUserWorkspace(ctx)
+	if err := cli.Delete(ctx, &w, &client.DeleteOptions{}); err != nil {
+		return ctx, fmt.Errorf("error deleting workspace %s/%s: %w", w.Namespace, w.Name, err)
+	}
+	return ctx, nil
+}
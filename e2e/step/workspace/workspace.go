@@ -6,6 +6,8 @@ func RegisterSteps(ctx *godog.ScenarioContext) {
 	// given
 	ctx.Given(`^A community workspace exists for an user$`, givenACommunityWorkspaceExists)
 	ctx.Given(`^A private workspace exists for an user$`, givenAPrivateWorkspaceExists)
+	ctx.Given(`^Another user owns a community workspace$`, givenAnotherUserOwnsACommunityWorkspace)
+	ctx.Given(`^Another user shares a private workspace with them$`, givenAnotherUserSharesAPrivateWorkspaceWithThem)
 
 	ctx.Given(`^Default workspace is created for them$`, givenDefaultWorkspaceIsCreatedForThem)
 	ctx.Given(`^Workspace\'s Space has cluster URL set$`, givenWorkspaceHasClusterURLSet)
@@ -26,6 +28,7 @@ func RegisterSteps(ctx *godog.ScenarioContext) {
 	ctx.Then(`^The workspace visibility is set to "([^"]*)"$`, thenTheWorkspaceVisibilityIsSetTo)
 
 	ctx.Then(`^The workspace visibility is updated to "([^"]*)"$`, thenTheWorkspaceVisibilityIsUpdatedTo)
+	ctx.Then(`^The workspace and its Space are deleted$`, thenTheWorkspaceAndItsSpaceAreDeleted)
 	ctx.Then(`^Workspace has cluster URL in status$`, thenDefaultWorkspaceHasClusterURLInStatus)
 	ctx.Then(`^Workspace has no cluster URL in status$`, thenDefaultWorkspaceHasNoClusterURLInStatus)
 }
@@ -87,3 +87,48 @@ func givenACommunityWorkspaceExists(ctx context.Context) (context.Context, error
 
 	return ctx, nil
 }
+
+func givenAnotherUserOwnsACommunityWorkspace(ctx context.Context) (context.Context, error) {
+	cli := tcontext.RetrieveHostClient(ctx)
+	kns := tcontext.RetrieveKubespaceNamespace(ctx)
+
+	_, w, err := createUserSignupAndWaitForWorkspace(ctx, cli, kns, "community-owner")
+	if err != nil {
+		return ctx, err
+	}
+
+	w.Spec.Visibility = workspacesv1alpha1.InternalWorkspaceVisibilityCommunity
+	if err := cli.Update(ctx, w); err != nil {
+		return ctx, err
+	}
+
+	if err := workspaceIsReadableForEveryone(ctx, cli, kns, w.Name); err != nil {
+		return ctx, err
+	}
+
+	return tcontext.InjectCommunityInternalWorkspace(ctx, *w), nil
+}
+
+func givenAnotherUserSharesAPrivateWorkspaceWithThem(ctx context.Context) (context.Context, error) {
+	cli := tcontext.RetrieveHostClient(ctx)
+	kns := tcontext.RetrieveKubespaceNamespace(ctx)
+	u := tcontext.RetrieveUser(ctx)
+
+	_, w, err := createUserSignupAndWaitForWorkspace(ctx, cli, kns, "sharing-owner")
+	if err != nil {
+		return ctx, err
+	}
+
+	w.Spec.Members = []workspacesv1alpha1.InternalWorkspaceMember{
+		{Username: u.Status.CompliantUsername, Role: workspacesv1alpha1.InternalWorkspaceRoleViewer},
+	}
+	if err := cli.Update(ctx, w); err != nil {
+		return ctx, err
+	}
+
+	if err := workspaceIsSharedWith(ctx, cli, kns, w.Name, u.Status.CompliantUsername); err != nil {
+		return ctx, err
+	}
+
+	return tcontext.InjectSharedInternalWorkspace(ctx, *w), nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -170,6 +171,24 @@ func workspaceIsReadableForEveryone(ctx context.Context, cli cli.Cli, namespace,
 	}
 }
 
+func workspaceIsSharedWith(ctx context.Context, cli cli.Cli, namespace, name, user string) error {
+	sb := &toolchainv1alpha1.SpaceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-member-%s", name, user),
+			Namespace: namespace,
+		},
+	}
+	if err := poll.WaitForConditionImmediately(ctx, func(ctx context.Context) (done bool, err error) {
+		if err := cli.Get(ctx, client.ObjectKeyFromObject(sb), sb); err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+		return true, nil
+	}); err != nil {
+		return fmt.Errorf("error waiting for space binding %s/%s to be created: %w", sb.Namespace, sb.Name, err)
+	}
+	return nil
+}
+
 func thenTheWorkspaceVisibilityIsUpdatedTo(ctx context.Context, visibility string) error {
 	w := tcontext.RetrieveInternalWorkspace(ctx)
 	cli := tcontext.RetrieveHostClient(ctx)
@@ -186,3 +205,21 @@ func thenTheWorkspaceVisibilityIsUpdatedTo(ctx context.Context, visibility strin
 		return true, nil
 	})
 }
+
+func thenTheWorkspaceAndItsSpaceAreDeleted(ctx context.Context) error {
+	cli := tcontext.RetrieveHostClient(ctx)
+	w := tcontext.RetrieveInternalWorkspace(ctx)
+	ns := tcontext.RetrieveKubespaceNamespace(ctx)
+
+	return poll.WaitForConditionImmediately(ctx, func(ctx context.Context) (done bool, err error) {
+		if err := cli.Get(ctx, client.ObjectKeyFromObject(&w), &workspacesv1alpha1.InternalWorkspace{}); !kerrors.IsNotFound(err) {
+			return false, client.IgnoreNotFound(err)
+		}
+
+		sk := types.NamespacedName{Namespace: ns, Name: w.Name}
+		if err := cli.Get(ctx, sk, &toolchainv1alpha1.Space{}); !kerrors.IsNotFound(err) {
+			return false, client.IgnoreNotFound(err)
+		}
+		return true, nil
+	})
+}
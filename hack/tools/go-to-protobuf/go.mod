@@ -0,0 +1,18 @@
+module github.com/konflux-workspaces/workspaces/hack/tools/go-to-protobuf
+
+go 1.22.0
+
+require (
+	golang.org/x/tools v0.26.0
+	k8s.io/code-generator v0.31.1
+)
+
+require (
+	github.com/go-logr/logr v1.4.2 // indirect
+	github.com/gogo/protobuf v1.3.2 // indirect
+	github.com/spf13/pflag v1.0.5 // indirect
+	golang.org/x/mod v0.21.0 // indirect
+	golang.org/x/sync v0.8.0 // indirect
+	k8s.io/gengo/v2 v2.0.0-20240228010128-51d4e06bde70 // indirect
+	k8s.io/klog/v2 v2.130.1 // indirect
+)
@@ -22,6 +22,9 @@ import (
 
 type InternalWorkspaceVisibility string
 
+// InternalWorkspaceRole is the role granted to a member on the InternalWorkspace's Space
+type InternalWorkspaceRole string
+
 const (
 	// PublicViewerName the name of the KubeSaw's PublicViewer user
 	PublicViewerName string = "kubesaw-authenticated"
@@ -33,19 +36,114 @@ const (
 	// InternalWorkspaceVisibilityPrivate Private value for InternalWorkspaces visibility
 	InternalWorkspaceVisibilityPrivate InternalWorkspaceVisibility = "private"
 
+	// InternalWorkspaceRoleViewer Viewer role for InternalWorkspaces members
+	InternalWorkspaceRoleViewer InternalWorkspaceRole = "viewer"
+	// InternalWorkspaceRoleContributor Contributor role for InternalWorkspaces members
+	InternalWorkspaceRoleContributor InternalWorkspaceRole = "contributor"
+	// InternalWorkspaceRoleMaintainer Maintainer role for InternalWorkspaces members
+	InternalWorkspaceRoleMaintainer InternalWorkspaceRole = "maintainer"
+	// InternalWorkspaceRoleAdmin Admin role for InternalWorkspaces members
+	InternalWorkspaceRoleAdmin InternalWorkspaceRole = "admin"
+
 	// LabelInternalDomain domain for internal labels
 	LabelInternalDomain string = "internal.workspaces.konflux-ci.dev/"
+	// LabelMember label set on the SpaceBindings granting access to the members of an InternalWorkspace
+	LabelMember string = LabelInternalDomain + "member"
+
+	// FinalizerCleanup finalizer used to clean up the resources backing an InternalWorkspace
+	FinalizerCleanup string = "workspaces.konflux-ci.dev/cleanup"
 
-	// ConditionTypeReady indicates whether an InternalWorkspace is Ready
+	// ConditionTypeReady indicates whether an InternalWorkspace is Ready, i.e. whether the
+	// DisplayNameUnique, OwnerResolved, SpaceProvisioned, and VisibilityApplied conditions are all True
 	ConditionTypeReady string = "Ready"
 	// ConditionReasonEverythingFine indicates "everything is fine"
 	ConditionReasonEverythingFine string = "EverythingFine"
+	// ConditionReasonReconciling means that not all the conditions Ready aggregates
+	// have been evaluated yet
+	ConditionReasonReconciling string = "Reconciling"
+
+	// ConditionTypeDisplayNameUnique indicates whether the InternalWorkspace is the only one
+	// of its owner with its DisplayName
+	ConditionTypeDisplayNameUnique string = "DisplayNameUnique"
+	// ConditionReasonDisplayNameUnique means that no other InternalWorkspace of the owner
+	// has the same DisplayName
+	ConditionReasonDisplayNameUnique string = "DisplayNameUnique"
+	// ConditionReasonDisplayNameConflict means that an older InternalWorkspace of the owner
+	// has the same DisplayName, so this one is not provisioned
+	ConditionReasonDisplayNameConflict string = "DisplayNameConflict"
+
+	// ConditionTypeOwnerResolved indicates whether the owner's UserSignup of an InternalWorkspace has been found
+	ConditionTypeOwnerResolved string = "OwnerResolved"
+	// ConditionReasonOwnerResolved means that the UserSignup for the InternalWorkspace
+	// was found
+	ConditionReasonOwnerResolved string = "OwnerResolved"
 	// ConditionReasonOwnerNotFound means that the UserSignup for the InternalWorkspace
 	// was not found
 	ConditionReasonOwnerNotFound string = "OwnerNotFound"
+
+	// ConditionTypeSpaceProvisioned indicates whether the Space of an InternalWorkspace exists
+	ConditionTypeSpaceProvisioned string = "SpaceProvisioned"
+	// ConditionReasonSpaceProvisioned means that the Space for the InternalWorkspace
+	// exists
+	ConditionReasonSpaceProvisioned string = "SpaceProvisioned"
 	// ConditionReasonSpaceNotFound means that the Space for the InternalWorkspace
 	// was not found
 	ConditionReasonSpaceNotFound string = "SpaceNotFound"
+	// ConditionReasonSpaceProvisioningFailed means that the Space for the InternalWorkspace
+	// or the owner's SpaceBinding could not be created or updated
+	ConditionReasonSpaceProvisioningFailed string = "SpaceProvisioningFailed"
+
+	// ConditionTypeVisibilityApplied indicates whether the visibility of an InternalWorkspace has been applied
+	ConditionTypeVisibilityApplied string = "VisibilityApplied"
+	// ConditionReasonVisibilityApplied means that the community SpaceBinding
+	// matches the InternalWorkspace's visibility
+	ConditionReasonVisibilityApplied string = "VisibilityApplied"
+	// ConditionReasonVisibilityNotApplied means that the community SpaceBinding
+	// could not be created or deleted
+	ConditionReasonVisibilityNotApplied string = "VisibilityNotApplied"
+	// ConditionReasonInvalidVisibility means that the InternalWorkspace's visibility
+	// is not a valid value
+	ConditionReasonInvalidVisibility string = "InvalidVisibility"
+
+	// ConditionTypeOwnerActive indicates whether the owner of an InternalWorkspace is active
+	ConditionTypeOwnerActive string = "OwnerActive"
+	// ConditionReasonOwnerActive means that the owner's UserSignup is active
+	ConditionReasonOwnerActive string = "OwnerActive"
+	// ConditionReasonOwnerDeactivated means that the owner's UserSignup is deactivated
+	ConditionReasonOwnerDeactivated string = "OwnerDeactivated"
+	// ConditionReasonOwnerBanned means that the owner's UserSignup is banned
+	ConditionReasonOwnerBanned string = "OwnerBanned"
+)
+
+// Reasons of the Events recorded on InternalWorkspaces
+const (
+	// EventReasonOwnerResolved means that the owner's UserSignup has been found
+	EventReasonOwnerResolved string = "OwnerResolved"
+	// EventReasonOwnerNotFound means that the owner's UserSignup has not been found
+	EventReasonOwnerNotFound string = "OwnerNotFound"
+	// EventReasonOwnerUpdated means that the owner's information has been updated from their UserSignup
+	EventReasonOwnerUpdated string = "OwnerUpdated"
+	// EventReasonHomeWorkspaceCreated means that the home InternalWorkspace has been created for a user
+	EventReasonHomeWorkspaceCreated string = "HomeWorkspaceCreated"
+	// EventReasonSpaceCreated means that the Space has been created
+	EventReasonSpaceCreated string = "SpaceCreated"
+	// EventReasonSpaceNotFound means that the Space, previously provisioned, does not exist anymore
+	EventReasonSpaceNotFound string = "SpaceNotFound"
+	// EventReasonSpaceProvisioningFailed means that the Space or the owner's SpaceBinding
+	// could not be created or updated
+	EventReasonSpaceProvisioningFailed string = "SpaceProvisioningFailed"
+	// EventReasonSpaceBindingCreated means that a SpaceBinding has been created for the owner or a member
+	EventReasonSpaceBindingCreated string = "SpaceBindingCreated"
+	// EventReasonSpaceBindingDeleted means that the SpaceBinding of a previous owner or member has been deleted
+	EventReasonSpaceBindingDeleted string = "SpaceBindingDeleted"
+	// EventReasonMembersNotGranted means that the members' SpaceBindings could not be created or deleted
+	EventReasonMembersNotGranted string = "MembersNotGranted"
+	// EventReasonVisibilityChanged means that the community SpaceBinding has been created or deleted
+	EventReasonVisibilityChanged string = "VisibilityChanged"
+	// EventReasonDisplayNameConflict means that an older InternalWorkspace of the owner has the same DisplayName
+	EventReasonDisplayNameConflict string = "DisplayNameConflict"
+	// EventReasonVisibilityNotApplied means that the visibility could not be applied
+	EventReasonVisibilityNotApplied string = "VisibilityNotApplied"
 )
 
 // UserInfo contains information about a user identity
@@ -64,6 +162,23 @@ type JwtInfo struct {
 	Sub string `json:"sub"`
 }
 
+// InternalWorkspaceMember is a user the InternalWorkspace is shared with
+type InternalWorkspaceMember struct {
+	// Username is the KubeSaw's CompliantUsername of the member
+	//+required
+	Username string `json:"username"`
+	//+required
+	//+kubebuilder:validation:Enum:=viewer;contributor;maintainer;admin
+	Role InternalWorkspaceRole `json:"role"`
+}
+
+// InternalWorkspaceOwnershipTransfer is a proposal to transfer the ownership of an InternalWorkspace
+type InternalWorkspaceOwnershipTransfer struct {
+	// NewOwner is the KubeSaw's CompliantUsername of the user the ownership is proposed to
+	//+required
+	NewOwner string `json:"newOwner"`
+}
+
 // InternalWorkspaceSpec defines the desired state of Workspace
 type InternalWorkspaceSpec struct {
 	//+required
@@ -73,6 +188,15 @@ type InternalWorkspaceSpec struct {
 	Visibility InternalWorkspaceVisibility `json:"visibility"`
 	//+required
 	Owner UserInfo `json:"owner"`
+	// Members are the users the InternalWorkspace is shared with
+	//+optional
+	//+listType=map
+	//+listMapKey=username
+	Members []InternalWorkspaceMember `json:"members,omitempty"`
+	// OwnershipTransfer is the pending proposal to transfer the ownership of the InternalWorkspace.
+	// The ownership is transferred when the proposed owner accepts it.
+	//+optional
+	OwnershipTransfer *InternalWorkspaceOwnershipTransfer `json:"ownershipTransfer,omitempty"`
 }
 
 // SpaceInfo Information about a Space
@@ -104,6 +228,15 @@ type InternalWorkspaceStatus struct {
 	// Owner contains information on the owner
 	//+optional
 	Owner UserInfoStatus `json:"owner,omitempty"`
+
+	// ObservedGeneration is the generation of the InternalWorkspace's spec
+	// that was last applied by the operator
+	//+optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// FirstReadyTime is the time the InternalWorkspace first became Ready
+	//+optional
+	FirstReadyTime *metav1.Time `json:"firstReadyTime,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -30,7 +30,7 @@ func (in *InternalWorkspace) DeepCopyInto(out *InternalWorkspace) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -84,10 +84,50 @@ func (in *InternalWorkspaceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InternalWorkspaceMember) DeepCopyInto(out *InternalWorkspaceMember) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternalWorkspaceMember.
+func (in *InternalWorkspaceMember) DeepCopy() *InternalWorkspaceMember {
+	if in == nil {
+		return nil
+	}
+	out := new(InternalWorkspaceMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InternalWorkspaceOwnershipTransfer) DeepCopyInto(out *InternalWorkspaceOwnershipTransfer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternalWorkspaceOwnershipTransfer.
+func (in *InternalWorkspaceOwnershipTransfer) DeepCopy() *InternalWorkspaceOwnershipTransfer {
+	if in == nil {
+		return nil
+	}
+	out := new(InternalWorkspaceOwnershipTransfer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InternalWorkspaceSpec) DeepCopyInto(out *InternalWorkspaceSpec) {
 	*out = *in
 	out.Owner = in.Owner
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]InternalWorkspaceMember, len(*in))
+		copy(*out, *in)
+	}
+	if in.OwnershipTransfer != nil {
+		in, out := &in.OwnershipTransfer, &out.OwnershipTransfer
+		*out = new(InternalWorkspaceOwnershipTransfer)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternalWorkspaceSpec.
@@ -112,6 +152,10 @@ func (in *InternalWorkspaceStatus) DeepCopyInto(out *InternalWorkspaceStatus) {
 	}
 	out.Space = in.Space
 	out.Owner = in.Owner
+	if in.FirstReadyTime != nil {
+		in, out := &in.FirstReadyTime, &out.FirstReadyTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternalWorkspaceStatus.
@@ -59,8 +59,11 @@ func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
+	var ownerWorkspacesThreshold int
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
+	flag.IntVar(&ownerWorkspacesThreshold, "owner-workspaces-threshold", 10,
+		"The number of workspaces a user can own before being counted in the owners over threshold metric.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
@@ -116,6 +119,7 @@ func main() {
 	if err = (&controller.WorkspaceReconciler{
 		Client:              mgr.GetClient(),
 		Scheme:              mgr.GetScheme(),
+		Recorder:            mgr.GetEventRecorderFor("internalworkspace-controller"),
 		KubesawNamespace:    kns,
 		WorkspacesNamespace: wns,
 	}).SetupWithManager(mgr); err != nil {
@@ -125,6 +129,7 @@ func main() {
 	if err = (&controller.UserSignupReconciler{
 		Client:              mgr.GetClient(),
 		Scheme:              mgr.GetScheme(),
+		Recorder:            mgr.GetEventRecorderFor("usersignup-controller"),
 		WorkspacesNamespace: wns,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "UserSignup")
@@ -134,6 +139,8 @@ func main() {
 
 	toolchainStatusGauge := metrics.NewToolchainStatusGauge(mgr.GetClient(), kns)
 	toolchainStatusGauge.Register(context.Background())
+	metrics.NewComponentsCollector(mgr.GetClient(), kns).Register()
+	metrics.NewWorkspacesCollector(mgr.GetClient(), wns, ownerWorkspacesThreshold).Register()
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
@@ -0,0 +1,84 @@
+/*
+Copyright 2024 The Workspaces Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internalworkspace
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+)
+
+// IndexKeyUserSignupSub key for UserSignup's indexer on field for the user's Sub
+const IndexKeyUserSignupSub string = "spec.identityClaims.sub"
+
+// IndexUserSignupSub indexes UserSignups by the user's Sub
+func IndexUserSignupSub(o client.Object) []string {
+	u, ok := o.(*toolchainv1alpha1.UserSignup)
+	if !ok {
+		return nil
+	}
+
+	return []string{u.Spec.IdentityClaims.Sub}
+}
+
+// IndexKeyInternalWorkspaceOwnerSub key for InternalWorkspace's indexer on field for the owner's Sub
+const IndexKeyInternalWorkspaceOwnerSub string = "spec.owner.jwtInfo.sub"
+
+// IndexInternalWorkspaceOwnerSub indexes InternalWorkspaces by the owner's Sub
+func IndexInternalWorkspaceOwnerSub(o client.Object) []string {
+	w, ok := o.(*workspacesv1alpha1.InternalWorkspace)
+	if !ok {
+		return nil
+	}
+
+	return []string{w.Spec.Owner.JwtInfo.Sub}
+}
+
+// IndexKeyInternalWorkspaceOwnerUsername key for InternalWorkspace's indexer on field for the owner's Username
+const IndexKeyInternalWorkspaceOwnerUsername string = "status.owner.username"
+
+// IndexInternalWorkspaceOwnerUsername indexes InternalWorkspaces by the owner's Username.
+// InternalWorkspaces whose owner's Username has not been resolved yet are not indexed.
+func IndexInternalWorkspaceOwnerUsername(o client.Object) []string {
+	w, ok := o.(*workspacesv1alpha1.InternalWorkspace)
+	if !ok || w.Status.Owner.Username == "" {
+		return nil
+	}
+
+	return []string{w.Status.Owner.Username}
+}
+
+// IndexKeyInternalWorkspaceOwnerDisplayName key for InternalWorkspace's indexer on fields for the owner's Sub and the Display Name
+const IndexKeyInternalWorkspaceOwnerDisplayName string = "spec.owner.jwtInfo.sub,spec.displayName"
+
+// IndexInternalWorkspaceOwnerDisplayName indexes InternalWorkspaces by the owner's Sub and the Display Name
+func IndexInternalWorkspaceOwnerDisplayName(o client.Object) []string {
+	w, ok := o.(*workspacesv1alpha1.InternalWorkspace)
+	if !ok {
+		return nil
+	}
+
+	return []string{InternalWorkspaceOwnerDisplayNameIndexValue(w.Spec.Owner.JwtInfo.Sub, w.Spec.DisplayName)}
+}
+
+// InternalWorkspaceOwnerDisplayNameIndexValue returns the value indexed by IndexInternalWorkspaceOwnerDisplayName
+// for the given owner's Sub and Display Name
+func InternalWorkspaceOwnerDisplayNameIndexValue(sub, displayName string) string {
+	return sub + "/" + displayName
+}
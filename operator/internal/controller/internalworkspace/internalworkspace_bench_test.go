@@ -0,0 +1,152 @@
+/*
+Copyright 2024 The Workspaces Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internalworkspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+)
+
+const benchmarkKubesawNamespace = "toolchain-host-operator"
+
+// BenchmarkEnsureWorkspaceOwnerExists measures the owner's UserSignup lookup performed
+// on each reconcile against a cache holding an increasing number of UserSignups
+func BenchmarkEnsureWorkspaceOwnerExists(b *testing.B) {
+	for _, n := range []int{100, 1_000, 10_000} {
+		c := newBenchmarkClient(b, n)
+		r := WorkspaceReconciler{Client: c, Recorder: &record.FakeRecorder{}, KubesawNamespace: benchmarkKubesawNamespace}
+		sub := fmt.Sprintf("sub-%d", n-1)
+		ctx := context.Background()
+
+		b.Run(fmt.Sprintf("indexed/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				w := workspacesv1alpha1.InternalWorkspace{}
+				w.Spec.Owner.JwtInfo.Sub = sub
+				if err := r.ensureWorkspaceOwnerExists(ctx, &w); err != nil {
+					b.Fatal(err)
+				}
+				if w.Status.Owner.Username == "" {
+					b.Fatal("usersignup not found")
+				}
+			}
+		})
+
+		// the lookup as it was performed before introducing the indexer
+		b.Run(fmt.Sprintf("scan/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				uu := toolchainv1alpha1.UserSignupList{}
+				if err := c.List(ctx, &uu, client.InNamespace(benchmarkKubesawNamespace)); err != nil {
+					b.Fatal(err)
+				}
+				found := false
+				for _, u := range uu.Items {
+					if u.Spec.IdentityClaims.Sub == sub {
+						found = true
+						break
+					}
+				}
+				if !found {
+					b.Fatal("usersignup not found")
+				}
+			}
+		})
+	}
+}
+
+// newBenchmarkClient returns a client reading from a started cache filled with n UserSignups.
+// The cache is backed by a fake API Server only serving UserSignups.
+func newBenchmarkClient(b *testing.B, n int) client.Client {
+	b.Helper()
+
+	uu := toolchainv1alpha1.UserSignupList{
+		TypeMeta: metav1.TypeMeta{APIVersion: toolchainv1alpha1.GroupVersion.String(), Kind: "UserSignupList"},
+		ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+		Items:    make([]toolchainv1alpha1.UserSignup, n),
+	}
+	for i := range uu.Items {
+		u := &uu.Items[i]
+		u.Name = fmt.Sprintf("user-%d", i)
+		u.Namespace = benchmarkKubesawNamespace
+		u.ResourceVersion = "1"
+		u.Spec.IdentityClaims.Sub = fmt.Sprintf("sub-%d", i)
+		u.Status.CompliantUsername = u.Name
+	}
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// watches are kept open without sending any event
+		if r.URL.Query().Get("watch") == "true" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			return
+		}
+
+		if r.URL.Path != "/apis/toolchain.dev.openshift.com/v1alpha1/usersignups" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&uu)
+	}))
+	b.Cleanup(s.Close)
+
+	cfg := &rest.Config{Host: s.URL}
+	sc := runtime.NewScheme()
+	if err := toolchainv1alpha1.AddToScheme(sc); err != nil {
+		b.Fatal(err)
+	}
+	m := meta.NewDefaultRESTMapper(nil)
+	m.Add(toolchainv1alpha1.GroupVersion.WithKind("UserSignup"), meta.RESTScopeNamespace)
+
+	ca, err := cache.New(cfg, cache.Options{Scheme: sc, Mapper: m})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	b.Cleanup(cancel)
+	if err := ca.IndexField(ctx, &toolchainv1alpha1.UserSignup{}, IndexKeyUserSignupSub, IndexUserSignupSub); err != nil {
+		b.Fatal(err)
+	}
+
+	go func() { _ = ca.Start(ctx) }()
+	if !ca.WaitForCacheSync(ctx) {
+		b.Fatal("error synching cache")
+	}
+
+	c, err := client.New(cfg, client.Options{Scheme: sc, Mapper: m, Cache: &client.CacheOptions{Reader: ca}})
+	if err != nil {
+		b.Fatal(err)
+	}
+	return c
+}
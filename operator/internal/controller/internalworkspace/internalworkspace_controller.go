@@ -22,11 +22,14 @@ import (
 	"fmt"
 	"slices"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -36,12 +39,14 @@ import (
 
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
 	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+	"github.com/konflux-workspaces/workspaces/operator/internal/metrics"
 )
 
 // WorkspaceReconciler reconciles a Workspace object
 type WorkspaceReconciler struct {
 	client.Client
 	Scheme              *runtime.Scheme
+	Recorder            record.EventRecorder
 	KubesawNamespace    string
 	WorkspacesNamespace string
 }
@@ -60,6 +65,8 @@ var (
 //+kubebuilder:rbac:groups=workspaces.konflux-ci.dev,resources=internalworkspaces/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=workspaces.konflux-ci.dev,resources=internalworkspaces/finalizers,verbs=update
 
+//+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
 // For more details, check Reconcile and its Result here:
@@ -72,42 +79,210 @@ func (r *WorkspaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	if err := r.ensureBackendResourcesExists(ctx, &w); err != nil {
-		return ctrl.Result{}, err
+	if !w.DeletionTimestamp.IsZero() {
+		if err := r.cleanupBackendResources(ctx, &w); err != nil {
+			l.Error(err, "error cleaning up InternalWorkspace's backend resources")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
 	}
 
-	if err := r.ensureWorkspaceVisibilityIsSatisfied(ctx, w); err != nil {
-		l.Error(err, "error ensuring InternalWorkspace Visibility is satisfied")
+	// the status is computed in memory and written once, at the end of the reconciliation
+	st := w.Status.DeepCopy()
+
+	// run checks
+	oerr := r.ensureWorkspaceOwnerExists(ctx, &w)
+	serr := r.ensureSpaceExists(ctx, &w)
+
+	// if all checks failed, return the errors
+	if oerr != nil && serr != nil {
+		return ctrl.Result{}, errors.Join(oerr, serr)
+	}
+
+	if err := r.ensureFinalizerIsSet(ctx, &w); err != nil {
+		l.Error(err, "error setting InternalWorkspace's finalizer")
 		return ctrl.Result{}, err
 	}
 
-	l.V(6).Info("InternalWorkspace's visibility is satisfied", "visibility", w.Spec.Visibility)
-	return ctrl.Result{}, nil
+	// if at least one check was successful, record its outcome
+	if oerr != nil || serr != nil {
+		return ctrl.Result{}, errors.Join(r.updateStatus(ctx, &w, st), oerr, serr)
+	}
+
+	// the newest of the owner's InternalWorkspaces with the same display name is not provisioned
+	unique, err := r.ensureDisplayNameIsUnique(ctx, &w)
+	if err != nil {
+		l.Error(err, "error checking the uniqueness of InternalWorkspace's display name")
+		return ctrl.Result{}, errors.Join(r.updateStatus(ctx, &w, st), err)
+	}
+
+	// record the outcome of applying the spec in the status
+	var aerr error
+	if unique {
+		aerr = r.applySpec(ctx, &w)
+	}
+
+	w.Status.ObservedGeneration = w.Generation
+	d, observe := r.recordFirstReadyTime(ctx, &w, st)
+	if err := r.updateStatus(ctx, &w, st); err != nil {
+		l.Error(err, "error updating InternalWorkspace's status")
+		return ctrl.Result{}, errors.Join(aerr, err)
+	}
+	// the first ready time is persisted, so the home InternalWorkspace is never observed twice
+	if observe {
+		metrics.SignupToReady.Observe(d)
+	}
+
+	l.V(6).Info("InternalWorkspace's spec is applied", "generation", w.Generation, "visibility", w.Spec.Visibility)
+	return ctrl.Result{}, aerr
 }
 
-func (r *WorkspaceReconciler) ensureBackendResourcesExists(ctx context.Context, w *workspacesv1alpha1.InternalWorkspace) error {
-	// reset ready condition
-	meta.SetStatusCondition(&w.Status.Conditions,
-		metav1.Condition{
-			Type:   workspacesv1alpha1.ConditionTypeReady,
-			Reason: workspacesv1alpha1.ConditionReasonEverythingFine,
-			Status: metav1.ConditionTrue,
+// updateStatus refreshes the Ready condition and writes the InternalWorkspace's status,
+// if it changed from the status st read at the beginning of the reconciliation
+func (r *WorkspaceReconciler) updateStatus(ctx context.Context, w *workspacesv1alpha1.InternalWorkspace, st *workspacesv1alpha1.InternalWorkspaceStatus) error {
+	setStatusCondition(w, readyCondition(w.Status.Conditions))
+	if equality.Semantic.DeepEqual(*st, w.Status) {
+		return nil
+	}
+	return r.Status().Update(ctx, w)
+}
+
+// recordFirstReadyTime records in the status the time the InternalWorkspace first became Ready.
+// If the home InternalWorkspace became Ready in this reconciliation, it also returns the seconds
+// elapsed since the approval of the owner's UserSignup, to be observed once the status is persisted.
+func (r *WorkspaceReconciler) recordFirstReadyTime(ctx context.Context, w *workspacesv1alpha1.InternalWorkspace, st *workspacesv1alpha1.InternalWorkspaceStatus) (float64, bool) {
+	if w.Status.FirstReadyTime != nil {
+		return 0, false
+	}
+
+	setStatusCondition(w, readyCondition(w.Status.Conditions))
+	rc := meta.FindStatusCondition(w.Status.Conditions, workspacesv1alpha1.ConditionTypeReady)
+	if rc.Status != metav1.ConditionTrue {
+		return 0, false
+	}
+	w.Status.FirstReadyTime = rc.LastTransitionTime.DeepCopy()
+
+	// InternalWorkspaces that were already Ready when their first ready time was recorded are not measured
+	if !w.Status.Space.IsHome || meta.IsStatusConditionTrue(st.Conditions, workspacesv1alpha1.ConditionTypeReady) {
+		return 0, false
+	}
+
+	at, err := r.ownerApprovalTime(ctx, w)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "error retrieving the approval time of the owner's UserSignup")
+		return 0, false
+	}
+	if at == nil || rc.LastTransitionTime.Before(at) {
+		return 0, false
+	}
+	return rc.LastTransitionTime.Sub(at.Time).Seconds(), true
+}
+
+// ownerApprovalTime returns the time the UserSignup of the InternalWorkspace's owner was approved at,
+// or nil if it is not approved
+func (r *WorkspaceReconciler) ownerApprovalTime(ctx context.Context, w *workspacesv1alpha1.InternalWorkspace) (*metav1.Time, error) {
+	uu := toolchainv1alpha1.UserSignupList{}
+	opts := []client.ListOption{
+		client.InNamespace(r.KubesawNamespace),
+		client.MatchingFields{IndexKeyUserSignupSub: w.Spec.Owner.JwtInfo.Sub},
+	}
+	if err := r.List(ctx, &uu, opts...); err != nil {
+		return nil, err
+	}
+
+	for _, u := range uu.Items {
+		for _, c := range u.Status.Conditions {
+			if c.Type == toolchainv1alpha1.UserSignupApproved && c.Status == corev1.ConditionTrue {
+				return &c.LastTransitionTime, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// applySpec provisions the Space, and applies the visibility and the members of the InternalWorkspace,
+// recording the outcome in its conditions
+func (r *WorkspaceReconciler) applySpec(ctx context.Context, w *workspacesv1alpha1.InternalWorkspace) error {
+	l := log.FromContext(ctx)
+
+	serr := r.ensureSpaceIsProvisioned(ctx, *w)
+	if serr != nil {
+		l.Error(serr, "error provisioning InternalWorkspace's Space")
+		r.Recorder.Event(w, corev1.EventTypeWarning, workspacesv1alpha1.EventReasonSpaceProvisioningFailed, serr.Error())
+		setStatusCondition(w, metav1.Condition{
+			Type:    workspacesv1alpha1.ConditionTypeSpaceProvisioned,
+			Reason:  workspacesv1alpha1.ConditionReasonSpaceProvisioningFailed,
+			Status:  metav1.ConditionFalse,
+			Message: serr.Error(),
 		})
+	}
 
-	// run checks
-	oerr := r.ensureWorkspaceOwnerExists(ctx, w)
-	uerr := r.ensureSpaceExists(ctx, w)
+	verr := r.ensureWorkspaceVisibilityIsSatisfied(ctx, *w)
+	if verr != nil {
+		l.Error(verr, "error ensuring InternalWorkspace Visibility is satisfied")
+		r.Recorder.Event(w, corev1.EventTypeWarning, workspacesv1alpha1.EventReasonVisibilityNotApplied, verr.Error())
+	}
+	setStatusCondition(w, visibilityAppliedCondition(verr))
 
-	// if all checks failed, return the errors
-	if oerr != nil && uerr != nil {
-		return errors.Join(oerr, uerr)
+	merr := r.ensureMembersAreGranted(ctx, *w)
+	if merr != nil {
+		l.Error(merr, "error granting InternalWorkspace's members access")
+		r.Recorder.Event(w, corev1.EventTypeWarning, workspacesv1alpha1.EventReasonMembersNotGranted, merr.Error())
+	}
+
+	return errors.Join(serr, verr, merr)
+}
+
+// ensureDisplayNameIsUnique checks whether another InternalWorkspace of the same owner has the same
+// display name and records the outcome in the DisplayNameUnique condition.
+// The REST API Server checks the uniqueness on its cache, so concurrent requests may both pass its check.
+// In such a case, the oldest InternalWorkspace keeps the display name and the others are reported
+// as conflicting, so that they are not provisioned.
+func (r *WorkspaceReconciler) ensureDisplayNameIsUnique(ctx context.Context, w *workspacesv1alpha1.InternalWorkspace) (bool, error) {
+	ww := workspacesv1alpha1.InternalWorkspaceList{}
+	if err := r.List(ctx, &ww,
+		client.InNamespace(w.Namespace),
+		client.MatchingFields{IndexKeyInternalWorkspaceOwnerDisplayName: InternalWorkspaceOwnerDisplayNameIndexValue(w.Spec.Owner.JwtInfo.Sub, w.Spec.DisplayName)},
+	); err != nil {
+		return false, err
+	}
+
+	for _, o := range ww.Items {
+		if o.Name == w.Name ||
+			!o.DeletionTimestamp.IsZero() ||
+			o.Spec.Owner.JwtInfo.Sub != w.Spec.Owner.JwtInfo.Sub ||
+			o.Spec.DisplayName != w.Spec.DisplayName ||
+			!isOlder(o, *w) {
+			continue
+		}
+
+		msg := fmt.Sprintf("display name %s is already used by InternalWorkspace %s", w.Spec.DisplayName, o.Name)
+		if setStatusCondition(w, metav1.Condition{
+			Type:    workspacesv1alpha1.ConditionTypeDisplayNameUnique,
+			Reason:  workspacesv1alpha1.ConditionReasonDisplayNameConflict,
+			Status:  metav1.ConditionFalse,
+			Message: msg,
+		}) {
+			r.Recorder.Event(w, corev1.EventTypeWarning, workspacesv1alpha1.EventReasonDisplayNameConflict, msg)
+		}
+		return false, nil
 	}
 
-	// if at least one check was successful, update the status
-	err := r.Status().Update(ctx, w)
+	setStatusCondition(w, metav1.Condition{
+		Type:   workspacesv1alpha1.ConditionTypeDisplayNameUnique,
+		Reason: workspacesv1alpha1.ConditionReasonDisplayNameUnique,
+		Status: metav1.ConditionTrue,
+	})
+	return true, nil
+}
 
-	// return joined errors
-	return errors.Join(err, oerr, uerr)
+// isOlder returns true if a has been created before b.
+// InternalWorkspaces created in the same second are ordered by name.
+func isOlder(a, b workspacesv1alpha1.InternalWorkspace) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.Name < b.Name
 }
 
 func (r *WorkspaceReconciler) ensureSpaceExists(ctx context.Context, w *workspacesv1alpha1.InternalWorkspace) error {
@@ -119,21 +294,26 @@ func (r *WorkspaceReconciler) ensureSpaceExists(ctx context.Context, w *workspac
 	// if the space exists, update the target cluster value
 	case err == nil:
 		w.Status.Space.TargetCluster = s.Status.TargetCluster
+		setStatusCondition(w, metav1.Condition{
+			Type:   workspacesv1alpha1.ConditionTypeSpaceProvisioned,
+			Reason: workspacesv1alpha1.ConditionReasonSpaceProvisioned,
+			Status: metav1.ConditionTrue,
+		})
 		return nil
 
 	// if the space does not exist, remove the target cluster value
 	case kerrors.IsNotFound(err):
 		w.Status.Space.TargetCluster = ""
-		// set Ready condition to false if it's true
-		if meta.IsStatusConditionTrue(w.Status.Conditions, workspacesv1alpha1.ConditionTypeReady) {
-			meta.SetStatusCondition(&w.Status.Conditions,
-				metav1.Condition{
-					Type:    workspacesv1alpha1.ConditionTypeReady,
-					Reason:  workspacesv1alpha1.ConditionReasonSpaceNotFound,
-					Status:  metav1.ConditionFalse,
-					Message: fmt.Sprintf("Space %s not found", w.Name),
-				})
+		// the Space disappeared if it was previously provisioned
+		if meta.IsStatusConditionTrue(w.Status.Conditions, workspacesv1alpha1.ConditionTypeSpaceProvisioned) {
+			r.Recorder.Eventf(w, corev1.EventTypeWarning, workspacesv1alpha1.EventReasonSpaceNotFound, "Space %s not found", w.Name)
 		}
+		setStatusCondition(w, metav1.Condition{
+			Type:    workspacesv1alpha1.ConditionTypeSpaceProvisioned,
+			Reason:  workspacesv1alpha1.ConditionReasonSpaceNotFound,
+			Status:  metav1.ConditionFalse,
+			Message: fmt.Sprintf("Space %s not found", w.Name),
+		})
 		return nil
 
 	// if any other error occurred, forward it
@@ -144,7 +324,11 @@ func (r *WorkspaceReconciler) ensureSpaceExists(ctx context.Context, w *workspac
 
 func (r *WorkspaceReconciler) ensureWorkspaceOwnerExists(ctx context.Context, w *workspacesv1alpha1.InternalWorkspace) error {
 	uu := toolchainv1alpha1.UserSignupList{}
-	if err := r.List(ctx, &uu, client.InNamespace(r.KubesawNamespace)); err != nil {
+	opts := []client.ListOption{
+		client.InNamespace(r.KubesawNamespace),
+		client.MatchingFields{IndexKeyUserSignupSub: w.Spec.Owner.JwtInfo.Sub},
+	}
+	if err := r.List(ctx, &uu, opts...); err != nil {
 		return err
 	}
 
@@ -155,25 +339,301 @@ func (r *WorkspaceReconciler) ensureWorkspaceOwnerExists(ctx context.Context, w
 	}
 
 	// set Owner information
+	po := w.Status.Owner.Username
 	w.Status.Owner = workspacesv1alpha1.UserInfoStatus{}
-	i := slices.IndexFunc(uu.Items, func(u toolchainv1alpha1.UserSignup) bool {
-		return u.Spec.IdentityClaims.Sub == w.Spec.Owner.JwtInfo.Sub
-	})
-	switch i {
-	case -1:
+	if len(uu.Items) == 0 {
 		log.FromContext(ctx).Info("UserSignup not found by Sub", "sub", w.Spec.Owner.JwtInfo.Sub)
-		meta.SetStatusCondition(&w.Status.Conditions,
-			metav1.Condition{
+		if setStatusCondition(w, metav1.Condition{
+			Type:    workspacesv1alpha1.ConditionTypeOwnerResolved,
+			Reason:  workspacesv1alpha1.ConditionReasonOwnerNotFound,
+			Status:  metav1.ConditionFalse,
+			Message: fmt.Sprintf("UserSignup with Sub %s not found", w.Spec.Owner.JwtInfo.Sub),
+		}) {
+			r.Recorder.Eventf(w, corev1.EventTypeWarning, workspacesv1alpha1.EventReasonOwnerNotFound,
+				"UserSignup with Sub %s not found", w.Spec.Owner.JwtInfo.Sub)
+		}
+		setStatusCondition(w, metav1.Condition{
+			Type:    workspacesv1alpha1.ConditionTypeOwnerActive,
+			Reason:  workspacesv1alpha1.ConditionReasonOwnerNotFound,
+			Status:  metav1.ConditionUnknown,
+			Message: fmt.Sprintf("UserSignup with Sub %s not found", w.Spec.Owner.JwtInfo.Sub),
+		})
+		return nil
+	}
+
+	log.FromContext(ctx).Info("user signup found", "sub", w.Spec.Owner.JwtInfo.Sub)
+	w.Status.Owner.Username = uu.Items[0].Status.CompliantUsername
+	if setStatusCondition(w, metav1.Condition{
+		Type:   workspacesv1alpha1.ConditionTypeOwnerResolved,
+		Reason: workspacesv1alpha1.ConditionReasonOwnerResolved,
+		Status: metav1.ConditionTrue,
+	}) || po != w.Status.Owner.Username {
+		r.Recorder.Eventf(w, corev1.EventTypeNormal, workspacesv1alpha1.EventReasonOwnerResolved,
+			"Owner resolved to user %s", w.Status.Owner.Username)
+	}
+	setStatusCondition(w, ownerActiveCondition(uu.Items[0]))
+	return nil
+}
+
+// setStatusCondition sets the condition on the InternalWorkspace's status,
+// recording the generation it has been computed for.
+// It returns true if the condition's status or reason changed.
+func setStatusCondition(w *workspacesv1alpha1.InternalWorkspace, c metav1.Condition) bool {
+	o := meta.FindStatusCondition(w.Status.Conditions, c.Type)
+	changed := o == nil || o.Status != c.Status || o.Reason != c.Reason
+	c.ObservedGeneration = w.Generation
+	meta.SetStatusCondition(&w.Status.Conditions, c)
+	return changed
+}
+
+// readyCondition builds the Ready condition aggregating the DisplayNameUnique, OwnerResolved,
+// SpaceProvisioned, and VisibilityApplied conditions. If any of them is not True, the Ready condition
+// reports the reason and message of the first one.
+func readyCondition(cc []metav1.Condition) metav1.Condition {
+	for _, t := range []string{
+		workspacesv1alpha1.ConditionTypeDisplayNameUnique,
+		workspacesv1alpha1.ConditionTypeOwnerResolved,
+		workspacesv1alpha1.ConditionTypeSpaceProvisioned,
+		workspacesv1alpha1.ConditionTypeVisibilityApplied,
+	} {
+		c := meta.FindStatusCondition(cc, t)
+		switch {
+		case c == nil:
+			return metav1.Condition{
+				Type:    workspacesv1alpha1.ConditionTypeReady,
+				Reason:  workspacesv1alpha1.ConditionReasonReconciling,
+				Status:  metav1.ConditionUnknown,
+				Message: fmt.Sprintf("Condition %s not evaluated yet", t),
+			}
+		case c.Status != metav1.ConditionTrue:
+			return metav1.Condition{
 				Type:    workspacesv1alpha1.ConditionTypeReady,
-				Reason:  workspacesv1alpha1.ConditionReasonOwnerNotFound,
-				Status:  metav1.ConditionFalse,
-				Message: fmt.Sprintf("UserSignup with Sub %s not found", w.Spec.Owner.JwtInfo.Sub),
-			})
+				Reason:  c.Reason,
+				Status:  c.Status,
+				Message: c.Message,
+			}
+		}
+	}
+
+	return metav1.Condition{
+		Type:   workspacesv1alpha1.ConditionTypeReady,
+		Reason: workspacesv1alpha1.ConditionReasonEverythingFine,
+		Status: metav1.ConditionTrue,
+	}
+}
+
+// visibilityAppliedCondition builds the VisibilityApplied condition from the outcome
+// of ensureWorkspaceVisibilityIsSatisfied
+func visibilityAppliedCondition(err error) metav1.Condition {
+	switch {
+	case err == nil:
+		return metav1.Condition{
+			Type:   workspacesv1alpha1.ConditionTypeVisibilityApplied,
+			Reason: workspacesv1alpha1.ConditionReasonVisibilityApplied,
+			Status: metav1.ConditionTrue,
+		}
+	case errors.Is(err, ErrNonTransient):
+		return metav1.Condition{
+			Type:    workspacesv1alpha1.ConditionTypeVisibilityApplied,
+			Reason:  workspacesv1alpha1.ConditionReasonInvalidVisibility,
+			Status:  metav1.ConditionFalse,
+			Message: err.Error(),
+		}
 	default:
-		log.FromContext(ctx).Info("user signup found", "sub", w.Spec.Owner.JwtInfo.Sub)
-		w.Status.Owner.Username = uu.Items[i].Status.CompliantUsername
+		return metav1.Condition{
+			Type:    workspacesv1alpha1.ConditionTypeVisibilityApplied,
+			Reason:  workspacesv1alpha1.ConditionReasonVisibilityNotApplied,
+			Status:  metav1.ConditionFalse,
+			Message: err.Error(),
+		}
+	}
+}
+
+// ownerActiveCondition builds the OwnerActive condition reflecting the state of the owner's UserSignup
+func ownerActiveCondition(u toolchainv1alpha1.UserSignup) metav1.Condition {
+	switch {
+	case isUserSignupInState(u, toolchainv1alpha1.UserSignupStateBanned, toolchainv1alpha1.UserSignupStateLabelValueBanned):
+		return metav1.Condition{
+			Type:    workspacesv1alpha1.ConditionTypeOwnerActive,
+			Reason:  workspacesv1alpha1.ConditionReasonOwnerBanned,
+			Status:  metav1.ConditionFalse,
+			Message: fmt.Sprintf("UserSignup %s is banned", u.Name),
+		}
+	case isUserSignupInState(u, toolchainv1alpha1.UserSignupStateDeactivated, toolchainv1alpha1.UserSignupStateLabelValueDeactivated):
+		return metav1.Condition{
+			Type:    workspacesv1alpha1.ConditionTypeOwnerActive,
+			Reason:  workspacesv1alpha1.ConditionReasonOwnerDeactivated,
+			Status:  metav1.ConditionFalse,
+			Message: fmt.Sprintf("UserSignup %s is deactivated", u.Name),
+		}
+	default:
+		return metav1.Condition{
+			Type:   workspacesv1alpha1.ConditionTypeOwnerActive,
+			Reason: workspacesv1alpha1.ConditionReasonOwnerActive,
+			Status: metav1.ConditionTrue,
+		}
+	}
+}
+
+// isUserSignupInState checks both the UserSignup's states and its state label
+func isUserSignupInState(u toolchainv1alpha1.UserSignup, state toolchainv1alpha1.UserSignupState, label string) bool {
+	return slices.Contains(u.Spec.States, state) ||
+		u.GetLabels()[toolchainv1alpha1.UserSignupStateLabelKey] == label
+}
+
+// ensureFinalizerIsSet adds the cleanup finalizer to the InternalWorkspace.
+// The update returns the persisted status, so the status computed in memory is restored afterwards.
+func (r *WorkspaceReconciler) ensureFinalizerIsSet(ctx context.Context, w *workspacesv1alpha1.InternalWorkspace) error {
+	if !controllerutil.AddFinalizer(w, workspacesv1alpha1.FinalizerCleanup) {
+		return nil
+	}
+
+	st := w.Status.DeepCopy()
+	if err := r.Update(ctx, w); err != nil {
+		return err
+	}
+	st.DeepCopyInto(&w.Status)
+	return nil
+}
+
+// cleanupBackendResources deletes the Space and the SpaceBindings created for the InternalWorkspace
+// and releases the finalizer. Home Spaces are managed by KubeSaw and are not deleted.
+func (r *WorkspaceReconciler) cleanupBackendResources(ctx context.Context, w *workspacesv1alpha1.InternalWorkspace) error {
+	if !controllerutil.ContainsFinalizer(w, workspacesv1alpha1.FinalizerCleanup) {
+		return nil
+	}
+
+	l := log.FromContext(ctx).WithValues("workspace", w.Name, "workspace-namespace", w.Namespace)
+
+	// delete the SpaceBindings
+	for _, n := range []string{fmt.Sprintf("%s-community", w.Name), fmt.Sprintf("%s-owner", w.Name)} {
+		sb := toolchainv1alpha1.SpaceBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: r.KubesawNamespace},
+		}
+		l.Info("ensuring spacebinding doesn't exist", "space-binding", sb.Name, "space-binding-namespace", sb.Namespace)
+		if err := client.IgnoreNotFound(r.Delete(ctx, &sb)); err != nil {
+			return err
+		}
 	}
 
+	// delete the members' SpaceBindings
+	if err := r.deleteMembersSpaceBindings(ctx, *w, nil); err != nil {
+		return err
+	}
+
+	// delete the Space
+	if w.Spec.DisplayName != workspacesv1alpha1.DisplayNameDefaultWorkspace {
+		s := toolchainv1alpha1.Space{
+			ObjectMeta: metav1.ObjectMeta{Name: w.Name, Namespace: r.KubesawNamespace},
+		}
+		l.Info("ensuring space doesn't exist", "space", s.Name, "space-namespace", s.Namespace)
+		if err := client.IgnoreNotFound(r.Delete(ctx, &s)); err != nil {
+			return err
+		}
+	}
+
+	// release the finalizer
+	controllerutil.RemoveFinalizer(w, workspacesv1alpha1.FinalizerCleanup)
+	return r.Update(ctx, w)
+}
+
+// ensureSpaceIsProvisioned creates the Space backing a non-home InternalWorkspace
+// and grants the owner the admin role on it. Home Spaces are provisioned by KubeSaw.
+func (r *WorkspaceReconciler) ensureSpaceIsProvisioned(ctx context.Context, w workspacesv1alpha1.InternalWorkspace) error {
+	// home workspaces and workspaces whose owner is still unknown are not provisioned
+	if w.Status.Space.IsHome || w.Status.Owner.Username == "" {
+		return nil
+	}
+
+	o := w.Status.Owner.Username
+	l := log.FromContext(ctx).WithValues(
+		"workspace", w.Name,
+		"workspace-namespace", w.Namespace,
+		"owner", o,
+	)
+
+	// ensure the Space exists.
+	// The Space is created with no tierName nor targetCluster: KubeSaw's host operator completes it
+	// with the default Space tier configured in the ToolchainConfig, and with the member cluster
+	// picked by its capacity manager.
+	s := toolchainv1alpha1.Space{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      w.Name,
+			Namespace: r.KubesawNamespace,
+		},
+	}
+	l.Info("ensuring space exists", "space", s.Name, "space-namespace", s.Namespace)
+	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, &s, func() error {
+		if s.Labels == nil {
+			s.Labels = map[string]string{}
+		}
+		s.Labels[toolchainv1alpha1.SpaceCreatorLabelKey] = o
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if op == controllerutil.OperationResultCreated {
+		r.Recorder.Eventf(&w, corev1.EventTypeNormal, workspacesv1alpha1.EventReasonSpaceCreated, "Space %s created", s.Name)
+	}
+
+	// ensure the owner is admin of the Space
+	sb := toolchainv1alpha1.SpaceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-owner", w.Name),
+			Namespace: r.KubesawNamespace,
+		},
+	}
+	if err := r.ensureOwnerSpaceBindingIsNotStale(ctx, &w, sb, o); err != nil {
+		return err
+	}
+	l.Info("ensuring owner spacebinding exists", "space-binding", sb.Name, "space-binding-namespace", sb.Namespace)
+	op, err = controllerutil.CreateOrUpdate(ctx, r.Client, &sb, func() error {
+		if sb.Labels == nil {
+			sb.Labels = map[string]string{}
+		}
+		sb.Labels[toolchainv1alpha1.SpaceBindingMasterUserRecordLabelKey] = o
+		sb.Labels[toolchainv1alpha1.SpaceBindingSpaceLabelKey] = w.Name
+		sb.Spec.Space = w.Name
+		sb.Spec.MasterUserRecord = o
+		sb.Spec.SpaceRole = "admin"
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	switch op {
+	case controllerutil.OperationResultCreated:
+		r.Recorder.Eventf(&w, corev1.EventTypeNormal, workspacesv1alpha1.EventReasonSpaceBindingCreated,
+			"SpaceBinding %s created for owner %s", sb.Name, o)
+	case controllerutil.OperationResultUpdated:
+		metrics.SpaceBindingDriftCorrections.WithLabelValues(metrics.SpaceBindingKindOwner).Inc()
+	}
+	return nil
+}
+
+// ensureOwnerSpaceBindingIsNotStale deletes the owner's SpaceBinding if it grants access to a user
+// other than the current owner, as it happens when the ownership of the InternalWorkspace is transferred.
+// The SpaceBinding is recreated for the new owner instead of being updated, so that the grants
+// of the previous owner are revoked with it.
+func (r *WorkspaceReconciler) ensureOwnerSpaceBindingIsNotStale(ctx context.Context, w *workspacesv1alpha1.InternalWorkspace, sb toolchainv1alpha1.SpaceBinding, owner string) error {
+	if err := r.Get(ctx, client.ObjectKeyFromObject(&sb), &sb); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if sb.Spec.MasterUserRecord == owner {
+		return nil
+	}
+
+	log.FromContext(ctx).Info("deleting stale owner spacebinding",
+		"space-binding", sb.Name,
+		"space-binding-namespace", sb.Namespace,
+		"previous-owner", sb.Spec.MasterUserRecord,
+		"owner", owner)
+	if err := r.Delete(ctx, &sb); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	r.Recorder.Eventf(w, corev1.EventTypeNormal, workspacesv1alpha1.EventReasonSpaceBindingDeleted,
+		"SpaceBinding %s of previous owner %s deleted", sb.Name, sb.Spec.MasterUserRecord)
 	return nil
 }
 
@@ -198,23 +658,152 @@ func (r *WorkspaceReconciler) ensureWorkspaceVisibilityIsSatisfied(ctx context.C
 	switch w.Spec.Visibility {
 	case workspacesv1alpha1.InternalWorkspaceVisibilityCommunity:
 		l.Info("ensuring spacebinding exists")
-		_, err := controllerutil.CreateOrUpdate(ctx, r.Client, &s, func() error {
+		op, err := controllerutil.CreateOrUpdate(ctx, r.Client, &s, func() error {
 			s.Spec.Space = w.Name
 			s.Spec.MasterUserRecord = workspacesv1alpha1.PublicViewerName
 			s.Spec.SpaceRole = "viewer"
 			return nil
 		})
-		return err
+		if err != nil {
+			return err
+		}
+		switch op {
+		case controllerutil.OperationResultCreated:
+			r.Recorder.Eventf(&w, corev1.EventTypeNormal, workspacesv1alpha1.EventReasonVisibilityChanged,
+				"Visibility set to community, SpaceBinding %s created", s.Name)
+		case controllerutil.OperationResultUpdated:
+			metrics.SpaceBindingDriftCorrections.WithLabelValues(metrics.SpaceBindingKindCommunity).Inc()
+		}
+		return nil
 	case workspacesv1alpha1.InternalWorkspaceVisibilityPrivate:
 		l.Info("ensuring spacebinding doesn't exist")
-		return client.IgnoreNotFound(r.Client.Delete(ctx, &s))
+		if err := r.Client.Delete(ctx, &s); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		r.Recorder.Eventf(&w, corev1.EventTypeNormal, workspacesv1alpha1.EventReasonVisibilityChanged,
+			"Visibility set to private, SpaceBinding %s deleted", s.Name)
+		return nil
 	default:
 		return fmt.Errorf("%w: invalid workspace visibility value", ErrNonTransient)
 	}
 }
 
+// ensureMembersAreGranted ensures a SpaceBinding exists for each member of the InternalWorkspace
+// with the requested role, and deletes the SpaceBindings of the users who are no longer members.
+func (r *WorkspaceReconciler) ensureMembersAreGranted(ctx context.Context, w workspacesv1alpha1.InternalWorkspace) error {
+	// workspaces whose owner is still unknown are not provisioned
+	if w.Status.Owner.Username == "" {
+		return nil
+	}
+
+	l := log.FromContext(ctx).WithValues(
+		"workspace", w.Name,
+		"workspace-namespace", w.Namespace,
+	)
+
+	// ensure members' SpaceBindings exist
+	mm := make(map[string]struct{}, len(w.Spec.Members))
+	for _, m := range w.Spec.Members {
+		// the owner is already granted the admin role
+		if m.Username == w.Status.Owner.Username {
+			continue
+		}
+		mm[m.Username] = struct{}{}
+
+		sb := toolchainv1alpha1.SpaceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      memberSpaceBindingName(w.Name, m.Username),
+				Namespace: r.KubesawNamespace,
+			},
+		}
+		l.Info("ensuring member spacebinding exists", "member", m.Username, "role", m.Role, "space-binding", sb.Name, "space-binding-namespace", sb.Namespace)
+		// a change of the member's role is not a drift
+		roleChanged := false
+		op, err := controllerutil.CreateOrUpdate(ctx, r.Client, &sb, func() error {
+			roleChanged = sb.Spec.SpaceRole != string(m.Role)
+			if sb.Labels == nil {
+				sb.Labels = map[string]string{}
+			}
+			sb.Labels[toolchainv1alpha1.SpaceBindingMasterUserRecordLabelKey] = m.Username
+			sb.Labels[toolchainv1alpha1.SpaceBindingSpaceLabelKey] = w.Name
+			sb.Labels[workspacesv1alpha1.LabelMember] = "true"
+			sb.Spec.Space = w.Name
+			sb.Spec.MasterUserRecord = m.Username
+			sb.Spec.SpaceRole = string(m.Role)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		switch {
+		case op == controllerutil.OperationResultCreated:
+			r.Recorder.Eventf(&w, corev1.EventTypeNormal, workspacesv1alpha1.EventReasonSpaceBindingCreated,
+				"SpaceBinding %s created for member %s with role %s", sb.Name, m.Username, m.Role)
+		case op == controllerutil.OperationResultUpdated && !roleChanged:
+			metrics.SpaceBindingDriftCorrections.WithLabelValues(metrics.SpaceBindingKindMember).Inc()
+		}
+	}
+
+	// delete the SpaceBindings of users who are no longer members
+	return r.deleteMembersSpaceBindings(ctx, w, mm)
+}
+
+// deleteMembersSpaceBindings deletes the SpaceBindings created for the members
+// of the InternalWorkspace, except the ones of the users in keep
+func (r *WorkspaceReconciler) deleteMembersSpaceBindings(ctx context.Context, w workspacesv1alpha1.InternalWorkspace, keep map[string]struct{}) error {
+	l := log.FromContext(ctx).WithValues(
+		"workspace", w.Name,
+		"workspace-namespace", w.Namespace,
+	)
+
+	sbb := toolchainv1alpha1.SpaceBindingList{}
+	if err := r.List(ctx, &sbb,
+		client.InNamespace(r.KubesawNamespace),
+		client.MatchingLabels{
+			toolchainv1alpha1.SpaceBindingSpaceLabelKey: w.Name,
+			workspacesv1alpha1.LabelMember:              "true",
+		},
+	); err != nil {
+		return err
+	}
+
+	for _, sb := range sbb.Items {
+		if _, ok := keep[sb.Spec.MasterUserRecord]; ok {
+			continue
+		}
+
+		l.Info("ensuring member spacebinding doesn't exist", "member", sb.Spec.MasterUserRecord, "space-binding", sb.Name, "space-binding-namespace", sb.Namespace)
+		if err := r.Delete(ctx, &sb); err != nil {
+			if kerrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		r.Recorder.Eventf(&w, corev1.EventTypeNormal, workspacesv1alpha1.EventReasonSpaceBindingDeleted,
+			"SpaceBinding %s of former member %s deleted", sb.Name, sb.Spec.MasterUserRecord)
+	}
+	return nil
+}
+
+func memberSpaceBindingName(workspace, member string) string {
+	return fmt.Sprintf("%s-member-%s", workspace, member)
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *WorkspaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &toolchainv1alpha1.UserSignup{}, IndexKeyUserSignupSub, IndexUserSignupSub); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &workspacesv1alpha1.InternalWorkspace{}, IndexKeyInternalWorkspaceOwnerSub, IndexInternalWorkspaceOwnerSub); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &workspacesv1alpha1.InternalWorkspace{}, IndexKeyInternalWorkspaceOwnerUsername, IndexInternalWorkspaceOwnerUsername); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &workspacesv1alpha1.InternalWorkspace{}, IndexKeyInternalWorkspaceOwnerDisplayName, IndexInternalWorkspaceOwnerDisplayName); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&workspacesv1alpha1.InternalWorkspace{}).
 		Watches(&toolchainv1alpha1.Space{}, handler.EnqueueRequestsFromMapFunc(r.mapSpaceToWorkspace)).
@@ -262,16 +851,33 @@ func (r *WorkspaceReconciler) mapSpaceBindingToWorkspace(ctx context.Context, o
 
 func (r *WorkspaceReconciler) mapUserSignupToWorkspace(ctx context.Context, o client.Object) []reconcile.Request {
 	u, ok := o.(*toolchainv1alpha1.UserSignup)
-	if !ok || u.Status.CompliantUsername == "" {
+	if !ok {
 		return nil
 	}
 
-	return []reconcile.Request{
-		{
-			NamespacedName: types.NamespacedName{
-				Name:      u.Status.CompliantUsername,
-				Namespace: r.WorkspacesNamespace,
-			},
-		},
+	// changes on the UserSignup may affect all the InternalWorkspaces owned by the user
+	ff := []client.MatchingFields{{IndexKeyInternalWorkspaceOwnerSub: u.Spec.IdentityClaims.Sub}}
+	if u.Status.CompliantUsername != "" {
+		ff = append(ff, client.MatchingFields{IndexKeyInternalWorkspaceOwnerUsername: u.Status.CompliantUsername})
+	}
+
+	rr := []reconcile.Request{}
+	seen := map[types.NamespacedName]struct{}{}
+	for _, f := range ff {
+		ww := workspacesv1alpha1.InternalWorkspaceList{}
+		if err := r.List(ctx, &ww, client.InNamespace(r.WorkspacesNamespace), f); err != nil {
+			log.FromContext(ctx).Error(err, "error listing InternalWorkspaces owned by UserSignup", "usersignup", u.Name)
+			return nil
+		}
+
+		for _, w := range ww.Items {
+			k := client.ObjectKeyFromObject(&w)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			rr = append(rr, reconcile.Request{NamespacedName: k})
+		}
 	}
+	return rr
 }
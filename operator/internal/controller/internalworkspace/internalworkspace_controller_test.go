@@ -4,21 +4,27 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
+	v1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	corev1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/konflux-workspaces/workspaces/operator/internal/controller/internalworkspace"
+	"github.com/konflux-workspaces/workspaces/operator/internal/metrics"
 
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
 	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
@@ -29,6 +35,7 @@ var _ = Describe("InternalWorkspaceController", func() {
 	var r internalworkspace.WorkspaceReconciler
 	var ctx context.Context
 	var scheme *runtime.Scheme
+	var recorder *record.FakeRecorder
 
 	var workspace workspacesv1alpha1.InternalWorkspace
 	var owner toolchainv1alpha1.UserSignup
@@ -43,19 +50,39 @@ var _ = Describe("InternalWorkspaceController", func() {
 		return internalworkspace.WorkspaceReconciler{
 			Client:              clientBuilder.Build(),
 			Scheme:              scheme,
+			Recorder:            recorder,
 			KubesawNamespace:    kubesawNamespace,
 			WorkspacesNamespace: workspacesNamespace,
 		}
 	}
 
+	// recordedEvents drains the events recorded by the reconciler
+	recordedEvents := func() []string {
+		ee := []string{}
+		for {
+			select {
+			case e := <-recorder.Events:
+				ee = append(ee, e)
+			default:
+				return ee
+			}
+		}
+	}
+
 	BeforeEach(func() {
 		ctx = context.TODO()
+		recorder = record.NewFakeRecorder(100)
 
 		scheme = runtime.NewScheme()
 		Expect(workspacesv1alpha1.AddToScheme(scheme)).To(Succeed())
 		Expect(toolchainv1alpha1.AddToScheme(scheme)).To(Succeed())
 
-		clientBuilder = fake.NewClientBuilder().WithScheme(scheme)
+		clientBuilder = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithIndex(&toolchainv1alpha1.UserSignup{}, internalworkspace.IndexKeyUserSignupSub, internalworkspace.IndexUserSignupSub).
+			WithIndex(&workspacesv1alpha1.InternalWorkspace{}, internalworkspace.IndexKeyInternalWorkspaceOwnerSub, internalworkspace.IndexInternalWorkspaceOwnerSub).
+			WithIndex(&workspacesv1alpha1.InternalWorkspace{}, internalworkspace.IndexKeyInternalWorkspaceOwnerUsername, internalworkspace.IndexInternalWorkspaceOwnerUsername).
+			WithIndex(&workspacesv1alpha1.InternalWorkspace{}, internalworkspace.IndexKeyInternalWorkspaceOwnerDisplayName, internalworkspace.IndexInternalWorkspaceOwnerDisplayName)
 
 		owner = toolchainv1alpha1.UserSignup{
 			ObjectMeta: corev1.ObjectMeta{
@@ -115,6 +142,93 @@ var _ = Describe("InternalWorkspaceController", func() {
 		})
 	})
 
+	Context("Workspace is being deleted", func() {
+		communitySpaceBinding := toolchainv1alpha1.SpaceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-community", workspaceName),
+				Namespace: kubesawNamespace,
+			},
+		}
+		ownerSpaceBinding := toolchainv1alpha1.SpaceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-owner", workspaceName),
+				Namespace: kubesawNamespace,
+			},
+		}
+		memberSpaceBinding := toolchainv1alpha1.SpaceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-member-member", workspaceName),
+				Namespace: kubesawNamespace,
+				Labels: map[string]string{
+					toolchainv1alpha1.SpaceBindingSpaceLabelKey: workspaceName,
+					workspacesv1alpha1.LabelMember:              "true",
+				},
+			},
+		}
+
+		BeforeEach(func() {
+			now := metav1.Now()
+			workspace.DeletionTimestamp = &now
+			workspace.Finalizers = []string{workspacesv1alpha1.FinalizerCleanup}
+		})
+
+		When("the InternalWorkspace is not the home workspace", func() {
+			BeforeEach(func() {
+				clientBuilder = clientBuilder.WithObjects(&workspace, &owner, &space, &communitySpaceBinding, &ownerSpaceBinding, &memberSpaceBinding)
+			})
+
+			It("deletes the Space and the SpaceBindings", func() {
+				// given
+				r = buildReconciler()
+				key := client.ObjectKeyFromObject(&workspace)
+
+				// when
+				res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(BeZero())
+
+				err = r.Get(ctx, client.ObjectKeyFromObject(&space), &toolchainv1alpha1.Space{})
+				Expect(err).To(MatchError(kerrors.IsNotFound, "IsNotFound error expected"))
+				err = r.Get(ctx, client.ObjectKeyFromObject(&communitySpaceBinding), &toolchainv1alpha1.SpaceBinding{})
+				Expect(err).To(MatchError(kerrors.IsNotFound, "IsNotFound error expected"))
+				err = r.Get(ctx, client.ObjectKeyFromObject(&ownerSpaceBinding), &toolchainv1alpha1.SpaceBinding{})
+				Expect(err).To(MatchError(kerrors.IsNotFound, "IsNotFound error expected"))
+				err = r.Get(ctx, client.ObjectKeyFromObject(&memberSpaceBinding), &toolchainv1alpha1.SpaceBinding{})
+				Expect(err).To(MatchError(kerrors.IsNotFound, "IsNotFound error expected"))
+				err = r.Get(ctx, key, &workspacesv1alpha1.InternalWorkspace{})
+				Expect(err).To(MatchError(kerrors.IsNotFound, "IsNotFound error expected"))
+			})
+		})
+
+		When("the InternalWorkspace is the home workspace", func() {
+			BeforeEach(func() {
+				workspace.Spec.DisplayName = workspacesv1alpha1.DisplayNameDefaultWorkspace
+				clientBuilder = clientBuilder.WithObjects(&workspace, &owner, &space, &communitySpaceBinding)
+			})
+
+			It("deletes the community SpaceBinding and keeps the Space", func() {
+				// given
+				r = buildReconciler()
+				key := client.ObjectKeyFromObject(&workspace)
+
+				// when
+				res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(BeZero())
+
+				Expect(r.Get(ctx, client.ObjectKeyFromObject(&space), &toolchainv1alpha1.Space{})).To(Succeed())
+				err = r.Get(ctx, client.ObjectKeyFromObject(&communitySpaceBinding), &toolchainv1alpha1.SpaceBinding{})
+				Expect(err).To(MatchError(kerrors.IsNotFound, "IsNotFound error expected"))
+				err = r.Get(ctx, key, &workspacesv1alpha1.InternalWorkspace{})
+				Expect(err).To(MatchError(kerrors.IsNotFound, "IsNotFound error expected"))
+			})
+		})
+	})
+
 	Context("Workspace exists", func() {
 		BeforeEach(func() {
 			clientBuilder = clientBuilder.
@@ -199,7 +313,7 @@ var _ = Describe("InternalWorkspaceController", func() {
 				})
 			})
 
-			It("joined errors are forwarded with no changes", func() {
+			It("joined errors are forwarded with no status changes", func() {
 				// given
 				r = buildReconciler()
 				key := client.ObjectKeyFromObject(&workspace)
@@ -214,7 +328,7 @@ var _ = Describe("InternalWorkspaceController", func() {
 				w := workspacesv1alpha1.InternalWorkspace{}
 				err = r.Get(ctx, key, &w)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(w).To(BeEquivalentTo(workspace))
+				Expect(w.Status).To(Equal(workspace.Status))
 			})
 		})
 
@@ -367,6 +481,917 @@ var _ = Describe("InternalWorkspaceController", func() {
 			})
 		})
 
+		Context("granular conditions", func() {
+			reconcileAndGetWorkspace := func() (workspacesv1alpha1.InternalWorkspace, error) {
+				r = buildReconciler()
+				key := client.ObjectKeyFromObject(&workspace)
+				_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+
+				w := workspacesv1alpha1.InternalWorkspace{}
+				Expect(r.Get(ctx, key, &w)).To(Succeed())
+				return w, err
+			}
+
+			expectCondition := func(w workspacesv1alpha1.InternalWorkspace, conditionType string, status metav1.ConditionStatus, reason string) {
+				c := meta.FindStatusCondition(w.Status.Conditions, conditionType)
+				Expect(c).NotTo(BeNil())
+				Expect(c.Status).To(Equal(status))
+				Expect(c.Reason).To(Equal(reason))
+				Expect(c.ObservedGeneration).To(Equal(workspace.Generation))
+			}
+
+			BeforeEach(func() {
+				workspace.Generation = 3
+			})
+
+			When("the Owner's UserSignup and Space exist", func() {
+				BeforeEach(func() {
+					clientBuilder = clientBuilder.WithObjects(&owner, &space)
+				})
+
+				It("sets all the conditions to True and records the observed generation", func() {
+					// when
+					w, err := reconcileAndGetWorkspace()
+
+					// then
+					Expect(err).NotTo(HaveOccurred())
+					expectCondition(w, workspacesv1alpha1.ConditionTypeOwnerResolved, metav1.ConditionTrue, workspacesv1alpha1.ConditionReasonOwnerResolved)
+					expectCondition(w, workspacesv1alpha1.ConditionTypeSpaceProvisioned, metav1.ConditionTrue, workspacesv1alpha1.ConditionReasonSpaceProvisioned)
+					expectCondition(w, workspacesv1alpha1.ConditionTypeVisibilityApplied, metav1.ConditionTrue, workspacesv1alpha1.ConditionReasonVisibilityApplied)
+					expectCondition(w, workspacesv1alpha1.ConditionTypeReady, metav1.ConditionTrue, workspacesv1alpha1.ConditionReasonEverythingFine)
+					Expect(w.Status.ObservedGeneration).To(Equal(workspace.Generation))
+				})
+			})
+
+			When("neither Owner's UserSignup nor Space exist", func() {
+				It("reports both the missing owner and the missing Space", func() {
+					// when
+					w, err := reconcileAndGetWorkspace()
+
+					// then
+					Expect(err).NotTo(HaveOccurred())
+					expectCondition(w, workspacesv1alpha1.ConditionTypeOwnerResolved, metav1.ConditionFalse, workspacesv1alpha1.ConditionReasonOwnerNotFound)
+					expectCondition(w, workspacesv1alpha1.ConditionTypeSpaceProvisioned, metav1.ConditionFalse, workspacesv1alpha1.ConditionReasonSpaceNotFound)
+					expectCondition(w, workspacesv1alpha1.ConditionTypeReady, metav1.ConditionFalse, workspacesv1alpha1.ConditionReasonOwnerNotFound)
+				})
+			})
+
+			When("the Visibility is not valid", func() {
+				BeforeEach(func() {
+					workspace.Spec.Visibility = "invalid"
+					clientBuilder = clientBuilder.WithObjects(&owner, &space)
+				})
+
+				It("sets VisibilityApplied and Ready conditions to False", func() {
+					// when
+					w, err := reconcileAndGetWorkspace()
+
+					// then
+					Expect(err).To(MatchError(internalworkspace.ErrNonTransient))
+					expectCondition(w, workspacesv1alpha1.ConditionTypeVisibilityApplied, metav1.ConditionFalse, workspacesv1alpha1.ConditionReasonInvalidVisibility)
+					expectCondition(w, workspacesv1alpha1.ConditionTypeReady, metav1.ConditionFalse, workspacesv1alpha1.ConditionReasonInvalidVisibility)
+					Expect(w.Status.ObservedGeneration).To(Equal(workspace.Generation))
+				})
+			})
+
+			When("the community SpaceBinding can not be created", func() {
+				errCreateSpaceBinding := fmt.Errorf("unexpected error creating SpaceBinding")
+
+				BeforeEach(func() {
+					clientBuilder = clientBuilder.WithObjects(&owner, &space).WithInterceptorFuncs(interceptor.Funcs{
+						Create: func(ctx context.Context, client client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+							if sb, ok := obj.(*toolchainv1alpha1.SpaceBinding); ok && sb.Name == fmt.Sprintf("%s-community", workspaceName) {
+								return errCreateSpaceBinding
+							}
+							return client.Create(ctx, obj, opts...)
+						},
+					})
+				})
+
+				It("sets VisibilityApplied and Ready conditions to False", func() {
+					// when
+					w, err := reconcileAndGetWorkspace()
+
+					// then
+					Expect(err).To(MatchError(errCreateSpaceBinding))
+					expectCondition(w, workspacesv1alpha1.ConditionTypeVisibilityApplied, metav1.ConditionFalse, workspacesv1alpha1.ConditionReasonVisibilityNotApplied)
+					expectCondition(w, workspacesv1alpha1.ConditionTypeReady, metav1.ConditionFalse, workspacesv1alpha1.ConditionReasonVisibilityNotApplied)
+				})
+			})
+
+			When("the Space can not be provisioned", func() {
+				errUpdateSpace := fmt.Errorf("unexpected error updating Space")
+
+				BeforeEach(func() {
+					clientBuilder = clientBuilder.WithObjects(&owner, &space).WithInterceptorFuncs(interceptor.Funcs{
+						Update: func(ctx context.Context, client client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+							if _, ok := obj.(*toolchainv1alpha1.Space); ok {
+								return errUpdateSpace
+							}
+							return client.Update(ctx, obj, opts...)
+						},
+					})
+				})
+
+				It("sets SpaceProvisioned and Ready conditions to False", func() {
+					// when
+					w, err := reconcileAndGetWorkspace()
+
+					// then
+					Expect(err).To(MatchError(errUpdateSpace))
+					expectCondition(w, workspacesv1alpha1.ConditionTypeSpaceProvisioned, metav1.ConditionFalse, workspacesv1alpha1.ConditionReasonSpaceProvisioningFailed)
+					expectCondition(w, workspacesv1alpha1.ConditionTypeVisibilityApplied, metav1.ConditionTrue, workspacesv1alpha1.ConditionReasonVisibilityApplied)
+					expectCondition(w, workspacesv1alpha1.ConditionTypeReady, metav1.ConditionFalse, workspacesv1alpha1.ConditionReasonSpaceProvisioningFailed)
+				})
+			})
+		})
+
+		Context("events", func() {
+			reconcileAndGetEvents := func() ([]string, error) {
+				r = buildReconciler()
+				key := client.ObjectKeyFromObject(&workspace)
+				_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+				return recordedEvents(), err
+			}
+
+			When("the Owner's UserSignup does not exist", func() {
+				It("records a Warning event", func() {
+					// when
+					ee, err := reconcileAndGetEvents()
+
+					// then
+					Expect(err).NotTo(HaveOccurred())
+					Expect(ee).To(ContainElement(fmt.Sprintf("Warning %s UserSignup with Sub %s not found",
+						workspacesv1alpha1.EventReasonOwnerNotFound, ownerSub)))
+				})
+			})
+
+			When("the Owner's UserSignup and Space exist", func() {
+				BeforeEach(func() {
+					clientBuilder = clientBuilder.WithObjects(&owner, &space)
+				})
+
+				It("records the owner resolution and the created SpaceBindings", func() {
+					// when
+					ee, err := reconcileAndGetEvents()
+
+					// then
+					Expect(err).NotTo(HaveOccurred())
+					Expect(ee).To(ConsistOf(
+						fmt.Sprintf("Normal %s Owner resolved to user owner", workspacesv1alpha1.EventReasonOwnerResolved),
+						fmt.Sprintf("Normal %s SpaceBinding %s-owner created for owner owner", workspacesv1alpha1.EventReasonSpaceBindingCreated, workspaceName),
+						fmt.Sprintf("Normal %s Visibility set to community, SpaceBinding %s-community created", workspacesv1alpha1.EventReasonVisibilityChanged, workspaceName),
+					))
+				})
+
+				It("records no events when nothing changes", func() {
+					// given
+					_, err := reconcileAndGetEvents()
+					Expect(err).NotTo(HaveOccurred())
+
+					// when
+					_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&workspace)})
+
+					// then
+					Expect(err).NotTo(HaveOccurred())
+					Expect(recordedEvents()).To(BeEmpty())
+				})
+			})
+
+			When("the Visibility is changed to private", func() {
+				BeforeEach(func() {
+					workspace.Spec.Visibility = workspacesv1alpha1.InternalWorkspaceVisibilityPrivate
+					clientBuilder = clientBuilder.WithObjects(&owner, &space, &toolchainv1alpha1.SpaceBinding{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      fmt.Sprintf("%s-community", workspaceName),
+							Namespace: kubesawNamespace,
+						},
+					})
+				})
+
+				It("records the deletion of the community SpaceBinding", func() {
+					// when
+					ee, err := reconcileAndGetEvents()
+
+					// then
+					Expect(err).NotTo(HaveOccurred())
+					Expect(ee).To(ContainElement(fmt.Sprintf("Normal %s Visibility set to private, SpaceBinding %s-community deleted",
+						workspacesv1alpha1.EventReasonVisibilityChanged, workspaceName)))
+				})
+			})
+
+			When("the Space disappears", func() {
+				BeforeEach(func() {
+					workspace.Status.Conditions = []metav1.Condition{{
+						Type:   workspacesv1alpha1.ConditionTypeSpaceProvisioned,
+						Reason: workspacesv1alpha1.ConditionReasonSpaceProvisioned,
+						Status: metav1.ConditionTrue,
+					}}
+				})
+
+				It("records a Warning event", func() {
+					// when
+					ee, err := reconcileAndGetEvents()
+
+					// then
+					Expect(err).NotTo(HaveOccurred())
+					Expect(ee).To(ContainElement(fmt.Sprintf("Warning %s Space %s not found",
+						workspacesv1alpha1.EventReasonSpaceNotFound, workspaceName)))
+				})
+			})
+
+			When("a member's SpaceBinding can not be created", func() {
+				errCreateSpaceBinding := fmt.Errorf("unexpected error creating SpaceBinding")
+
+				BeforeEach(func() {
+					workspace.Spec.Members = []workspacesv1alpha1.InternalWorkspaceMember{
+						{Username: "alice", Role: workspacesv1alpha1.InternalWorkspaceRoleViewer},
+					}
+					clientBuilder = clientBuilder.WithObjects(&owner, &space).WithInterceptorFuncs(interceptor.Funcs{
+						Create: func(ctx context.Context, client client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+							if sb, ok := obj.(*toolchainv1alpha1.SpaceBinding); ok && sb.Spec.MasterUserRecord == "alice" {
+								return errCreateSpaceBinding
+							}
+							return client.Create(ctx, obj, opts...)
+						},
+					})
+				})
+
+				It("records a Warning event", func() {
+					// when
+					ee, err := reconcileAndGetEvents()
+
+					// then
+					Expect(err).To(MatchError(errCreateSpaceBinding))
+					Expect(ee).To(ContainElement(fmt.Sprintf("Warning %s %s",
+						workspacesv1alpha1.EventReasonMembersNotGranted, errCreateSpaceBinding.Error())))
+				})
+			})
+		})
+
+		Context("owner's state", func() {
+			reconcileAndGetOwnerActiveCondition := func() *metav1.Condition {
+				GinkgoHelper()
+				key := client.ObjectKeyFromObject(&workspace)
+				r = buildReconciler()
+
+				res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+				Expect(res).To(BeZero())
+				Expect(err).NotTo(HaveOccurred())
+
+				w := workspacesv1alpha1.InternalWorkspace{}
+				Expect(r.Get(ctx, key, &w)).To(Succeed())
+				return meta.FindStatusCondition(w.Status.Conditions, workspacesv1alpha1.ConditionTypeOwnerActive)
+			}
+
+			When("the Owner's UserSignup is active", func() {
+				BeforeEach(func() {
+					clientBuilder = clientBuilder.WithObjects(&owner, &space)
+				})
+
+				It("sets OwnerActive condition to True", func() {
+					c := reconcileAndGetOwnerActiveCondition()
+					Expect(c).NotTo(BeNil())
+					Expect(c.Status).To(Equal(metav1.ConditionTrue))
+					Expect(c.Reason).To(Equal(workspacesv1alpha1.ConditionReasonOwnerActive))
+				})
+			})
+
+			When("the Owner's UserSignup is deactivated", func() {
+				BeforeEach(func() {
+					owner.Spec.States = []toolchainv1alpha1.UserSignupState{toolchainv1alpha1.UserSignupStateDeactivated}
+					clientBuilder = clientBuilder.WithObjects(&owner, &space)
+				})
+
+				It("sets OwnerActive condition to False", func() {
+					c := reconcileAndGetOwnerActiveCondition()
+					Expect(c).NotTo(BeNil())
+					Expect(c.Status).To(Equal(metav1.ConditionFalse))
+					Expect(c.Reason).To(Equal(workspacesv1alpha1.ConditionReasonOwnerDeactivated))
+				})
+			})
+
+			When("the Owner's UserSignup is labeled as banned", func() {
+				BeforeEach(func() {
+					owner.Labels = map[string]string{
+						toolchainv1alpha1.UserSignupStateLabelKey: toolchainv1alpha1.UserSignupStateLabelValueBanned,
+					}
+					clientBuilder = clientBuilder.WithObjects(&owner, &space)
+				})
+
+				It("sets OwnerActive condition to False", func() {
+					c := reconcileAndGetOwnerActiveCondition()
+					Expect(c).NotTo(BeNil())
+					Expect(c.Status).To(Equal(metav1.ConditionFalse))
+					Expect(c.Reason).To(Equal(workspacesv1alpha1.ConditionReasonOwnerBanned))
+				})
+			})
+
+			When("the Owner's UserSignup does not exist", func() {
+				BeforeEach(func() {
+					clientBuilder = clientBuilder.WithObjects(&space)
+				})
+
+				It("sets OwnerActive condition to Unknown", func() {
+					c := reconcileAndGetOwnerActiveCondition()
+					Expect(c).NotTo(BeNil())
+					Expect(c.Status).To(Equal(metav1.ConditionUnknown))
+					Expect(c.Reason).To(Equal(workspacesv1alpha1.ConditionReasonOwnerNotFound))
+				})
+			})
+		})
+
+		When("the InternalWorkspace is reconciled", func() {
+			var updates, statusUpdates int
+
+			BeforeEach(func() {
+				updates, statusUpdates = 0, 0
+				clientBuilder = clientBuilder.WithObjects(&owner, &space).WithInterceptorFuncs(interceptor.Funcs{
+					Update: func(ctx context.Context, client client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+						if _, ok := obj.(*workspacesv1alpha1.InternalWorkspace); ok {
+							updates++
+						}
+						return client.Update(ctx, obj, opts...)
+					},
+					SubResourceUpdate: func(ctx context.Context, client client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						if _, ok := obj.(*workspacesv1alpha1.InternalWorkspace); ok && subResourceName == "status" {
+							statusUpdates++
+						}
+						return client.Status().Update(ctx, obj, opts...)
+					},
+				})
+			})
+
+			It("writes the status once", func() {
+				// given
+				r = buildReconciler()
+				key := client.ObjectKeyFromObject(&workspace)
+
+				// when
+				_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updates).To(Equal(1), "the finalizer is expected to be set")
+				Expect(statusUpdates).To(Equal(1))
+
+				w := workspacesv1alpha1.InternalWorkspace{}
+				Expect(r.Get(ctx, key, &w)).To(Succeed())
+				Expect(w.Finalizers).To(ContainElement(workspacesv1alpha1.FinalizerCleanup))
+				Expect(meta.IsStatusConditionTrue(w.Status.Conditions, workspacesv1alpha1.ConditionTypeReady)).To(BeTrue())
+			})
+
+			It("writes nothing when nothing changes", func() {
+				// given
+				r = buildReconciler()
+				key := client.ObjectKeyFromObject(&workspace)
+				_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+				Expect(err).ToNot(HaveOccurred())
+				updates, statusUpdates = 0, 0
+
+				// when
+				_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updates).To(BeZero())
+				Expect(statusUpdates).To(BeZero())
+			})
+		})
+
+		Context("signup to ready", func() {
+			// signupToReadyCount returns the number of observations of the signup-to-ready histogram
+			signupToReadyCount := func() uint64 {
+				m := &dto.Metric{}
+				Expect(metrics.SignupToReady.Write(m)).To(Succeed())
+				return m.GetHistogram().GetSampleCount()
+			}
+
+			var count uint64
+
+			BeforeEach(func() {
+				owner.Status.Conditions = []toolchainv1alpha1.Condition{
+					{
+						Type:               toolchainv1alpha1.UserSignupApproved,
+						Status:             v1.ConditionTrue,
+						LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Minute)),
+					},
+				}
+				count = signupToReadyCount()
+			})
+
+			When("the home InternalWorkspace becomes Ready", func() {
+				BeforeEach(func() {
+					workspace.Spec.DisplayName = "default"
+					clientBuilder = clientBuilder.WithObjects(&owner, &space)
+				})
+
+				It("records the first ready time and observes the signup to ready time", func() {
+					// given
+					r = buildReconciler()
+					key := client.ObjectKeyFromObject(&workspace)
+
+					// when
+					_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+
+					// then
+					Expect(err).ToNot(HaveOccurred())
+					Expect(signupToReadyCount()).To(Equal(count + 1))
+
+					w := workspacesv1alpha1.InternalWorkspace{}
+					Expect(r.Get(ctx, key, &w)).To(Succeed())
+					Expect(w.Status.FirstReadyTime).NotTo(BeNil())
+				})
+
+				It("does not observe the signup to ready time again", func() {
+					// given
+					r = buildReconciler()
+					key := client.ObjectKeyFromObject(&workspace)
+					_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+					Expect(err).ToNot(HaveOccurred())
+
+					// when
+					_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+
+					// then
+					Expect(err).ToNot(HaveOccurred())
+					Expect(signupToReadyCount()).To(Equal(count + 1))
+				})
+			})
+
+			When("the home InternalWorkspace was already Ready", func() {
+				BeforeEach(func() {
+					workspace.Spec.DisplayName = "default"
+					meta.SetStatusCondition(&workspace.Status.Conditions, metav1.Condition{
+						Type:   workspacesv1alpha1.ConditionTypeReady,
+						Status: metav1.ConditionTrue,
+						Reason: workspacesv1alpha1.ConditionReasonEverythingFine,
+					})
+					clientBuilder = clientBuilder.WithObjects(&owner, &space)
+				})
+
+				It("records the first ready time without observing the signup to ready time", func() {
+					// given
+					r = buildReconciler()
+					key := client.ObjectKeyFromObject(&workspace)
+
+					// when
+					_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+
+					// then
+					Expect(err).ToNot(HaveOccurred())
+					Expect(signupToReadyCount()).To(Equal(count))
+
+					w := workspacesv1alpha1.InternalWorkspace{}
+					Expect(r.Get(ctx, key, &w)).To(Succeed())
+					Expect(w.Status.FirstReadyTime).NotTo(BeNil())
+				})
+			})
+
+			When("a non-home InternalWorkspace becomes Ready", func() {
+				BeforeEach(func() {
+					clientBuilder = clientBuilder.WithObjects(&owner, &space)
+				})
+
+				It("does not observe the signup to ready time", func() {
+					// given
+					r = buildReconciler()
+					key := client.ObjectKeyFromObject(&workspace)
+
+					// when
+					_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+
+					// then
+					Expect(err).ToNot(HaveOccurred())
+					Expect(signupToReadyCount()).To(Equal(count))
+				})
+			})
+		})
+
+		When("the InternalWorkspace has no finalizer", func() {
+			BeforeEach(func() {
+				clientBuilder = clientBuilder.WithObjects(&owner, &space)
+			})
+
+			It("sets the cleanup finalizer", func() {
+				// given
+				r = buildReconciler()
+				key := client.ObjectKeyFromObject(&workspace)
+
+				// when
+				res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(BeZero())
+
+				w := workspacesv1alpha1.InternalWorkspace{}
+				Expect(r.Get(ctx, key, &w)).To(Succeed())
+				Expect(w.Finalizers).To(ContainElement(workspacesv1alpha1.FinalizerCleanup))
+			})
+		})
+
+		Context("display name uniqueness", func() {
+			var older workspacesv1alpha1.InternalWorkspace
+
+			BeforeEach(func() {
+				workspace.Spec.DisplayName = "my-workspace"
+				workspace.CreationTimestamp = metav1.NewTime(time.Date(2024, time.January, 1, 0, 1, 0, 0, time.UTC))
+
+				older = *workspace.DeepCopy()
+				older.Name = "older-workspace"
+				older.CreationTimestamp = metav1.NewTime(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+				clientBuilder = clientBuilder.WithObjects(&owner, &older)
+			})
+
+			It("does not provision the newest InternalWorkspace with the same display name", func() {
+				// given
+				r = buildReconciler()
+				key := client.ObjectKeyFromObject(&workspace)
+
+				// when
+				_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+
+				// then
+				Expect(err).NotTo(HaveOccurred())
+
+				w := workspacesv1alpha1.InternalWorkspace{}
+				Expect(r.Get(ctx, key, &w)).To(Succeed())
+				c := meta.FindStatusCondition(w.Status.Conditions, workspacesv1alpha1.ConditionTypeDisplayNameUnique)
+				Expect(c).NotTo(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				Expect(c.Reason).To(Equal(workspacesv1alpha1.ConditionReasonDisplayNameConflict))
+				Expect(meta.FindStatusCondition(w.Status.Conditions, workspacesv1alpha1.ConditionTypeReady).Reason).
+					To(Equal(workspacesv1alpha1.ConditionReasonDisplayNameConflict))
+
+				s := toolchainv1alpha1.Space{}
+				err = r.Get(ctx, client.ObjectKey{Name: workspace.Name, Namespace: kubesawNamespace}, &s)
+				Expect(kerrors.IsNotFound(err)).To(BeTrue())
+				Expect(recordedEvents()).To(ContainElement(HavePrefix("Warning DisplayNameConflict")))
+			})
+
+			It("provisions the oldest InternalWorkspace with the same display name", func() {
+				// given
+				r = buildReconciler()
+				key := client.ObjectKeyFromObject(&older)
+
+				// when
+				_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+
+				// then
+				Expect(err).NotTo(HaveOccurred())
+
+				w := workspacesv1alpha1.InternalWorkspace{}
+				Expect(r.Get(ctx, key, &w)).To(Succeed())
+				Expect(meta.IsStatusConditionTrue(w.Status.Conditions, workspacesv1alpha1.ConditionTypeDisplayNameUnique)).To(BeTrue())
+
+				s := toolchainv1alpha1.Space{}
+				Expect(r.Get(ctx, client.ObjectKey{Name: older.Name, Namespace: kubesawNamespace}, &s)).To(Succeed())
+			})
+
+			It("provisions the InternalWorkspace if the older one with the same display name has another owner", func() {
+				// given
+				r = buildReconciler()
+				o := workspacesv1alpha1.InternalWorkspace{}
+				Expect(r.Get(ctx, client.ObjectKeyFromObject(&older), &o)).To(Succeed())
+				o.Spec.Owner.JwtInfo.Sub = "another-sub"
+				Expect(r.Update(ctx, &o)).To(Succeed())
+				key := client.ObjectKeyFromObject(&workspace)
+
+				// when
+				_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+
+				// then
+				Expect(err).NotTo(HaveOccurred())
+
+				w := workspacesv1alpha1.InternalWorkspace{}
+				Expect(r.Get(ctx, key, &w)).To(Succeed())
+				Expect(meta.IsStatusConditionTrue(w.Status.Conditions, workspacesv1alpha1.ConditionTypeDisplayNameUnique)).To(BeTrue())
+			})
+		})
+
+		Context("Space provisioning", func() {
+			ownerSpaceBindingKey := client.ObjectKey{
+				Name:      fmt.Sprintf("%s-owner", workspaceName),
+				Namespace: kubesawNamespace,
+			}
+			spaceKey := client.ObjectKey{
+				Name:      workspaceName,
+				Namespace: kubesawNamespace,
+			}
+
+			When("the Owner's UserSignup exists", func() {
+				BeforeEach(func() {
+					clientBuilder = clientBuilder.WithObjects(&owner)
+				})
+
+				It("creates the Space and the owner's SpaceBinding", func() {
+					// given
+					r = buildReconciler()
+					key := client.ObjectKeyFromObject(&workspace)
+
+					// when
+					res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+
+					// then
+					Expect(err).ToNot(HaveOccurred())
+					Expect(res).To(BeZero())
+
+					s := toolchainv1alpha1.Space{}
+					Expect(r.Get(ctx, spaceKey, &s)).To(Succeed())
+					Expect(s.Labels).To(HaveKeyWithValue(toolchainv1alpha1.SpaceCreatorLabelKey, owner.Status.CompliantUsername))
+
+					sb := toolchainv1alpha1.SpaceBinding{}
+					Expect(r.Get(ctx, ownerSpaceBindingKey, &sb)).To(Succeed())
+					Expect(sb.Spec.MasterUserRecord).To(Equal(owner.Status.CompliantUsername))
+					Expect(sb.Spec.Space).To(Equal(workspace.Name))
+					Expect(sb.Spec.SpaceRole).To(Equal("admin"))
+					Expect(sb.Labels).To(And(
+						HaveKeyWithValue(toolchainv1alpha1.SpaceBindingMasterUserRecordLabelKey, owner.Status.CompliantUsername),
+						HaveKeyWithValue(toolchainv1alpha1.SpaceBindingSpaceLabelKey, workspace.Name)))
+				})
+			})
+
+			When("the ownership has been transferred", func() {
+				var newOwner toolchainv1alpha1.UserSignup
+
+				BeforeEach(func() {
+					newOwner = *owner.DeepCopy()
+					newOwner.Name = "new-owner"
+					newOwner.Spec.IdentityClaims.Sub = "new-owner-sub"
+					newOwner.Status.CompliantUsername = "new-owner"
+
+					workspace.Spec.Owner.JwtInfo.Sub = newOwner.Spec.IdentityClaims.Sub
+					workspace.Status.Owner.Username = owner.Status.CompliantUsername
+					clientBuilder = clientBuilder.WithObjects(&owner, &newOwner, &toolchainv1alpha1.SpaceBinding{
+						ObjectMeta: corev1.ObjectMeta{
+							Name:      ownerSpaceBindingKey.Name,
+							Namespace: ownerSpaceBindingKey.Namespace,
+						},
+						Spec: toolchainv1alpha1.SpaceBindingSpec{
+							Space:            workspace.Name,
+							MasterUserRecord: owner.Status.CompliantUsername,
+							SpaceRole:        "admin",
+						},
+					})
+				})
+
+				It("moves the InternalWorkspace to the new owner and rebinds the owner's SpaceBinding", func() {
+					// given
+					r = buildReconciler()
+					key := client.ObjectKeyFromObject(&workspace)
+
+					// when
+					res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+
+					// then
+					Expect(err).ToNot(HaveOccurred())
+					Expect(res).To(BeZero())
+
+					w := workspacesv1alpha1.InternalWorkspace{}
+					Expect(r.Get(ctx, key, &w)).To(Succeed())
+					Expect(w.Status.Owner.Username).To(Equal(newOwner.Status.CompliantUsername))
+
+					s := toolchainv1alpha1.Space{}
+					Expect(r.Get(ctx, spaceKey, &s)).To(Succeed())
+					Expect(s.Labels).To(HaveKeyWithValue(toolchainv1alpha1.SpaceCreatorLabelKey, newOwner.Status.CompliantUsername))
+
+					sb := toolchainv1alpha1.SpaceBinding{}
+					Expect(r.Get(ctx, ownerSpaceBindingKey, &sb)).To(Succeed())
+					Expect(sb.Spec.MasterUserRecord).To(Equal(newOwner.Status.CompliantUsername))
+					Expect(sb.Spec.SpaceRole).To(Equal("admin"))
+					Expect(sb.Labels).To(HaveKeyWithValue(toolchainv1alpha1.SpaceBindingMasterUserRecordLabelKey, newOwner.Status.CompliantUsername))
+				})
+			})
+
+			When("the Owner's UserSignup does not exist", func() {
+				It("does not create the Space", func() {
+					// given
+					r = buildReconciler()
+					key := client.ObjectKeyFromObject(&workspace)
+
+					// when
+					res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+
+					// then
+					Expect(err).ToNot(HaveOccurred())
+					Expect(res).To(BeZero())
+
+					err = r.Get(ctx, spaceKey, &toolchainv1alpha1.Space{})
+					Expect(err).To(MatchError(kerrors.IsNotFound, "IsNotFound error expected"))
+					err = r.Get(ctx, ownerSpaceBindingKey, &toolchainv1alpha1.SpaceBinding{})
+					Expect(err).To(MatchError(kerrors.IsNotFound, "IsNotFound error expected"))
+				})
+			})
+
+			When("the InternalWorkspace is the owner's home workspace", func() {
+				BeforeEach(func() {
+					workspace.Spec.DisplayName = "default"
+					clientBuilder = clientBuilder.WithObjects(&owner)
+				})
+
+				It("does not create the Space", func() {
+					// given
+					r = buildReconciler()
+					key := client.ObjectKeyFromObject(&workspace)
+
+					// when
+					res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+
+					// then
+					Expect(err).ToNot(HaveOccurred())
+					Expect(res).To(BeZero())
+
+					err = r.Get(ctx, spaceKey, &toolchainv1alpha1.Space{})
+					Expect(err).To(MatchError(kerrors.IsNotFound, "IsNotFound error expected"))
+					err = r.Get(ctx, ownerSpaceBindingKey, &toolchainv1alpha1.SpaceBinding{})
+					Expect(err).To(MatchError(kerrors.IsNotFound, "IsNotFound error expected"))
+				})
+			})
+		})
+
+		Context("members SpaceBindings management", func() {
+			memberSpaceBindingKey := func(member string) client.ObjectKey {
+				return client.ObjectKey{Name: fmt.Sprintf("%s-member-%s", workspaceName, member), Namespace: kubesawNamespace}
+			}
+
+			reconcile := func() {
+				GinkgoHelper()
+				r = buildReconciler()
+				res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&workspace)})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(BeZero())
+			}
+
+			BeforeEach(func() {
+				clientBuilder = clientBuilder.WithObjects(&owner, &space)
+			})
+
+			It("creates a SpaceBinding for each member", func() {
+				// given
+				workspace.Spec.Members = []workspacesv1alpha1.InternalWorkspaceMember{
+					{Username: "viewer-user", Role: workspacesv1alpha1.InternalWorkspaceRoleViewer},
+					{Username: "admin-user", Role: workspacesv1alpha1.InternalWorkspaceRoleAdmin},
+				}
+
+				// when
+				reconcile()
+
+				// then
+				for _, m := range workspace.Spec.Members {
+					sb := toolchainv1alpha1.SpaceBinding{}
+					Expect(r.Get(ctx, memberSpaceBindingKey(m.Username), &sb)).To(Succeed())
+					Expect(sb.Spec.MasterUserRecord).To(Equal(m.Username))
+					Expect(sb.Spec.Space).To(Equal(workspace.Name))
+					Expect(sb.Spec.SpaceRole).To(Equal(string(m.Role)))
+					Expect(sb.Labels).To(And(
+						HaveKeyWithValue(toolchainv1alpha1.SpaceBindingMasterUserRecordLabelKey, m.Username),
+						HaveKeyWithValue(toolchainv1alpha1.SpaceBindingSpaceLabelKey, workspace.Name),
+						HaveKeyWithValue(workspacesv1alpha1.LabelMember, "true"),
+					))
+				}
+			})
+
+			It("does not create a member SpaceBinding for the owner", func() {
+				// given
+				workspace.Spec.Members = []workspacesv1alpha1.InternalWorkspaceMember{
+					{Username: owner.Status.CompliantUsername, Role: workspacesv1alpha1.InternalWorkspaceRoleViewer},
+				}
+
+				// when
+				reconcile()
+
+				// then
+				err := r.Get(ctx, memberSpaceBindingKey(owner.Status.CompliantUsername), &toolchainv1alpha1.SpaceBinding{})
+				Expect(err).To(MatchError(kerrors.IsNotFound, "IsNotFound error expected"))
+			})
+
+			It("updates the role of existing members", func() {
+				// given
+				workspace.Spec.Members = []workspacesv1alpha1.InternalWorkspaceMember{
+					{Username: "member", Role: workspacesv1alpha1.InternalWorkspaceRoleMaintainer},
+				}
+				k := memberSpaceBindingKey("member")
+				clientBuilder = clientBuilder.WithObjects(&toolchainv1alpha1.SpaceBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: k.Name, Namespace: k.Namespace},
+					Spec: toolchainv1alpha1.SpaceBindingSpec{
+						MasterUserRecord: "member",
+						Space:            workspace.Name,
+						SpaceRole:        string(workspacesv1alpha1.InternalWorkspaceRoleViewer),
+					},
+				})
+
+				// when
+				reconcile()
+
+				// then
+				sb := toolchainv1alpha1.SpaceBinding{}
+				Expect(r.Get(ctx, k, &sb)).To(Succeed())
+				Expect(sb.Spec.SpaceRole).To(Equal(string(workspacesv1alpha1.InternalWorkspaceRoleMaintainer)))
+			})
+
+			It("counts the correction of a drifted member SpaceBinding", func() {
+				// given
+				workspace.Spec.Members = []workspacesv1alpha1.InternalWorkspaceMember{
+					{Username: "member", Role: workspacesv1alpha1.InternalWorkspaceRoleViewer},
+				}
+				k := memberSpaceBindingKey("member")
+				clientBuilder = clientBuilder.WithObjects(&toolchainv1alpha1.SpaceBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: k.Name, Namespace: k.Namespace},
+					Spec: toolchainv1alpha1.SpaceBindingSpec{
+						MasterUserRecord: "member",
+						Space:            "another-space",
+						SpaceRole:        string(workspacesv1alpha1.InternalWorkspaceRoleViewer),
+					},
+				})
+				c := metrics.SpaceBindingDriftCorrections.WithLabelValues(metrics.SpaceBindingKindMember)
+				before := testutil.ToFloat64(c)
+
+				// when
+				reconcile()
+
+				// then
+				Expect(testutil.ToFloat64(c)).To(Equal(before + 1))
+				sb := toolchainv1alpha1.SpaceBinding{}
+				Expect(r.Get(ctx, k, &sb)).To(Succeed())
+				Expect(sb.Spec.Space).To(Equal(workspace.Name))
+			})
+
+			It("does not count the change of a member's role as a drift", func() {
+				// given
+				workspace.Spec.Members = []workspacesv1alpha1.InternalWorkspaceMember{
+					{Username: "member", Role: workspacesv1alpha1.InternalWorkspaceRoleMaintainer},
+				}
+				k := memberSpaceBindingKey("member")
+				clientBuilder = clientBuilder.WithObjects(&toolchainv1alpha1.SpaceBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: k.Name, Namespace: k.Namespace},
+					Spec: toolchainv1alpha1.SpaceBindingSpec{
+						MasterUserRecord: "member",
+						Space:            workspace.Name,
+						SpaceRole:        string(workspacesv1alpha1.InternalWorkspaceRoleViewer),
+					},
+				})
+				c := metrics.SpaceBindingDriftCorrections.WithLabelValues(metrics.SpaceBindingKindMember)
+				before := testutil.ToFloat64(c)
+
+				// when
+				reconcile()
+
+				// then
+				Expect(testutil.ToFloat64(c)).To(Equal(before))
+			})
+
+			It("deletes the SpaceBindings of former members", func() {
+				// given
+				k := memberSpaceBindingKey("former-member")
+				clientBuilder = clientBuilder.WithObjects(&toolchainv1alpha1.SpaceBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      k.Name,
+						Namespace: k.Namespace,
+						Labels: map[string]string{
+							toolchainv1alpha1.SpaceBindingMasterUserRecordLabelKey: "former-member",
+							toolchainv1alpha1.SpaceBindingSpaceLabelKey:            workspace.Name,
+							workspacesv1alpha1.LabelMember:                         "true",
+						},
+					},
+					Spec: toolchainv1alpha1.SpaceBindingSpec{
+						MasterUserRecord: "former-member",
+						Space:            workspace.Name,
+						SpaceRole:        string(workspacesv1alpha1.InternalWorkspaceRoleViewer),
+					},
+				})
+
+				// when
+				reconcile()
+
+				// then
+				err := r.Get(ctx, k, &toolchainv1alpha1.SpaceBinding{})
+				Expect(err).To(MatchError(kerrors.IsNotFound, "IsNotFound error expected"))
+			})
+
+			It("does not delete SpaceBindings not created for members", func() {
+				// given
+				workspace.Spec.Visibility = workspacesv1alpha1.InternalWorkspaceVisibilityPrivate
+				sb := toolchainv1alpha1.SpaceBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "kubesaw-managed",
+						Namespace: kubesawNamespace,
+						Labels: map[string]string{
+							toolchainv1alpha1.SpaceBindingMasterUserRecordLabelKey: "another-user",
+							toolchainv1alpha1.SpaceBindingSpaceLabelKey:            workspace.Name,
+						},
+					},
+				}
+				clientBuilder = clientBuilder.WithObjects(&sb)
+
+				// when
+				reconcile()
+
+				// then
+				Expect(r.Get(ctx, client.ObjectKeyFromObject(&sb), &toolchainv1alpha1.SpaceBinding{})).To(Succeed())
+			})
+		})
+
 		Context("community SpaceBinding management", func() {
 			communitySpaceBinding := toolchainv1alpha1.SpaceBinding{
 				ObjectMeta: metav1.ObjectMeta{
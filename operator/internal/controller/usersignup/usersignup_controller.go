@@ -20,9 +20,11 @@ import (
 	"context"
 	"slices"
 
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -38,11 +40,13 @@ import (
 type UserSignupReconciler struct {
 	client.Client
 	Scheme              *runtime.Scheme
+	Recorder            record.EventRecorder
 	WorkspacesNamespace string
 }
 
 //+kubebuilder:rbac:groups=toolchain.dev.openshift.com,resources=usersignups,verbs=get;list;watch
 //+kubebuilder:rbac:groups=workspaces.konflux-ci.dev,resources=internalworkspaces,verbs=get;list;watch;create;update;patch;delete;deletecollection
+//+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -81,7 +85,7 @@ func (r *UserSignupReconciler) ensureWorkspaceIsPresentForHomeSpace(ctx context.
 			Namespace: r.WorkspacesNamespace,
 		},
 	}
-	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, w, func() error {
+	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, w, func() error {
 		if w.ObjectMeta.CreationTimestamp.IsZero() {
 			w.Spec.DisplayName = "default"
 			w.Spec.Visibility = workspacesv1alpha1.InternalWorkspaceVisibilityPrivate
@@ -103,6 +107,14 @@ func (r *UserSignupReconciler) ensureWorkspaceIsPresentForHomeSpace(ctx context.
 		return err
 	}
 
+	switch op {
+	case controllerutil.OperationResultCreated:
+		r.Recorder.Eventf(w, corev1.EventTypeNormal, workspacesv1alpha1.EventReasonHomeWorkspaceCreated,
+			"Home workspace created for UserSignup %s", u.Name)
+	case controllerutil.OperationResultUpdated:
+		r.Recorder.Eventf(w, corev1.EventTypeNormal, workspacesv1alpha1.EventReasonOwnerUpdated,
+			"Owner information updated from UserSignup %s", u.Name)
+	}
 	return nil
 }
 
@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	corev1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/konflux-workspaces/workspaces/operator/internal/controller/usersignup"
 
@@ -83,6 +84,7 @@ var _ = Describe("InternalWorkspaceController", func() {
 		r := &usersignup.UserSignupReconciler{
 			Client:              clientBuilder.Build(),
 			Scheme:              scheme,
+			Recorder:            record.NewFakeRecorder(10),
 			WorkspacesNamespace: workspacesNamespace,
 		}
 		res, err := r.Reconcile(ctx, req)
@@ -175,6 +177,8 @@ var _ = Describe("InternalWorkspaceController", func() {
 				Expect(iw.Spec.Owner.JwtInfo.Sub).To(Equal(aliceUserSignup.Spec.IdentityClaims.Sub))
 				Expect(iw.Spec.Owner.JwtInfo.Email).To(Equal(aliceUserSignup.Spec.IdentityClaims.Email))
 				Expect(iw.Spec.Owner.JwtInfo.UserId).To(Equal(aliceUserSignup.Spec.IdentityClaims.UserID))
+				Expect(r.Recorder.(*record.FakeRecorder).Events).To(Receive(Equal(fmt.Sprintf("Normal %s Owner information updated from UserSignup %s",
+					workspacesv1alpha1.EventReasonOwnerUpdated, aliceUserSignup.Name))))
 			})
 
 			It("forwards the error if update was not successful", func() {
@@ -211,6 +215,8 @@ var _ = Describe("InternalWorkspaceController", func() {
 				Expect(iw.Spec.Owner.JwtInfo.UserId).To(Equal(aliceUserSignup.Spec.IdentityClaims.UserID))
 				Expect(iw.Spec.DisplayName).To(Equal("default"))
 				Expect(iw.Spec.Visibility).To(Equal(workspacesv1alpha1.InternalWorkspaceVisibilityPrivate))
+				Expect(r.Recorder.(*record.FakeRecorder).Events).To(Receive(Equal(fmt.Sprintf("Normal %s Home workspace created for UserSignup %s",
+					workspacesv1alpha1.EventReasonHomeWorkspaceCreated, aliceUserSignup.Name))))
 			})
 
 			It("forwards the error if creation was not successful", func() {
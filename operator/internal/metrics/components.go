@@ -0,0 +1,83 @@
+/*
+Copyright 2024 The Workspaces Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const KonfluxWorkspacesComponentReady = "konflux_workspaces_component_ready"
+
+// ComponentsCollector measures whether each component of the underlying
+// kubesaw instance is ready, so that it is possible to find out which one
+// is making konflux_workspaces_available drop.
+// No measure is reported if the ToolchainStatus does not exist.
+type ComponentsCollector struct {
+	gauge          ToolchainStatusGauge
+	componentReady *prometheus.Desc
+}
+
+var _ prometheus.Collector = &ComponentsCollector{}
+
+func NewComponentsCollector(client client.Client, kubesawNamespace string) *ComponentsCollector {
+	return &ComponentsCollector{
+		gauge: NewToolchainStatusGauge(client, kubesawNamespace),
+		componentReady: prometheus.NewDesc(
+			KonfluxWorkspacesComponentReady,
+			"Whether a kubesaw component is ready.",
+			[]string{"component", "cluster"},
+			nil,
+		),
+	}
+}
+
+func (c *ComponentsCollector) Register() {
+	metrics.Registry.MustRegister(c)
+}
+
+// Describe implements prometheus.Collector
+func (c *ComponentsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.componentReady
+}
+
+// Collect implements prometheus.Collector
+func (c *ComponentsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	cc, err := c.gauge.Components(ctx)
+	switch {
+	case kerrors.IsNotFound(err):
+		return
+	case err != nil:
+		ch <- prometheus.NewInvalidMetric(c.componentReady, err)
+		return
+	}
+
+	for _, cmp := range cc {
+		v := 0.0
+		if cmp.Ready() {
+			v = 1.0
+		}
+		ch <- prometheus.MustNewConstMetric(c.componentReady, prometheus.GaugeValue, v, cmp.Name, cmp.Cluster)
+	}
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2024 The Workspaces Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const KonfluxWorkspacesSpaceBindingDriftCorrections = "konflux_workspaces_spacebinding_drift_corrections_total"
+
+const (
+	// SpaceBindingKindOwner is the kind of the SpaceBindings granting the owner the admin role
+	SpaceBindingKindOwner = "owner"
+	// SpaceBindingKindMember is the kind of the SpaceBindings granting access to the members
+	SpaceBindingKindMember = "member"
+	// SpaceBindingKindCommunity is the kind of the SpaceBindings granting access to community workspaces
+	SpaceBindingKindCommunity = "community"
+)
+
+// SpaceBindingDriftCorrections counts the SpaceBindings the reconciler had to
+// update because they diverged from their InternalWorkspace, e.g. after being
+// edited by hand.
+var SpaceBindingDriftCorrections = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: KonfluxWorkspacesSpaceBindingDriftCorrections,
+	Help: "Number of SpaceBindings updated because they diverged from their workspace.",
+}, []string{"kind"})
+
+func init() {
+	metrics.Registry.MustRegister(SpaceBindingDriftCorrections)
+}
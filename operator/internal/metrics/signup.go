@@ -0,0 +1,41 @@
+/*
+Copyright 2024 The Workspaces Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const KonfluxWorkspacesSignupToReadySeconds = "konflux_workspaces_signup_to_ready_seconds"
+
+// SignupToReadyBuckets are the upper bounds, in seconds, of the buckets of the
+// konflux_workspaces_signup_to_ready_seconds histogram
+var SignupToReadyBuckets = []float64{1, 5, 10, 30, 60, 120, 300, 600, 1800, 3600}
+
+// SignupToReady measures the time elapsed between the approval of the users'
+// UserSignups and their home InternalWorkspaces becoming Ready.
+// It is observed by the InternalWorkspace reconciler, once per home InternalWorkspace.
+var SignupToReady = prometheus.NewHistogram(prometheus.HistogramOpts{
+	Name:    KonfluxWorkspacesSignupToReadySeconds,
+	Help:    "Time elapsed between the approval of a UserSignup and the user's home workspace becoming Ready.",
+	Buckets: SignupToReadyBuckets,
+})
+
+func init() {
+	metrics.Registry.MustRegister(SignupToReady)
+}
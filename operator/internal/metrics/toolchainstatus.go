@@ -18,14 +18,14 @@ package metrics
 
 import (
 	"context"
-	"slices"
 
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
 	"github.com/prometheus/client_golang/prometheus"
-	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/konflux-workspaces/workspaces/operator/pkg/toolchainstatus"
 )
 
 //+kubebuilder:rbac:groups=toolchain.dev.openshift.com,resources=toolchainstatuses,verbs=get;list;watch
@@ -42,7 +42,7 @@ func NewToolchainStatusGauge(client client.Client, kubesawNamespace string) Tool
 }
 
 const KonfluxWorkspacesAvailable = "konflux_workspaces_available"
-const KubesawToolchainStatusName = "toolchain-status"
+const KubesawToolchainStatusName = toolchainstatus.Name
 
 func (r *ToolchainStatusGauge) Register(ctx context.Context) {
 	KubesawGauge := prometheus.NewGaugeFunc(prometheus.GaugeOpts{
@@ -58,50 +58,20 @@ func (r *ToolchainStatusGauge) Register(ctx context.Context) {
 }
 
 func (r *ToolchainStatusGauge) Status(ctx context.Context) bool {
-	toolchainstatus := toolchainv1alpha1.ToolchainStatus{}
-
-	err := r.Client.Get(ctx,
-		types.NamespacedName{Namespace: r.kubesawNamespace, Name: KubesawToolchainStatusName},
-		&toolchainstatus)
+	cc, err := r.Components(ctx)
 	if err != nil {
 		return false
 	}
+	return toolchainstatus.Ready(cc)
+}
 
-	conditions := slices.Concat(
-		toolchainstatus.Status.Conditions,
-		toolchainstatus.Status.HostRoutes.Conditions,
-	)
-
-	if toolchainstatus.Status.HostOperator != nil {
-		conditions = slices.Concat(conditions,
-			toolchainstatus.Status.HostOperator.Conditions,
-			toolchainstatus.Status.HostOperator.RevisionCheck.Conditions)
-	}
-
-	if toolchainstatus.Status.RegistrationService != nil {
-		conditions = slices.Concat(conditions,
-			toolchainstatus.Status.RegistrationService.Health.Conditions,
-			toolchainstatus.Status.RegistrationService.Deployment.Conditions,
-			toolchainstatus.Status.RegistrationService.RevisionCheck.Conditions)
-	}
-
-	// we ignore Che in member clusters since we don't care about Che in konflux
-	for _, member := range toolchainstatus.Status.Members {
-		conditions = append(conditions, member.MemberStatus.Conditions...)
-		if member.MemberStatus.Host != nil {
-			conditions = append(conditions, member.MemberStatus.Host.Conditions...)
-		}
-		if member.MemberStatus.HostConnection != nil {
-			conditions = append(conditions, member.MemberStatus.HostConnection.Conditions...)
-		}
-		if member.MemberStatus.Routes != nil {
-			conditions = append(conditions, member.MemberStatus.Routes.Conditions...)
-		}
+// Components returns the health of each component of the underlying kubesaw instance
+func (r *ToolchainStatusGauge) Components(ctx context.Context) ([]toolchainstatus.Component, error) {
+	ts := toolchainv1alpha1.ToolchainStatus{}
+	if err := r.Client.Get(ctx,
+		types.NamespacedName{Namespace: r.kubesawNamespace, Name: KubesawToolchainStatusName},
+		&ts); err != nil {
+		return nil, err
 	}
-
-	return conditions != nil &&
-		!slices.ContainsFunc(conditions, func(cond toolchainv1alpha1.Condition) bool {
-			return cond.Type == toolchainv1alpha1.ConditionReady &&
-				cond.Status != v1.ConditionTrue
-		})
+	return toolchainstatus.Components(&ts), nil
 }
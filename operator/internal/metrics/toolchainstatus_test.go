@@ -20,9 +20,11 @@ import (
 	"context"
 	_ "embed"
 	"encoding/json"
+	"strings"
 
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
 	"github.com/konflux-workspaces/workspaces/operator/internal/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -107,6 +109,57 @@ var _ = Describe("availabiltiy metrics", func() {
 				gauge := metrics.NewToolchainStatusGauge(clientBuilder.Build(), namespace)
 				Expect(gauge.Status(context.Background())).To(BeTrue())
 			})
+
+			It("should report every component as ready", func() {
+				collector := metrics.NewComponentsCollector(clientBuilder.Build(), namespace)
+				Expect(testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP konflux_workspaces_component_ready Whether a kubesaw component is ready.
+# TYPE konflux_workspaces_component_ready gauge
+konflux_workspaces_component_ready{cluster="",component="host-operator"} 1
+konflux_workspaces_component_ready{cluster="",component="host-routes"} 1
+konflux_workspaces_component_ready{cluster="",component="registration-service"} 1
+konflux_workspaces_component_ready{cluster="",component="toolchain"} 1
+konflux_workspaces_component_ready{cluster="member-fddgp-y57zo-3kr.5933.p3.openshiftapps.com",component="member"} 1
+`))).To(Succeed())
+			})
+		})
+
+		When("a member cluster is unready", func() {
+			BeforeEach(func() {
+				Expect(json.Unmarshal(goodToolchainStatus, &toolchainStatus)).NotTo(HaveOccurred())
+				toolchainStatus.Status.Members[0].MemberStatus.Conditions = []toolchainv1alpha1.Condition{
+					{
+						Type:   toolchainv1alpha1.ConditionReady,
+						Status: v1.ConditionFalse,
+						Reason: "ComponentsNotReady",
+					},
+				}
+			})
+
+			It("should return an unready status", func() {
+				gauge := metrics.NewToolchainStatusGauge(clientBuilder.Build(), namespace)
+				Expect(gauge.Status(context.Background())).To(BeFalse())
+			})
+
+			It("should report the member cluster as unready", func() {
+				collector := metrics.NewComponentsCollector(clientBuilder.Build(), namespace)
+				Expect(testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP konflux_workspaces_component_ready Whether a kubesaw component is ready.
+# TYPE konflux_workspaces_component_ready gauge
+konflux_workspaces_component_ready{cluster="",component="host-operator"} 1
+konflux_workspaces_component_ready{cluster="",component="host-routes"} 1
+konflux_workspaces_component_ready{cluster="",component="registration-service"} 1
+konflux_workspaces_component_ready{cluster="",component="toolchain"} 1
+konflux_workspaces_component_ready{cluster="member-fddgp-y57zo-3kr.5933.p3.openshiftapps.com",component="member"} 0
+`))).To(Succeed())
+			})
+		})
+	})
+
+	When("toolchain status is not found", func() {
+		It("should report no component", func() {
+			collector := metrics.NewComponentsCollector(clientBuilder.Build(), namespace)
+			Expect(testutil.CollectAndCount(collector)).To(BeZero())
 		})
 	})
 })
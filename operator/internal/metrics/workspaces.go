@@ -0,0 +1,130 @@
+/*
+Copyright 2024 The Workspaces Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+)
+
+//+kubebuilder:rbac:groups=workspaces.konflux-ci.dev,resources=internalworkspaces,verbs=get;list;watch
+
+const (
+	KonfluxWorkspacesWorkspaces          = "konflux_workspaces_workspaces"
+	KonfluxWorkspacesOwnersOverThreshold = "konflux_workspaces_owners_over_threshold"
+
+	// ReadyReasonUnknown is the ready_reason of the InternalWorkspaces
+	// that have not yet been reconciled
+	ReadyReasonUnknown = "Unknown"
+
+	// collectTimeout is the maximum time a collector waits for the cache
+	collectTimeout = 5 * time.Second
+)
+
+// WorkspacesCollector measures the number of InternalWorkspaces by visibility
+// and reason of their Ready condition, and the number of owners owning more
+// than a given number of InternalWorkspaces.
+// The InternalWorkspaces are read at each collection, so the reader is
+// expected to be backed by a cache, like the manager's client.
+type WorkspacesCollector struct {
+	client.Reader
+	workspacesNamespace string
+	ownerThreshold      int
+
+	workspaces          *prometheus.Desc
+	ownersOverThreshold *prometheus.Desc
+}
+
+var _ prometheus.Collector = &WorkspacesCollector{}
+
+func NewWorkspacesCollector(reader client.Reader, workspacesNamespace string, ownerThreshold int) *WorkspacesCollector {
+	return &WorkspacesCollector{
+		Reader:              reader,
+		workspacesNamespace: workspacesNamespace,
+		ownerThreshold:      ownerThreshold,
+		workspaces: prometheus.NewDesc(
+			KonfluxWorkspacesWorkspaces,
+			"Number of workspaces by visibility and reason of the Ready condition.",
+			[]string{"visibility", "ready_reason"},
+			nil,
+		),
+		ownersOverThreshold: prometheus.NewDesc(
+			KonfluxWorkspacesOwnersOverThreshold,
+			"Number of users owning more workspaces than the threshold.",
+			nil,
+			prometheus.Labels{"threshold": strconv.Itoa(ownerThreshold)},
+		),
+	}
+}
+
+func (c *WorkspacesCollector) Register() {
+	metrics.Registry.MustRegister(c)
+}
+
+// Describe implements prometheus.Collector
+func (c *WorkspacesCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.workspaces
+	ch <- c.ownersOverThreshold
+}
+
+// Collect implements prometheus.Collector
+func (c *WorkspacesCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	ww := workspacesv1alpha1.InternalWorkspaceList{}
+	if err := c.List(ctx, &ww, client.InNamespace(c.workspacesNamespace)); err != nil {
+		ch <- prometheus.NewInvalidMetric(c.workspaces, err)
+		ch <- prometheus.NewInvalidMetric(c.ownersOverThreshold, err)
+		return
+	}
+
+	type workspacesKey struct{ visibility, readyReason string }
+	workspaces := map[workspacesKey]int{}
+	owned := map[string]int{}
+	for _, w := range ww.Items {
+		k := workspacesKey{visibility: string(w.Spec.Visibility), readyReason: ReadyReasonUnknown}
+		if rc := meta.FindStatusCondition(w.Status.Conditions, workspacesv1alpha1.ConditionTypeReady); rc != nil {
+			k.readyReason = rc.Reason
+		}
+		workspaces[k]++
+
+		if o := w.Status.Owner.Username; o != "" {
+			owned[o]++
+		}
+	}
+
+	for k, v := range workspaces {
+		ch <- prometheus.MustNewConstMetric(c.workspaces, prometheus.GaugeValue, float64(v), k.visibility, k.readyReason)
+	}
+
+	ownersOverThreshold := 0
+	for _, v := range owned {
+		if v > c.ownerThreshold {
+			ownersOverThreshold++
+		}
+	}
+	ch <- prometheus.MustNewConstMetric(c.ownersOverThreshold, prometheus.GaugeValue, float64(ownersOverThreshold))
+}
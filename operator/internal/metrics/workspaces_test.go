@@ -0,0 +1,145 @@
+/*
+Copyright 2024 The Workspaces Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+	"github.com/konflux-workspaces/workspaces/operator/internal/metrics"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const workspacesNamespace = "workspaces-system"
+
+func internalWorkspace(name, owner string, visibility workspacesv1alpha1.InternalWorkspaceVisibility, conditions ...metav1.Condition) *workspacesv1alpha1.InternalWorkspace {
+	return &workspacesv1alpha1.InternalWorkspace{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: workspacesNamespace},
+		Spec:       workspacesv1alpha1.InternalWorkspaceSpec{Visibility: visibility},
+		Status: workspacesv1alpha1.InternalWorkspaceStatus{
+			Owner:      workspacesv1alpha1.UserInfoStatus{Username: owner},
+			Conditions: conditions,
+		},
+	}
+}
+
+var _ = Describe("workspaces metrics", func() {
+	var clientBuilder *fake.ClientBuilder
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(workspacesv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		clientBuilder = fake.NewClientBuilder().WithScheme(scheme)
+	})
+
+	When("no workspace exists", func() {
+		It("should report no workspaces and no owners over threshold", func() {
+			collector := metrics.NewWorkspacesCollector(clientBuilder.Build(), workspacesNamespace, 1)
+
+			Expect(testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP konflux_workspaces_owners_over_threshold Number of users owning more workspaces than the threshold.
+# TYPE konflux_workspaces_owners_over_threshold gauge
+konflux_workspaces_owners_over_threshold{threshold="1"} 0
+`))).To(Succeed())
+		})
+	})
+
+	When("workspaces exist", func() {
+		ready := metav1.Condition{
+			Type:   workspacesv1alpha1.ConditionTypeReady,
+			Status: metav1.ConditionTrue,
+			Reason: workspacesv1alpha1.ConditionReasonEverythingFine,
+		}
+		notReady := metav1.Condition{
+			Type:   workspacesv1alpha1.ConditionTypeReady,
+			Status: metav1.ConditionFalse,
+			Reason: workspacesv1alpha1.ConditionReasonSpaceNotFound,
+		}
+
+		BeforeEach(func() {
+			clientBuilder.WithObjects(
+				internalWorkspace("ws-1", "alice", workspacesv1alpha1.InternalWorkspaceVisibilityPrivate, ready),
+				internalWorkspace("ws-2", "alice", workspacesv1alpha1.InternalWorkspaceVisibilityPrivate, ready),
+				internalWorkspace("ws-3", "alice", workspacesv1alpha1.InternalWorkspaceVisibilityCommunity, notReady),
+				internalWorkspace("ws-4", "bob", workspacesv1alpha1.InternalWorkspaceVisibilityCommunity, ready),
+				internalWorkspace("ws-5", "bob", workspacesv1alpha1.InternalWorkspaceVisibilityPrivate),
+				internalWorkspace("ws-6", "carol", workspacesv1alpha1.InternalWorkspaceVisibilityPrivate, ready),
+			)
+		})
+
+		It("should count the workspaces by visibility and ready reason", func() {
+			collector := metrics.NewWorkspacesCollector(clientBuilder.Build(), workspacesNamespace, 1)
+
+			Expect(testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP konflux_workspaces_workspaces Number of workspaces by visibility and reason of the Ready condition.
+# TYPE konflux_workspaces_workspaces gauge
+konflux_workspaces_workspaces{ready_reason="EverythingFine",visibility="community"} 1
+konflux_workspaces_workspaces{ready_reason="EverythingFine",visibility="private"} 3
+konflux_workspaces_workspaces{ready_reason="SpaceNotFound",visibility="community"} 1
+konflux_workspaces_workspaces{ready_reason="Unknown",visibility="private"} 1
+`), metrics.KonfluxWorkspacesWorkspaces)).To(Succeed())
+		})
+
+		DescribeTable("should count the owners over the threshold",
+			func(threshold, expected int) {
+				collector := metrics.NewWorkspacesCollector(clientBuilder.Build(), workspacesNamespace, threshold)
+
+				Expect(testutil.CollectAndCompare(collector, strings.NewReader(fmt.Sprintf(`
+# HELP konflux_workspaces_owners_over_threshold Number of users owning more workspaces than the threshold.
+# TYPE konflux_workspaces_owners_over_threshold gauge
+konflux_workspaces_owners_over_threshold{threshold="%d"} %d
+`, threshold, expected)), metrics.KonfluxWorkspacesOwnersOverThreshold)).To(Succeed())
+			},
+			Entry("threshold 0", 0, 3),
+			Entry("threshold 1", 1, 2),
+			Entry("threshold 2", 2, 1),
+			Entry("threshold 3", 3, 0),
+		)
+
+		It("should ignore the workspaces in other namespaces", func() {
+			collector := metrics.NewWorkspacesCollector(clientBuilder.Build(), "other", 0)
+
+			Expect(testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP konflux_workspaces_owners_over_threshold Number of users owning more workspaces than the threshold.
+# TYPE konflux_workspaces_owners_over_threshold gauge
+konflux_workspaces_owners_over_threshold{threshold="0"} 0
+`))).To(Succeed())
+		})
+	})
+
+	When("workspaces can not be listed", func() {
+		It("should report the error", func() {
+			// the scheme does not know InternalWorkspaces
+			collector := metrics.NewWorkspacesCollector(fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build(), workspacesNamespace, 1)
+
+			registry := prometheus.NewPedanticRegistry()
+			registry.MustRegister(collector)
+			_, err := registry.Gather()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
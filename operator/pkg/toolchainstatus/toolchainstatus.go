@@ -0,0 +1,127 @@
+/*
+Copyright 2024 The Workspaces Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package toolchainstatus breaks the health of a KubeSaw instance, as reported
+// in its ToolchainStatus, down into the health of its components.
+package toolchainstatus
+
+import (
+	"slices"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// Name of the ToolchainStatus of a KubeSaw instance
+const Name = "toolchain-status"
+
+const (
+	ComponentToolchain           = "toolchain"
+	ComponentHostRoutes          = "host-routes"
+	ComponentHostOperator        = "host-operator"
+	ComponentRegistrationService = "registration-service"
+	ComponentMember              = "member"
+)
+
+// Component is a KubeSaw component reporting its conditions in the ToolchainStatus
+type Component struct {
+	// Name of the component
+	Name string `json:"component"`
+	// Cluster is the name of the member cluster, set only for member components
+	Cluster string `json:"cluster,omitempty"`
+	// Conditions reported by the component
+	Conditions []toolchainv1alpha1.Condition `json:"conditions,omitempty"`
+}
+
+// Ready returns true if all the Ready conditions reported by the component are True
+func (c Component) Ready() bool {
+	return len(c.NotReadyConditions()) == 0
+}
+
+// NotReadyConditions returns the Ready conditions reported by the component that are not True
+func (c Component) NotReadyConditions() []toolchainv1alpha1.Condition {
+	nr := []toolchainv1alpha1.Condition{}
+	for _, cond := range c.Conditions {
+		if cond.Type == toolchainv1alpha1.ConditionReady && cond.Status != v1.ConditionTrue {
+			nr = append(nr, cond)
+		}
+	}
+	return nr
+}
+
+// Components collects the conditions reported in the ToolchainStatus by each KubeSaw component.
+// The host operator and the registration service are included only if their status is reported.
+func Components(s *toolchainv1alpha1.ToolchainStatus) []Component {
+	cc := []Component{
+		{Name: ComponentToolchain, Conditions: s.Status.Conditions},
+		{Name: ComponentHostRoutes, Conditions: s.Status.HostRoutes.Conditions},
+	}
+
+	if ho := s.Status.HostOperator; ho != nil {
+		cc = append(cc, Component{
+			Name:       ComponentHostOperator,
+			Conditions: slices.Concat(ho.Conditions, ho.RevisionCheck.Conditions),
+		})
+	}
+
+	if rs := s.Status.RegistrationService; rs != nil {
+		cc = append(cc, Component{
+			Name: ComponentRegistrationService,
+			Conditions: slices.Concat(
+				rs.Health.Conditions,
+				rs.Deployment.Conditions,
+				rs.RevisionCheck.Conditions),
+		})
+	}
+
+	// we ignore Che in member clusters since we don't care about Che in konflux
+	for _, member := range s.Status.Members {
+		conditions := slices.Clone(member.MemberStatus.Conditions)
+		if member.MemberStatus.Host != nil {
+			conditions = append(conditions, member.MemberStatus.Host.Conditions...)
+		}
+		if member.MemberStatus.HostConnection != nil {
+			conditions = append(conditions, member.MemberStatus.HostConnection.Conditions...)
+		}
+		if member.MemberStatus.Routes != nil {
+			conditions = append(conditions, member.MemberStatus.Routes.Conditions...)
+		}
+		cc = append(cc, Component{
+			Name:       ComponentMember,
+			Cluster:    member.ClusterName,
+			Conditions: conditions,
+		})
+	}
+
+	return cc
+}
+
+// Ready returns true if the components report at least one condition and all of them are ready
+func Ready(cc []Component) bool {
+	reported := false
+	for _, c := range cc {
+		if !c.Ready() {
+			return false
+		}
+		reported = reported || len(c.Conditions) > 0
+	}
+	return reported
+}
+
+// NotReady returns the components that are not ready
+func NotReady(cc []Component) []Component {
+	return slices.DeleteFunc(slices.Clone(cc), Component.Ready)
+}
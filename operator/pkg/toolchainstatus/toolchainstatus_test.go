@@ -0,0 +1,94 @@
+/*
+Copyright 2024 The Workspaces Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package toolchainstatus_test
+
+import (
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/konflux-workspaces/workspaces/operator/pkg/toolchainstatus"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Components", func() {
+	ready := toolchainv1alpha1.Condition{Type: toolchainv1alpha1.ConditionReady, Status: v1.ConditionTrue}
+	notReady := toolchainv1alpha1.Condition{Type: toolchainv1alpha1.ConditionReady, Status: v1.ConditionFalse, Reason: "failure"}
+
+	var status toolchainv1alpha1.ToolchainStatus
+
+	BeforeEach(func() {
+		status = toolchainv1alpha1.ToolchainStatus{
+			Status: toolchainv1alpha1.ToolchainStatusStatus{
+				Conditions: []toolchainv1alpha1.Condition{ready},
+				HostOperator: &toolchainv1alpha1.HostOperatorStatus{
+					Conditions: []toolchainv1alpha1.Condition{ready},
+				},
+				Members: []toolchainv1alpha1.Member{
+					{
+						ClusterName: "member-1",
+						MemberStatus: toolchainv1alpha1.MemberStatusStatus{
+							Conditions: []toolchainv1alpha1.Condition{ready},
+						},
+					},
+					{
+						ClusterName: "member-2",
+						MemberStatus: toolchainv1alpha1.MemberStatusStatus{
+							Conditions: []toolchainv1alpha1.Condition{ready},
+							Routes: &toolchainv1alpha1.Routes{
+								Conditions: []toolchainv1alpha1.Condition{notReady},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	It("should break the status down by component", func() {
+		cc := toolchainstatus.Components(&status)
+
+		Expect(cc).To(HaveExactElements(
+			toolchainstatus.Component{Name: toolchainstatus.ComponentToolchain, Conditions: []toolchainv1alpha1.Condition{ready}},
+			toolchainstatus.Component{Name: toolchainstatus.ComponentHostRoutes},
+			toolchainstatus.Component{Name: toolchainstatus.ComponentHostOperator, Conditions: []toolchainv1alpha1.Condition{ready}},
+			toolchainstatus.Component{Name: toolchainstatus.ComponentMember, Cluster: "member-1", Conditions: []toolchainv1alpha1.Condition{ready}},
+			toolchainstatus.Component{Name: toolchainstatus.ComponentMember, Cluster: "member-2", Conditions: []toolchainv1alpha1.Condition{ready, notReady}},
+		))
+	})
+
+	It("should report the components that are not ready", func() {
+		cc := toolchainstatus.Components(&status)
+
+		Expect(toolchainstatus.Ready(cc)).To(BeFalse())
+		nr := toolchainstatus.NotReady(cc)
+		Expect(nr).To(HaveLen(1))
+		Expect(nr[0].Cluster).To(Equal("member-2"))
+		Expect(nr[0].NotReadyConditions()).To(ConsistOf(notReady))
+	})
+
+	It("should be ready if all components are ready", func() {
+		status.Status.Members = status.Status.Members[:1]
+
+		Expect(toolchainstatus.Ready(toolchainstatus.Components(&status))).To(BeTrue())
+	})
+
+	It("should not be ready if no condition is reported", func() {
+		Expect(toolchainstatus.Ready(toolchainstatus.Components(&toolchainv1alpha1.ToolchainStatus{}))).To(BeFalse())
+	})
+})
@@ -23,12 +23,24 @@ import (
 
 type WorkspaceVisibility string
 
+// WorkspaceRole is the role granted to a member of a Workspace
+type WorkspaceRole string
+
 const (
 	// WorkspaceVisibilityCommunity Community value for Workspaces visibility
 	WorkspaceVisibilityCommunity WorkspaceVisibility = "community"
 	// WorkspaceVisibilityPrivate Private value for Workspaces visibility
 	WorkspaceVisibilityPrivate WorkspaceVisibility = "private"
 
+	// WorkspaceRoleViewer Viewer role for Workspaces members
+	WorkspaceRoleViewer WorkspaceRole = "viewer"
+	// WorkspaceRoleContributor Contributor role for Workspaces members
+	WorkspaceRoleContributor WorkspaceRole = "contributor"
+	// WorkspaceRoleMaintainer Maintainer role for Workspaces members
+	WorkspaceRoleMaintainer WorkspaceRole = "maintainer"
+	// WorkspaceRoleAdmin Admin role for Workspaces members
+	WorkspaceRoleAdmin WorkspaceRole = "admin"
+
 	// LabelIsOwner if the requesting user is the owner of the workspace
 	LabelIsOwner string = workspacesv1alpha1.LabelInternalDomain + "is-owner"
 	// LabelHasDirectAccess if the requesting user has access to the workspace
@@ -36,50 +48,91 @@ const (
 	LabelHasDirectAccess string = workspacesv1alpha1.LabelInternalDomain + "has-direct-access"
 )
 
+// WorkspaceMember is a user the Workspace is shared with
+type WorkspaceMember struct {
+	// Username is the name of the member, i.e. the namespace of their workspaces
+	//+required
+	Username string `json:"username" protobuf:"bytes,1,opt,name=username"`
+	//+required
+	//+kubebuilder:validation:Enum:=viewer;contributor;maintainer;admin
+	Role WorkspaceRole `json:"role" protobuf:"bytes,2,opt,name=role,casttype=WorkspaceRole"`
+}
+
+// WorkspaceRename is the request to rename a Workspace
+type WorkspaceRename struct {
+	// Name is the new name of the Workspace, it must be unique among the Workspaces of the owner
+	//+required
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+}
+
+// WorkspaceOwnershipTransfer is a proposal to transfer the ownership of a Workspace to another user
+type WorkspaceOwnershipTransfer struct {
+	// NewOwner is the username of the user the ownership is proposed to
+	//+required
+	NewOwner string `json:"newOwner" protobuf:"bytes,1,opt,name=newOwner"`
+}
+
 // WorkspaceSpec defines the desired state of Workspace
 type WorkspaceSpec struct {
 	//+required
 	//+kubebuilder:validation:Enum:=community;private
-	Visibility WorkspaceVisibility `json:"visibility"`
+	Visibility WorkspaceVisibility `json:"visibility" protobuf:"bytes,1,opt,name=visibility,casttype=WorkspaceVisibility"`
+	// Members are the users the Workspace is shared with.
+	// Members are managed via the members endpoints only.
+	//+optional
+	//+listType=map
+	//+listMapKey=username
+	Members []WorkspaceMember `json:"members,omitempty" protobuf:"bytes,2,rep,name=members"`
+	// OwnershipTransfer is the pending proposal to transfer the ownership of the Workspace.
+	// Ownership transfers are managed via the transfer endpoints only.
+	//+optional
+	OwnershipTransfer *WorkspaceOwnershipTransfer `json:"ownershipTransfer,omitempty" protobuf:"bytes,3,opt,name=ownershipTransfer"`
 }
 
 // SpaceInfo Information about a Space
 type SpaceInfo struct {
 	//+required
-	Name string `json:"name"`
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 
 	// TargetCluster contains the URL to the cluster where the workspace's namespaces live
 	//+optional
-	TargetCluster string `json:"targetCluster,omitempty"`
+	TargetCluster string `json:"targetCluster,omitempty" protobuf:"bytes,2,opt,name=targetCluster"`
 }
 
 // UserInfoStatus User info stored in the status
 type UserInfoStatus struct {
 	//+required
-	Email string `json:"email"`
+	Email string `json:"email" protobuf:"bytes,1,opt,name=email"`
 }
 
 // WorkspaceStatus defines the observed state of Workspace
 type WorkspaceStatus struct {
 	//+optional
-	Space *SpaceInfo `json:"space,omitempty"`
+	Space *SpaceInfo `json:"space,omitempty" protobuf:"bytes,1,opt,name=space"`
+	//+optional
+	Owner *UserInfoStatus `json:"owner,omitempty" protobuf:"bytes,2,opt,name=owner"`
 	//+optional
-	Owner *UserInfoStatus `json:"owner,omitempty"`
+	//+listType=map
+	//+listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" protobuf:"bytes,3,rep,name=conditions"`
+	// ObservedGeneration is the generation of the Workspace's spec
+	// that was last applied
 	//+optional
-	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,4,opt,name=observedGeneration"`
 }
 
 //+kubebuilder:object:root=true
+//+kubebuilder:resource:shortName=ws
 //+kubebuilder:subresource:status
 //+kubebuilder:printcolumn:name="Visibility",type="string",JSONPath=`.spec.visibility`
 
 // Workspace is the Schema for the workspaces API
 type Workspace struct {
 	metav1.TypeMeta   `json:",inline"`
-	metav1.ObjectMeta `json:"metadata,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 
-	Spec   WorkspaceSpec   `json:"spec,omitempty"`
-	Status WorkspaceStatus `json:"status,omitempty"`
+	Spec   WorkspaceSpec   `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status WorkspaceStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
 //+kubebuilder:object:root=true
@@ -87,10 +140,6 @@ type Workspace struct {
 // WorkspaceList contains a list of Workspace
 type WorkspaceList struct {
 	metav1.TypeMeta `json:",inline"`
-	metav1.ListMeta `json:"metadata,omitempty"`
-	Items           []Workspace `json:"items"`
-}
-
-func init() {
-	SchemeBuilder.Register(&Workspace{}, &WorkspaceList{})
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []Workspace `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
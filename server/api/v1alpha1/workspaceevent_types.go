@@ -0,0 +1,60 @@
+/*
+Copyright 2024 The Workspaces Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WorkspaceEvent is an event recorded on a Workspace, e.g. the creation of its Space
+// or the failure to grant its members access.
+// WorkspaceEvents are served by the events endpoint only, they are not a resource of the API.
+type WorkspaceEvent struct {
+	metav1.TypeMeta `json:",inline"`
+	// ObjectMeta is not embedded, so that WorkspaceEvent is not mistaken for a kind
+	// of the API by the CustomResourceDefinition generator
+
+	// Metadata of the event
+	//+optional
+	ObjectMeta metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Type is the type of the event, Normal or Warning
+	//+optional
+	Type string `json:"type,omitempty" protobuf:"bytes,2,opt,name=type"`
+	// Reason is a short, machine understandable, description of the event
+	//+optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,3,opt,name=reason"`
+	// Message is a human-readable description of the event
+	//+optional
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+	// Count is the number of times the event occurred
+	//+optional
+	Count int32 `json:"count,omitempty" protobuf:"varint,5,opt,name=count"`
+	// FirstTimestamp is the time the event was first recorded
+	//+optional
+	FirstTimestamp metav1.Time `json:"firstTimestamp,omitempty" protobuf:"bytes,6,opt,name=firstTimestamp"`
+	// LastTimestamp is the time the event was last recorded
+	//+optional
+	LastTimestamp metav1.Time `json:"lastTimestamp,omitempty" protobuf:"bytes,7,opt,name=lastTimestamp"`
+}
+
+// WorkspaceEventList contains a list of WorkspaceEvent
+type WorkspaceEventList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []WorkspaceEvent `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
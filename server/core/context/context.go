@@ -4,5 +4,7 @@ type ServerContextKey string
 
 const (
 	UserSubKey                 ServerContextKey = "user-sub"
+	UserEmailKey               ServerContextKey = "user-email"
+	UserIdKey                  ServerContextKey = "user-id"
 	UserSignupComplaintNameKey ServerContextKey = "usersignup-complaintname"
 )
@@ -1,7 +1,16 @@
 package core
 
-import "fmt"
+import (
+	"fmt"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+)
 
 var (
 	ErrNotFound error = fmt.Errorf("resource not found")
 )
+
+// NewUnauthenticatedError returns the error for requests carrying no authenticated user
+func NewUnauthenticatedError() error {
+	return kerrors.NewUnauthorized("unauthenticated request")
+}
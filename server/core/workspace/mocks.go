@@ -1,3 +1,3 @@
 package workspace
 
-//go:generate mockgen -destination=mocks_generated_test.go -package=workspace_test . WorkspaceUpdater,WorkspaceReader,WorkspaceLister,WorkspaceCreator
+//go:generate mockgen -destination=mocks_generated_test.go -package=workspace_test . WorkspaceUpdater,WorkspaceReader,WorkspaceLister,WorkspaceCreator,WorkspaceDeleter,WorkspaceWatcher,WorkspaceMembersUpdater,WorkspaceRenamer,WorkspaceOwnershipTransferrer,WorkspaceEventsLister
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/konflux-workspaces/workspaces/server/core/workspace (interfaces: WorkspaceUpdater,WorkspaceReader,WorkspaceLister,WorkspaceCreator)
+// Source: github.com/konflux-workspaces/workspaces/server/core/workspace (interfaces: WorkspaceUpdater,WorkspaceReader,WorkspaceLister,WorkspaceCreator,WorkspaceDeleter,WorkspaceWatcher,WorkspaceMembersUpdater,WorkspaceRenamer,WorkspaceOwnershipTransferrer,WorkspaceEventsLister)
 //
 // Generated by this command:
 //
-//	mockgen -destination=mocks_generated_test.go -package=workspace_test . WorkspaceUpdater,WorkspaceReader,WorkspaceLister,WorkspaceCreator
+//	mockgen -destination=mocks_generated_test.go -package=workspace_test . WorkspaceUpdater,WorkspaceReader,WorkspaceLister,WorkspaceCreator,WorkspaceDeleter,WorkspaceWatcher,WorkspaceMembersUpdater,WorkspaceRenamer,WorkspaceOwnershipTransferrer,WorkspaceEventsLister
 //
 
 // Package workspace_test is a generated GoMock package.
@@ -15,6 +15,7 @@ import (
 
 	v1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
 	gomock "go.uber.org/mock/gomock"
+	watch "k8s.io/apimachinery/pkg/watch"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -185,3 +186,294 @@ func (mr *MockWorkspaceCreatorMockRecorder) CreateUserWorkspace(arg0, arg1, arg2
 	varargs := append([]any{arg0, arg1, arg2}, arg3...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserWorkspace", reflect.TypeOf((*MockWorkspaceCreator)(nil).CreateUserWorkspace), varargs...)
 }
+
+// MockWorkspaceDeleter is a mock of WorkspaceDeleter interface.
+type MockWorkspaceDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceDeleterMockRecorder
+}
+
+// MockWorkspaceDeleterMockRecorder is the mock recorder for MockWorkspaceDeleter.
+type MockWorkspaceDeleterMockRecorder struct {
+	mock *MockWorkspaceDeleter
+}
+
+// NewMockWorkspaceDeleter creates a new mock instance.
+func NewMockWorkspaceDeleter(ctrl *gomock.Controller) *MockWorkspaceDeleter {
+	mock := &MockWorkspaceDeleter{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkspaceDeleter) EXPECT() *MockWorkspaceDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteUserWorkspace mocks base method.
+func (m *MockWorkspaceDeleter) DeleteUserWorkspace(arg0 context.Context, arg1 string, arg2 *v1alpha1.Workspace, arg3 ...client.DeleteOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteUserWorkspace", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserWorkspace indicates an expected call of DeleteUserWorkspace.
+func (mr *MockWorkspaceDeleterMockRecorder) DeleteUserWorkspace(arg0, arg1, arg2 any, arg3 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserWorkspace", reflect.TypeOf((*MockWorkspaceDeleter)(nil).DeleteUserWorkspace), varargs...)
+}
+
+// MockWorkspaceWatcher is a mock of WorkspaceWatcher interface.
+type MockWorkspaceWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceWatcherMockRecorder
+}
+
+// MockWorkspaceWatcherMockRecorder is the mock recorder for MockWorkspaceWatcher.
+type MockWorkspaceWatcherMockRecorder struct {
+	mock *MockWorkspaceWatcher
+}
+
+// NewMockWorkspaceWatcher creates a new mock instance.
+func NewMockWorkspaceWatcher(ctrl *gomock.Controller) *MockWorkspaceWatcher {
+	mock := &MockWorkspaceWatcher{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkspaceWatcher) EXPECT() *MockWorkspaceWatcherMockRecorder {
+	return m.recorder
+}
+
+// WatchUserWorkspaces mocks base method.
+func (m *MockWorkspaceWatcher) WatchUserWorkspaces(arg0 context.Context, arg1 string, arg2 ...client.ListOption) (watch.Interface, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchUserWorkspaces", varargs...)
+	ret0, _ := ret[0].(watch.Interface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchUserWorkspaces indicates an expected call of WatchUserWorkspaces.
+func (mr *MockWorkspaceWatcherMockRecorder) WatchUserWorkspaces(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchUserWorkspaces", reflect.TypeOf((*MockWorkspaceWatcher)(nil).WatchUserWorkspaces), varargs...)
+}
+
+// MockWorkspaceMembersUpdater is a mock of WorkspaceMembersUpdater interface.
+type MockWorkspaceMembersUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceMembersUpdaterMockRecorder
+}
+
+// MockWorkspaceMembersUpdaterMockRecorder is the mock recorder for MockWorkspaceMembersUpdater.
+type MockWorkspaceMembersUpdaterMockRecorder struct {
+	mock *MockWorkspaceMembersUpdater
+}
+
+// NewMockWorkspaceMembersUpdater creates a new mock instance.
+func NewMockWorkspaceMembersUpdater(ctrl *gomock.Controller) *MockWorkspaceMembersUpdater {
+	mock := &MockWorkspaceMembersUpdater{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceMembersUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkspaceMembersUpdater) EXPECT() *MockWorkspaceMembersUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateUserWorkspaceMembers mocks base method.
+func (m *MockWorkspaceMembersUpdater) UpdateUserWorkspaceMembers(arg0 context.Context, arg1 string, arg2 *v1alpha1.Workspace, arg3 ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateUserWorkspaceMembers", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserWorkspaceMembers indicates an expected call of UpdateUserWorkspaceMembers.
+func (mr *MockWorkspaceMembersUpdaterMockRecorder) UpdateUserWorkspaceMembers(arg0, arg1, arg2 any, arg3 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserWorkspaceMembers", reflect.TypeOf((*MockWorkspaceMembersUpdater)(nil).UpdateUserWorkspaceMembers), varargs...)
+}
+
+// MockWorkspaceRenamer is a mock of WorkspaceRenamer interface.
+type MockWorkspaceRenamer struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceRenamerMockRecorder
+}
+
+// MockWorkspaceRenamerMockRecorder is the mock recorder for MockWorkspaceRenamer.
+type MockWorkspaceRenamerMockRecorder struct {
+	mock *MockWorkspaceRenamer
+}
+
+// NewMockWorkspaceRenamer creates a new mock instance.
+func NewMockWorkspaceRenamer(ctrl *gomock.Controller) *MockWorkspaceRenamer {
+	mock := &MockWorkspaceRenamer{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceRenamerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkspaceRenamer) EXPECT() *MockWorkspaceRenamerMockRecorder {
+	return m.recorder
+}
+
+// RenameUserWorkspace mocks base method.
+func (m *MockWorkspaceRenamer) RenameUserWorkspace(arg0 context.Context, arg1 string, arg2 *v1alpha1.Workspace, arg3 string, arg4 ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RenameUserWorkspace", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameUserWorkspace indicates an expected call of RenameUserWorkspace.
+func (mr *MockWorkspaceRenamerMockRecorder) RenameUserWorkspace(arg0, arg1, arg2, arg3 any, arg4 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameUserWorkspace", reflect.TypeOf((*MockWorkspaceRenamer)(nil).RenameUserWorkspace), varargs...)
+}
+
+// MockWorkspaceOwnershipTransferrer is a mock of WorkspaceOwnershipTransferrer interface.
+type MockWorkspaceOwnershipTransferrer struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceOwnershipTransferrerMockRecorder
+}
+
+// MockWorkspaceOwnershipTransferrerMockRecorder is the mock recorder for MockWorkspaceOwnershipTransferrer.
+type MockWorkspaceOwnershipTransferrerMockRecorder struct {
+	mock *MockWorkspaceOwnershipTransferrer
+}
+
+// NewMockWorkspaceOwnershipTransferrer creates a new mock instance.
+func NewMockWorkspaceOwnershipTransferrer(ctrl *gomock.Controller) *MockWorkspaceOwnershipTransferrer {
+	mock := &MockWorkspaceOwnershipTransferrer{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceOwnershipTransferrerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkspaceOwnershipTransferrer) EXPECT() *MockWorkspaceOwnershipTransferrerMockRecorder {
+	return m.recorder
+}
+
+// AcceptUserWorkspaceOwnershipTransfer mocks base method.
+func (m *MockWorkspaceOwnershipTransferrer) AcceptUserWorkspaceOwnershipTransfer(arg0 context.Context, arg1 string, arg2 *v1alpha1.Workspace, arg3 ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AcceptUserWorkspaceOwnershipTransfer", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptUserWorkspaceOwnershipTransfer indicates an expected call of AcceptUserWorkspaceOwnershipTransfer.
+func (mr *MockWorkspaceOwnershipTransferrerMockRecorder) AcceptUserWorkspaceOwnershipTransfer(arg0, arg1, arg2 any, arg3 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptUserWorkspaceOwnershipTransfer", reflect.TypeOf((*MockWorkspaceOwnershipTransferrer)(nil).AcceptUserWorkspaceOwnershipTransfer), varargs...)
+}
+
+// CancelUserWorkspaceOwnershipTransfer mocks base method.
+func (m *MockWorkspaceOwnershipTransferrer) CancelUserWorkspaceOwnershipTransfer(arg0 context.Context, arg1 string, arg2 *v1alpha1.Workspace, arg3 ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelUserWorkspaceOwnershipTransfer", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelUserWorkspaceOwnershipTransfer indicates an expected call of CancelUserWorkspaceOwnershipTransfer.
+func (mr *MockWorkspaceOwnershipTransferrerMockRecorder) CancelUserWorkspaceOwnershipTransfer(arg0, arg1, arg2 any, arg3 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUserWorkspaceOwnershipTransfer", reflect.TypeOf((*MockWorkspaceOwnershipTransferrer)(nil).CancelUserWorkspaceOwnershipTransfer), varargs...)
+}
+
+// ProposeUserWorkspaceOwnershipTransfer mocks base method.
+func (m *MockWorkspaceOwnershipTransferrer) ProposeUserWorkspaceOwnershipTransfer(arg0 context.Context, arg1 string, arg2 *v1alpha1.Workspace, arg3 string, arg4 ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ProposeUserWorkspaceOwnershipTransfer", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProposeUserWorkspaceOwnershipTransfer indicates an expected call of ProposeUserWorkspaceOwnershipTransfer.
+func (mr *MockWorkspaceOwnershipTransferrerMockRecorder) ProposeUserWorkspaceOwnershipTransfer(arg0, arg1, arg2, arg3 any, arg4 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposeUserWorkspaceOwnershipTransfer", reflect.TypeOf((*MockWorkspaceOwnershipTransferrer)(nil).ProposeUserWorkspaceOwnershipTransfer), varargs...)
+}
+
+// MockWorkspaceEventsLister is a mock of WorkspaceEventsLister interface.
+type MockWorkspaceEventsLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceEventsListerMockRecorder
+}
+
+// MockWorkspaceEventsListerMockRecorder is the mock recorder for MockWorkspaceEventsLister.
+type MockWorkspaceEventsListerMockRecorder struct {
+	mock *MockWorkspaceEventsLister
+}
+
+// NewMockWorkspaceEventsLister creates a new mock instance.
+func NewMockWorkspaceEventsLister(ctrl *gomock.Controller) *MockWorkspaceEventsLister {
+	mock := &MockWorkspaceEventsLister{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceEventsListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkspaceEventsLister) EXPECT() *MockWorkspaceEventsListerMockRecorder {
+	return m.recorder
+}
+
+// ListUserWorkspaceEvents mocks base method.
+func (m *MockWorkspaceEventsLister) ListUserWorkspaceEvents(arg0 context.Context, arg1, arg2, arg3 string, arg4 *v1alpha1.WorkspaceEventList, arg5 ...client.ListOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1, arg2, arg3, arg4}
+	for _, a := range arg5 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUserWorkspaceEvents", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListUserWorkspaceEvents indicates an expected call of ListUserWorkspaceEvents.
+func (mr *MockWorkspaceEventsListerMockRecorder) ListUserWorkspaceEvents(arg0, arg1, arg2, arg3, arg4 any, arg5 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1, arg2, arg3, arg4}, arg5...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserWorkspaceEvents", reflect.TypeOf((*MockWorkspaceEventsLister)(nil).ListUserWorkspaceEvents), varargs...)
+}
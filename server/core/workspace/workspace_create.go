@@ -2,16 +2,19 @@ package workspace
 
 import (
 	"context"
-	"fmt"
 
 	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+	"github.com/konflux-workspaces/workspaces/server/core"
 	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // CreateWorkspaceCommand contains the information needed to create a new workspace
 type CreateWorkspaceCommand struct {
 	Workspace restworkspacesv1alpha1.Workspace
+	// DryRun processes the command without persisting the workspace
+	DryRun bool
 }
 
 // CreateWorkspaceResponse contains the newly-created workspace
@@ -34,7 +37,7 @@ func NewCreateWorkspaceHandler(creator WorkspaceCreator) *CreateWorkspaceHandler
 func (h *CreateWorkspaceHandler) Handle(ctx context.Context, request CreateWorkspaceCommand) (*CreateWorkspaceResponse, error) {
 	u, ok := ctx.Value(ccontext.UserSignupComplaintNameKey).(string)
 	if !ok {
-		return nil, fmt.Errorf("unauthenticated request")
+		return nil, core.NewUnauthenticatedError()
 	}
 
 	// TODO: validate the workspace; maybe punt to a webhook down the line?
@@ -42,6 +45,9 @@ func (h *CreateWorkspaceHandler) Handle(ctx context.Context, request CreateWorks
 	// write the workspace
 	workspace := request.Workspace.DeepCopy()
 	opts := &client.CreateOptions{}
+	if request.DryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
 	if err := h.creator.CreateUserWorkspace(ctx, u, workspace, opts); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,85 @@
+package workspace_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/konflux-workspaces/workspaces/server/core"
+	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
+	"github.com/konflux-workspaces/workspaces/server/core/workspace"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("Delete", func() {
+	var (
+		ctrl    *gomock.Controller
+		ctx     context.Context
+		deleter *MockWorkspaceDeleter
+		request workspace.DeleteWorkspaceCommand
+		handler workspace.DeleteWorkspaceHandler
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		deleter = NewMockWorkspaceDeleter(ctrl)
+		request = workspace.DeleteWorkspaceCommand{Workspace: restworkspacesv1alpha1.Workspace{}}
+		handler = *workspace.NewDeleteWorkspaceHandler(deleter)
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	It("should not allow unauthenticated requests", func() {
+		// don't set the "user" value within ctx
+
+		response, err := handler.Handle(ctx, request)
+		Expect(err).To(HaveOccurred())
+		Expect(err).To(Equal(core.NewUnauthenticatedError()))
+		Expect(response).To(BeNil())
+	})
+
+	It("should allow authenticated requests", func() {
+		// given
+		username := "foo"
+		ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, username)
+		opts := &client.DeleteOptions{}
+		deleter.EXPECT().
+			DeleteUserWorkspace(ctx, username, &request.Workspace, opts).
+			Return(nil)
+
+		// when
+		response, err := handler.Handle(ctx, request)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response).To(Equal(&workspace.DeleteWorkspaceResponse{
+			Workspace: &request.Workspace,
+		}))
+	})
+
+	It("should forward errors from the workspace deleter", func() {
+		// given
+		username := "foo"
+		ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, username)
+		opts := &client.DeleteOptions{}
+		error := fmt.Errorf("Failed to delete workspace!")
+		deleter.EXPECT().
+			DeleteUserWorkspace(ctx, username, &request.Workspace, opts).
+			Return(error)
+
+		// when
+		response, err := handler.Handle(ctx, request)
+
+		// then
+		Expect(response).To(BeNil())
+		Expect(err).To(HaveOccurred())
+		Expect(err).To(Equal(error))
+	})
+})
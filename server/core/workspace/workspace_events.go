@@ -0,0 +1,60 @@
+package workspace
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/konflux-workspaces/workspaces/server/core"
+	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+// ListWorkspaceEventsQuery contains the information needed to retrieve the Events
+// of a Workspace the user has access to from the data source
+type ListWorkspaceEventsQuery struct {
+	Name  string
+	Owner string
+}
+
+// ListWorkspaceEventsResponse contains the Events of the workspace the user requested
+type ListWorkspaceEventsResponse struct {
+	Events *restworkspacesv1alpha1.WorkspaceEventList
+}
+
+// WorkspaceEventsLister is the interface the data source needs to implement to allow the ListWorkspaceEventsHandler to fetch data from it
+type WorkspaceEventsLister interface {
+	ListUserWorkspaceEvents(ctx context.Context, user, owner, space string, obj *restworkspacesv1alpha1.WorkspaceEventList, opts ...client.ListOption) error
+}
+
+// ListWorkspaceEventsHandler processes ListWorkspaceEventsQuery and returns ListWorkspaceEventsResponse fetching data from a WorkspaceEventsLister
+type ListWorkspaceEventsHandler struct {
+	lister WorkspaceEventsLister
+}
+
+// NewListWorkspaceEventsHandler creates a new ListWorkspaceEventsHandler that uses a specified WorkspaceEventsLister
+func NewListWorkspaceEventsHandler(lister WorkspaceEventsLister) *ListWorkspaceEventsHandler {
+	return &ListWorkspaceEventsHandler{lister: lister}
+}
+
+// Handle handles a ListWorkspaceEventsQuery and returns a ListWorkspaceEventsResponse or an error
+func (h *ListWorkspaceEventsHandler) Handle(ctx context.Context, query ListWorkspaceEventsQuery) (*ListWorkspaceEventsResponse, error) {
+	// authorization
+	// Only users allowed to read the workspace can list its events, this is checked by the WorkspaceEventsLister
+	u, ok := ctx.Value(ccontext.UserSignupComplaintNameKey).(string)
+	if !ok {
+		return nil, core.NewUnauthenticatedError()
+	}
+
+	// data access
+	var ee restworkspacesv1alpha1.WorkspaceEventList
+	if err := h.lister.ListUserWorkspaceEvents(ctx, u, query.Owner, query.Name, &ee); err != nil {
+		return nil, err
+	}
+
+	// reply
+	return &ListWorkspaceEventsResponse{
+		Events: &ee,
+	}, nil
+}
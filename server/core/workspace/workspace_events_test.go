@@ -0,0 +1,83 @@
+package workspace_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/konflux-workspaces/workspaces/server/core"
+	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
+	"github.com/konflux-workspaces/workspaces/server/core/workspace"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("WorkspaceEvents", func() {
+	var (
+		ctrl    *gomock.Controller
+		ctx     context.Context
+		lister  *MockWorkspaceEventsLister
+		request workspace.ListWorkspaceEventsQuery
+		handler workspace.ListWorkspaceEventsHandler
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		lister = NewMockWorkspaceEventsLister(ctrl)
+		request = workspace.ListWorkspaceEventsQuery{Owner: "owner", Name: "foo"}
+		handler = *workspace.NewListWorkspaceEventsHandler(lister)
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	It("should not allow unauthenticated requests", func() {
+		// don't set the "user" value within ctx
+
+		response, err := handler.Handle(ctx, request)
+		Expect(err).To(Equal(core.NewUnauthenticatedError()))
+		Expect(response).To(BeNil())
+	})
+
+	It("should allow authenticated requests", func() {
+		// given
+		username := "foo"
+		ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, username)
+		lister.EXPECT().
+			ListUserWorkspaceEvents(ctx, username, request.Owner, request.Name, &restworkspacesv1alpha1.WorkspaceEventList{}, []client.ListOption{}).
+			DoAndReturn(func(_ context.Context, _, _, _ string, obj *restworkspacesv1alpha1.WorkspaceEventList, _ ...client.ListOption) error {
+				obj.Items = []restworkspacesv1alpha1.WorkspaceEvent{{Reason: "OwnerResolved"}}
+				return nil
+			})
+
+		// when
+		response, err := handler.Handle(ctx, request)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.Events.Items).To(HaveLen(1))
+		Expect(response.Events.Items[0].Reason).To(Equal("OwnerResolved"))
+	})
+
+	It("should forward errors from the workspace events lister", func() {
+		// given
+		username := "foo"
+		ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, username)
+		error := fmt.Errorf("Failed to list workspace events!")
+		lister.EXPECT().
+			ListUserWorkspaceEvents(ctx, username, request.Owner, request.Name, &restworkspacesv1alpha1.WorkspaceEventList{}, []client.ListOption{}).
+			Return(error)
+
+		// when
+		response, err := handler.Handle(ctx, request)
+
+		// then
+		Expect(response).To(BeNil())
+		Expect(err).To(Equal(error))
+	})
+})
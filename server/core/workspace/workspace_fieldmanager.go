@@ -0,0 +1,42 @@
+package workspace
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/managedfields"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+// FieldManager tracks which manager owns each field of a Workspace, and merges
+// the configurations applied by managers via server-side apply
+type FieldManager interface {
+	Update(liveObj, newObj runtime.Object, manager string) (runtime.Object, error)
+	Apply(liveObj, appliedObj runtime.Object, manager string, force bool) (runtime.Object, error)
+}
+
+var _ FieldManager = &managedfields.FieldManager{}
+
+// NewFieldManager creates a FieldManager for Workspaces that uses the given TypeConverter to merge
+// the applied configurations. The status can not be applied, as it is reserved to the operator.
+func NewFieldManager(typeConverter managedfields.TypeConverter) (FieldManager, error) {
+	s := runtime.NewScheme()
+	if err := restworkspacesv1alpha1.AddToScheme(s); err != nil {
+		return nil, err
+	}
+
+	gv := restworkspacesv1alpha1.GroupVersion
+	resetFields := map[fieldpath.APIVersion]*fieldpath.Set{
+		fieldpath.APIVersion(gv.String()): fieldpath.NewSet(fieldpath.MakePathOrDie("status")),
+	}
+	return managedfields.NewDefaultFieldManager(
+		typeConverter,
+		runtime.UnsafeObjectConvertor(s),
+		s,
+		s,
+		gv.WithKind("Workspace"),
+		gv,
+		"",
+		resetFields,
+	)
+}
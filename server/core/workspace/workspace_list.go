@@ -2,17 +2,29 @@ package workspace
 
 import (
 	"context"
-	"fmt"
 
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+	"github.com/konflux-workspaces/workspaces/server/core"
 	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
 )
 
 // ListWorkspaceQuery contains the information needed to retrieve all the workspaces the user has access to from the data source
 type ListWorkspaceQuery struct {
 	Namespace string
+
+	// LabelSelector restricts the list of returned workspaces by their labels
+	LabelSelector labels.Selector
+	// FieldSelector restricts the list of returned workspaces by their fields
+	FieldSelector fields.Selector
+
+	// Limit is the maximum number of workspaces to return, 0 means no limit
+	Limit int64
+	// Continue is the token returned by a previous paginated list request
+	Continue string
 }
 
 // ListWorkspaceResponse contains all the workspaces the user can access
@@ -41,7 +53,7 @@ func (h *ListWorkspaceHandler) Handle(ctx context.Context, query ListWorkspaceQu
 	// If required, implement here complex logic like multiple-domains filtering, etc
 	u, ok := ctx.Value(ccontext.UserSignupComplaintNameKey).(string)
 	if !ok {
-		return nil, fmt.Errorf("unauthenticated request")
+		return nil, core.NewUnauthenticatedError()
 	}
 
 	// validate query
@@ -49,7 +61,13 @@ func (h *ListWorkspaceHandler) Handle(ctx context.Context, query ListWorkspaceQu
 
 	// data access
 	ww := restworkspacesv1alpha1.WorkspaceList{}
-	opts := &client.ListOptions{Namespace: query.Namespace}
+	opts := &client.ListOptions{
+		Namespace:     query.Namespace,
+		LabelSelector: query.LabelSelector,
+		FieldSelector: query.FieldSelector,
+		Limit:         query.Limit,
+		Continue:      query.Continue,
+	}
 	if err := h.lister.ListUserWorkspaces(ctx, u, &ww, opts); err != nil {
 		return nil, err
 	}
@@ -9,7 +9,9 @@ import (
 	"go.uber.org/mock/gomock"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/konflux-workspaces/workspaces/server/core"
 	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
 	"github.com/konflux-workspaces/workspaces/server/core/workspace"
 
@@ -40,7 +42,7 @@ var _ = Describe("WorkspaceList", func() {
 
 		response, err := handler.Handle(ctx, request)
 		Expect(err).To(HaveOccurred())
-		Expect(err).To(Equal(fmt.Errorf("unauthenticated request")))
+		Expect(err).To(Equal(core.NewUnauthenticatedError()))
 		Expect(response).To(BeNil())
 	})
 
@@ -64,6 +66,23 @@ var _ = Describe("WorkspaceList", func() {
 		}))
 	})
 
+	It("should forward pagination options", func() {
+		// given
+		username := "foo"
+		ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, username)
+		request.Limit = 10
+		request.Continue = "token"
+		lister.EXPECT().
+			ListUserWorkspaces(ctx, username, &restworkspacesv1alpha1.WorkspaceList{}, &client.ListOptions{Limit: 10, Continue: "token"}).
+			Return(nil)
+
+		// when
+		_, err := handler.Handle(ctx, request)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+	})
+
 	It("should forward errors from the workspace reader", func() {
 		// given
 		username := "foo"
@@ -0,0 +1,148 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/konflux-workspaces/workspaces/server/core"
+	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
+	"github.com/konflux-workspaces/workspaces/server/log"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+// WorkspaceMembersUpdater is the interface the data source needs to implement to allow the
+// AddWorkspaceMemberHandler and RemoveWorkspaceMemberHandler to update the members of a Workspace
+type WorkspaceMembersUpdater interface {
+	UpdateUserWorkspaceMembers(ctx context.Context, user string, obj *restworkspacesv1alpha1.Workspace, opts ...client.UpdateOption) error
+}
+
+// AddWorkspaceMemberCommand contains the information needed to add a member to a Workspace, or to update its role
+type AddWorkspaceMemberCommand struct {
+	Owner     string
+	Workspace string
+	Member    restworkspacesv1alpha1.WorkspaceMember
+}
+
+// AddWorkspaceMemberResponse contains the updated workspace
+type AddWorkspaceMemberResponse struct {
+	Workspace *restworkspacesv1alpha1.Workspace
+}
+
+// AddWorkspaceMemberHandler processes AddWorkspaceMemberCommand and returns AddWorkspaceMemberResponse
+type AddWorkspaceMemberHandler struct {
+	reader  WorkspaceReader
+	updater WorkspaceMembersUpdater
+}
+
+// NewAddWorkspaceMemberHandler creates a new AddWorkspaceMemberHandler that uses the specified WorkspaceReader and WorkspaceMembersUpdater
+func NewAddWorkspaceMemberHandler(reader WorkspaceReader, updater WorkspaceMembersUpdater) *AddWorkspaceMemberHandler {
+	return &AddWorkspaceMemberHandler{
+		reader:  reader,
+		updater: updater,
+	}
+}
+
+// Handle handles a AddWorkspaceMemberCommand and returns a AddWorkspaceMemberResponse or an error
+func (h *AddWorkspaceMemberHandler) Handle(ctx context.Context, command AddWorkspaceMemberCommand) (*AddWorkspaceMemberResponse, error) {
+	// authorization
+	// Only owners and admins are allowed to manage members, this is checked by the WorkspaceMembersUpdater
+	u, ok := ctx.Value(ccontext.UserSignupComplaintNameKey).(string)
+	if !ok {
+		return nil, core.NewUnauthenticatedError()
+	}
+
+	// retrieve workspace
+	w := restworkspacesv1alpha1.Workspace{}
+	if err := h.reader.ReadUserWorkspace(ctx, u, command.Owner, command.Workspace, &w); err != nil {
+		return nil, err
+	}
+
+	// add the member or update its role
+	i := slices.IndexFunc(w.Spec.Members, func(m restworkspacesv1alpha1.WorkspaceMember) bool {
+		return m.Username == command.Member.Username
+	})
+	if i == -1 {
+		w.Spec.Members = append(w.Spec.Members, command.Member)
+	} else {
+		w.Spec.Members[i] = command.Member
+	}
+
+	// data access
+	log.FromContext(ctx).Debug("adding workspace member", "workspace", w, "member", command.Member)
+	opts := &client.UpdateOptions{}
+	if err := h.updater.UpdateUserWorkspaceMembers(ctx, u, &w, opts); err != nil {
+		return nil, err
+	}
+
+	// reply
+	return &AddWorkspaceMemberResponse{
+		Workspace: &w,
+	}, nil
+}
+
+// RemoveWorkspaceMemberCommand contains the information needed to remove a member from a Workspace
+type RemoveWorkspaceMemberCommand struct {
+	Owner     string
+	Workspace string
+	Member    string
+}
+
+// RemoveWorkspaceMemberResponse contains the updated workspace
+type RemoveWorkspaceMemberResponse struct {
+	Workspace *restworkspacesv1alpha1.Workspace
+}
+
+// RemoveWorkspaceMemberHandler processes RemoveWorkspaceMemberCommand and returns RemoveWorkspaceMemberResponse
+type RemoveWorkspaceMemberHandler struct {
+	reader  WorkspaceReader
+	updater WorkspaceMembersUpdater
+}
+
+// NewRemoveWorkspaceMemberHandler creates a new RemoveWorkspaceMemberHandler that uses the specified WorkspaceReader and WorkspaceMembersUpdater
+func NewRemoveWorkspaceMemberHandler(reader WorkspaceReader, updater WorkspaceMembersUpdater) *RemoveWorkspaceMemberHandler {
+	return &RemoveWorkspaceMemberHandler{
+		reader:  reader,
+		updater: updater,
+	}
+}
+
+// Handle handles a RemoveWorkspaceMemberCommand and returns a RemoveWorkspaceMemberResponse or an error
+func (h *RemoveWorkspaceMemberHandler) Handle(ctx context.Context, command RemoveWorkspaceMemberCommand) (*RemoveWorkspaceMemberResponse, error) {
+	// authorization
+	// Only owners and admins are allowed to manage members, this is checked by the WorkspaceMembersUpdater
+	u, ok := ctx.Value(ccontext.UserSignupComplaintNameKey).(string)
+	if !ok {
+		return nil, core.NewUnauthenticatedError()
+	}
+
+	// retrieve workspace
+	w := restworkspacesv1alpha1.Workspace{}
+	if err := h.reader.ReadUserWorkspace(ctx, u, command.Owner, command.Workspace, &w); err != nil {
+		return nil, err
+	}
+
+	// remove the member
+	i := slices.IndexFunc(w.Spec.Members, func(m restworkspacesv1alpha1.WorkspaceMember) bool {
+		return m.Username == command.Member
+	})
+	if i == -1 {
+		return nil, fmt.Errorf("%w: member %s", core.ErrNotFound, command.Member)
+	}
+	w.Spec.Members = slices.Delete(w.Spec.Members, i, i+1)
+
+	// data access
+	log.FromContext(ctx).Debug("removing workspace member", "workspace", w, "member", command.Member)
+	opts := &client.UpdateOptions{}
+	if err := h.updater.UpdateUserWorkspaceMembers(ctx, u, &w, opts); err != nil {
+		return nil, err
+	}
+
+	// reply
+	return &RemoveWorkspaceMemberResponse{
+		Workspace: &w,
+	}, nil
+}
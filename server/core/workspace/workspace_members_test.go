@@ -0,0 +1,204 @@
+package workspace_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/konflux-workspaces/workspaces/server/core"
+	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
+	"github.com/konflux-workspaces/workspaces/server/core/workspace"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("WorkspaceMembers", func() {
+	var (
+		ctrl    *gomock.Controller
+		ctx     context.Context
+		reader  *MockWorkspaceReader
+		updater *MockWorkspaceMembersUpdater
+		w       restworkspacesv1alpha1.Workspace
+	)
+
+	username := "foo"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		reader = NewMockWorkspaceReader(ctrl)
+		updater = NewMockWorkspaceMembersUpdater(ctrl)
+		w = restworkspacesv1alpha1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "default",
+				Namespace: username,
+			},
+			Spec: restworkspacesv1alpha1.WorkspaceSpec{
+				Visibility: restworkspacesv1alpha1.WorkspaceVisibilityPrivate,
+				Members: []restworkspacesv1alpha1.WorkspaceMember{
+					{Username: "bar", Role: restworkspacesv1alpha1.WorkspaceRoleViewer},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	expectRead := func(ctx context.Context) {
+		reader.EXPECT().
+			ReadUserWorkspace(ctx, username, w.Namespace, w.Name, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _, _ string, rw *restworkspacesv1alpha1.Workspace, _ ...client.GetOption) error {
+				w.DeepCopyInto(rw)
+				return nil
+			})
+	}
+
+	expectUpdatedMembers := func(ctx context.Context, mm ...restworkspacesv1alpha1.WorkspaceMember) {
+		updater.EXPECT().
+			UpdateUserWorkspaceMembers(ctx, username, gomock.Any(), &client.UpdateOptions{}).
+			DoAndReturn(func(_ context.Context, _ string, uw *restworkspacesv1alpha1.Workspace, _ ...client.UpdateOption) error {
+				Expect(uw.Spec.Members).To(Equal(mm))
+				return nil
+			})
+	}
+
+	Describe("AddWorkspaceMemberHandler", func() {
+		var handler workspace.AddWorkspaceMemberHandler
+		var request workspace.AddWorkspaceMemberCommand
+
+		BeforeEach(func() {
+			handler = *workspace.NewAddWorkspaceMemberHandler(reader, updater)
+			request = workspace.AddWorkspaceMemberCommand{
+				Owner:     w.Namespace,
+				Workspace: w.Name,
+				Member:    restworkspacesv1alpha1.WorkspaceMember{Username: "baz", Role: restworkspacesv1alpha1.WorkspaceRoleContributor},
+			}
+		})
+
+		It("should not allow unauthenticated requests", func() {
+			// don't set the "user" value within ctx
+
+			response, err := handler.Handle(ctx, request)
+			Expect(err).To(Equal(core.NewUnauthenticatedError()))
+			Expect(response).To(BeNil())
+		})
+
+		It("should add a new member", func() {
+			// given
+			ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, username)
+			expectRead(ctx)
+			expectUpdatedMembers(ctx, w.Spec.Members[0], request.Member)
+
+			// when
+			response, err := handler.Handle(ctx, request)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Workspace.Spec.Members).To(ConsistOf(w.Spec.Members[0], request.Member))
+		})
+
+		It("should update the role of an existing member", func() {
+			// given
+			ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, username)
+			request.Member = restworkspacesv1alpha1.WorkspaceMember{Username: "bar", Role: restworkspacesv1alpha1.WorkspaceRoleAdmin}
+			expectRead(ctx)
+			expectUpdatedMembers(ctx, request.Member)
+
+			// when
+			response, err := handler.Handle(ctx, request)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Workspace.Spec.Members).To(ConsistOf(request.Member))
+		})
+
+		It("should forward errors from the workspace reader", func() {
+			// given
+			ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, username)
+			expectedErr := fmt.Errorf("failed to read workspace")
+			reader.EXPECT().
+				ReadUserWorkspace(ctx, username, w.Namespace, w.Name, gomock.Any(), gomock.Any()).
+				Return(expectedErr)
+
+			// when
+			response, err := handler.Handle(ctx, request)
+
+			// then
+			Expect(err).To(Equal(expectedErr))
+			Expect(response).To(BeNil())
+		})
+
+		It("should forward errors from the members updater", func() {
+			// given
+			ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, username)
+			expectedErr := fmt.Errorf("failed to update members")
+			expectRead(ctx)
+			updater.EXPECT().
+				UpdateUserWorkspaceMembers(ctx, username, gomock.Any(), &client.UpdateOptions{}).
+				Return(expectedErr)
+
+			// when
+			response, err := handler.Handle(ctx, request)
+
+			// then
+			Expect(err).To(Equal(expectedErr))
+			Expect(response).To(BeNil())
+		})
+	})
+
+	Describe("RemoveWorkspaceMemberHandler", func() {
+		var handler workspace.RemoveWorkspaceMemberHandler
+		var request workspace.RemoveWorkspaceMemberCommand
+
+		BeforeEach(func() {
+			handler = *workspace.NewRemoveWorkspaceMemberHandler(reader, updater)
+			request = workspace.RemoveWorkspaceMemberCommand{
+				Owner:     w.Namespace,
+				Workspace: w.Name,
+				Member:    "bar",
+			}
+		})
+
+		It("should not allow unauthenticated requests", func() {
+			// don't set the "user" value within ctx
+
+			response, err := handler.Handle(ctx, request)
+			Expect(err).To(Equal(core.NewUnauthenticatedError()))
+			Expect(response).To(BeNil())
+		})
+
+		It("should remove an existing member", func() {
+			// given
+			ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, username)
+			expectRead(ctx)
+			expectUpdatedMembers(ctx, []restworkspacesv1alpha1.WorkspaceMember{}...)
+
+			// when
+			response, err := handler.Handle(ctx, request)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Workspace.Spec.Members).To(BeEmpty())
+		})
+
+		It("should return not found if the user is not a member", func() {
+			// given
+			ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, username)
+			request.Member = "not-a-member"
+			expectRead(ctx)
+
+			// when
+			response, err := handler.Handle(ctx, request)
+
+			// then
+			Expect(err).To(MatchError(core.ErrNotFound))
+			Expect(response).To(BeNil())
+		})
+	})
+})
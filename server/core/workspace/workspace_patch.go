@@ -4,12 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 
 	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/konflux-workspaces/workspaces/server/core"
 	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
 	"github.com/konflux-workspaces/workspaces/server/log"
 
@@ -23,24 +29,40 @@ type PatchWorkspaceCommand struct {
 	Workspace string
 	Patch     []byte
 	PatchType types.PatchType
+
+	// FieldManager is the name of the actor making the changes, required for apply patches
+	FieldManager string
+	// Force makes apply patches take ownership of the fields owned by other managers instead of conflicting
+	Force bool
+	// DryRun processes the command without persisting the changes
+	DryRun bool
+	// ResourceVersion, if set, is the resourceVersion the workspace must have for the patch to be applied
+	ResourceVersion string
 }
 
 // PatchWorkspaceResponse contains the workspace the user requested
 type PatchWorkspaceResponse struct {
 	Workspace *restworkspacesv1alpha1.Workspace
+	// Created is true if the workspace did not exist and was created by an apply patch
+	Created bool
 }
 
 // PatchWorkspaceHandler processes PatchWorkspaceCommand and returns PatchWorkspaceResponse fetching data from a WorkspacePatcher
 type PatchWorkspaceHandler struct {
-	reader  WorkspaceReader
-	updater WorkspaceUpdater
+	reader       WorkspaceReader
+	updater      WorkspaceUpdater
+	creator      WorkspaceCreator
+	fieldManager FieldManager
 }
 
-// NewPatchWorkspaceHandler creates a new PatchWorkspaceHandler that uses a specified WorkspacePatcher
-func NewPatchWorkspaceHandler(reader WorkspaceReader, updater WorkspaceUpdater) *PatchWorkspaceHandler {
+// NewPatchWorkspaceHandler creates a new PatchWorkspaceHandler that uses a specified WorkspacePatcher.
+// The creator is used by apply patches targeting a workspace that does not exist yet.
+func NewPatchWorkspaceHandler(reader WorkspaceReader, updater WorkspaceUpdater, creator WorkspaceCreator, fieldManager FieldManager) *PatchWorkspaceHandler {
 	return &PatchWorkspaceHandler{
-		reader:  reader,
-		updater: updater,
+		reader:       reader,
+		updater:      updater,
+		creator:      creator,
+		fieldManager: fieldManager,
 	}
 }
 
@@ -50,18 +72,34 @@ func (h *PatchWorkspaceHandler) Handle(ctx context.Context, command PatchWorkspa
 	// If required, implement here complex logic like multiple-domains filtering, etc
 	u, ok := ctx.Value(ccontext.UserSignupComplaintNameKey).(string)
 	if !ok {
-		return nil, fmt.Errorf("unauthenticated request")
+		return nil, core.NewUnauthenticatedError()
 	}
 
 	// validate query
 	// TODO: sanitize input, block reserved labels, etc
+	if command.PatchType == types.ApplyPatchType && command.FieldManager == "" {
+		return nil, kerrors.NewBadRequest("fieldManager is required for apply patch")
+	}
 
 	// retrieve workspace
 	w := workspacesv1alpha1.Workspace{}
-	if err := h.reader.ReadUserWorkspace(ctx, u, command.Owner, command.Workspace, &w); err != nil {
+	switch err := h.reader.ReadUserWorkspace(ctx, u, command.Owner, command.Workspace, &w); {
+	case err == nil:
+	case command.PatchType == types.ApplyPatchType && command.ResourceVersion == "" && kerrors.IsNotFound(err):
+		// server-side apply creates the workspace if it does not exist
+		return h.createApplied(ctx, u, command)
+	default:
 		return nil, err
 	}
 
+	// check the resourceVersion precondition
+	if command.ResourceVersion != "" && command.ResourceVersion != w.ResourceVersion {
+		return nil, kerrors.NewConflict(
+			workspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource(),
+			command.Workspace,
+			fmt.Errorf("the resourceVersion of the workspace is %q, not %q", w.ResourceVersion, command.ResourceVersion))
+	}
+
 	// apply patch
 	pw, err := h.applyPatch(&w, command)
 	if err != nil {
@@ -70,6 +108,9 @@ func (h *PatchWorkspaceHandler) Handle(ctx context.Context, command PatchWorkspa
 
 	log.FromContext(ctx).Debug("updating workspace", "workspace", pw)
 	opts := &client.UpdateOptions{}
+	if command.DryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
 	if err := h.updater.UpdateUserWorkspace(ctx, u, pw, opts); err != nil {
 		return nil, err
 	}
@@ -80,15 +121,143 @@ func (h *PatchWorkspaceHandler) Handle(ctx context.Context, command PatchWorkspa
 	}, nil
 }
 
+func (h *PatchWorkspaceHandler) createApplied(ctx context.Context, user string, command PatchWorkspaceCommand) (*PatchWorkspaceResponse, error) {
+	w := workspacesv1alpha1.Workspace{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Workspace",
+			APIVersion: workspacesv1alpha1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      command.Workspace,
+			Namespace: command.Owner,
+		},
+	}
+
+	// apply patch
+	pw, err := h.applyApplyPatch(&w, command)
+	if err != nil {
+		return nil, fmt.Errorf("error patching Workspace %s/%s: %w", command.Owner, command.Workspace, err)
+	}
+
+	log.FromContext(ctx).Debug("creating applied workspace", "workspace", pw)
+	opts := &client.CreateOptions{}
+	if command.DryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+	if err := h.creator.CreateUserWorkspace(ctx, user, pw, opts); err != nil {
+		return nil, err
+	}
+
+	// reply
+	return &PatchWorkspaceResponse{
+		Workspace: pw,
+		Created:   true,
+	}, nil
+}
+
 func (h *PatchWorkspaceHandler) applyPatch(w *workspacesv1alpha1.Workspace, command PatchWorkspaceCommand) (*workspacesv1alpha1.Workspace, error) {
+	var pw *workspacesv1alpha1.Workspace
+	var err error
 	switch command.PatchType {
+	case types.ApplyPatchType:
+		return h.applyApplyPatch(w, command)
+	case types.JSONPatchType:
+		pw, err = h.applyJSONPatch(w, command.Patch)
 	case types.MergePatchType:
-		return h.applyMergePatch(w, command.Patch)
+		pw, err = h.applyMergePatch(w, command.Patch)
 	case types.StrategicMergePatchType:
-		return h.applyStrategicMergePatch(w, command.Patch)
+		pw, err = h.applyStrategicMergePatch(w, command.Patch)
 	default:
 		return nil, fmt.Errorf("unsupported patch type: %s", command.PatchType)
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	// track the fields changed by the manager
+	return h.updateManagedFields(w, pw, command.FieldManager)
+}
+
+func (h *PatchWorkspaceHandler) applyApplyPatch(w *workspacesv1alpha1.Workspace, command PatchWorkspaceCommand) (*workspacesv1alpha1.Workspace, error) {
+	// parse the applied configuration
+	j, err := yaml.YAMLToJSON(command.Patch)
+	if err != nil {
+		return nil, kerrors.NewBadRequest(fmt.Sprintf("error decoding YAML: %v", err))
+	}
+	ac := &unstructured.Unstructured{}
+	if err := ac.UnmarshalJSON(j); err != nil {
+		return nil, kerrors.NewBadRequest(fmt.Sprintf("error decoding applied configuration: %v", err))
+	}
+
+	// the applied configuration must refer to the patched workspace
+	switch {
+	case ac.GroupVersionKind() != workspacesv1alpha1.GroupVersion.WithKind("Workspace"):
+		return nil, kerrors.NewBadRequest(fmt.Sprintf("applied configuration must be a Workspace %s, got %s", workspacesv1alpha1.GroupVersion, ac.GroupVersionKind()))
+	case ac.GetName() != command.Workspace:
+		return nil, kerrors.NewBadRequest("the name of the object in the body must match the name in the URL")
+	case ac.GetNamespace() != "" && ac.GetNamespace() != command.Owner:
+		return nil, kerrors.NewBadRequest("the namespace of the object in the body must match the namespace in the URL")
+	}
+
+	// merge the applied configuration, conflicts are reported as kerrors.StatusError
+	// while any other error is caused by a configuration not matching the schema
+	o, err := h.fieldManager.Apply(w, ac, command.FieldManager, command.Force)
+	if err != nil {
+		if _, ok := err.(kerrors.APIStatus); ok {
+			return nil, err
+		}
+		return nil, kerrors.NewBadRequest(err.Error())
+	}
+	pw, ok := o.(*workspacesv1alpha1.Workspace)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T returned applying the configuration", o)
+	}
+	return pw, nil
+}
+
+func (h *PatchWorkspaceHandler) updateManagedFields(w, pw *workspacesv1alpha1.Workspace, manager string) (*workspacesv1alpha1.Workspace, error) {
+	o, err := h.fieldManager.Update(w, pw, manager)
+	if err != nil {
+		return nil, err
+	}
+	mw, ok := o.(*workspacesv1alpha1.Workspace)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T returned updating managed fields", o)
+	}
+	return mw, nil
+}
+
+func (h *PatchWorkspaceHandler) applyJSONPatch(w *workspacesv1alpha1.Workspace, patch []byte) (*workspacesv1alpha1.Workspace, error) {
+	// decode RFC 6902 patch
+	p, err := jsonpatch.DecodePatch(patch)
+	if err != nil {
+		return nil, kerrors.NewBadRequest(fmt.Sprintf("error decoding JSON patch: %v", err))
+	}
+
+	// marshal workspace as json
+	wj, err := json.Marshal(w)
+	if err != nil {
+		return nil, err
+	}
+
+	// apply jsonpatch, failing test operations included
+	pwj, err := p.Apply(wj)
+	if err != nil {
+		return nil, &kerrors.StatusError{ErrStatus: metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusUnprocessableEntity,
+			Reason:  metav1.StatusReasonInvalid,
+			Message: fmt.Sprintf("error applying JSON patch: %v", err),
+		}}
+	}
+
+	// unmarshal json to struct
+	pw := workspacesv1alpha1.Workspace{}
+	if err := json.Unmarshal(pwj, &pw); err != nil {
+		return nil, err
+	}
+
+	return &pw, nil
 }
 
 func (h *PatchWorkspaceHandler) applyMergePatch(w *workspacesv1alpha1.Workspace, patch []byte) (*workspacesv1alpha1.Workspace, error) {
@@ -7,11 +7,14 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/managedfields"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/konflux-workspaces/workspaces/server/core"
 	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
 	"github.com/konflux-workspaces/workspaces/server/core/workspace"
 
@@ -24,6 +27,7 @@ var _ = Describe("", func() {
 		ctx     context.Context
 		reader  *MockWorkspaceReader
 		updater *MockWorkspaceUpdater
+		creator *MockWorkspaceCreator
 		request workspace.PatchWorkspaceCommand
 		handler workspace.PatchWorkspaceHandler
 		w       workspacesv1alpha1.Workspace
@@ -33,9 +37,14 @@ var _ = Describe("", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		ctx = context.Background()
 		w = workspacesv1alpha1.Workspace{
+			TypeMeta: v1.TypeMeta{
+				Kind:       "Workspace",
+				APIVersion: workspacesv1alpha1.GroupVersion.String(),
+			},
 			ObjectMeta: v1.ObjectMeta{
-				Name:      "default",
-				Namespace: "user",
+				Name:            "default",
+				Namespace:       "user",
+				ResourceVersion: "2",
 			},
 			Spec: workspacesv1alpha1.WorkspaceSpec{
 				Visibility: workspacesv1alpha1.WorkspaceVisibilityPrivate,
@@ -43,11 +52,15 @@ var _ = Describe("", func() {
 		}
 		updater = NewMockWorkspaceUpdater(ctrl)
 		reader = NewMockWorkspaceReader(ctrl)
+		creator = NewMockWorkspaceCreator(ctrl)
 		request = workspace.PatchWorkspaceCommand{
-			Workspace: w.Name,
-			Owner:     w.Namespace,
+			Workspace:    w.Name,
+			Owner:        w.Namespace,
+			FieldManager: "kubectl",
 		}
-		handler = *workspace.NewPatchWorkspaceHandler(reader, updater)
+		fieldManager, err := workspace.NewFieldManager(managedfields.NewDeducedTypeConverter())
+		Expect(err).NotTo(HaveOccurred())
+		handler = *workspace.NewPatchWorkspaceHandler(reader, updater, creator, fieldManager)
 	})
 
 	AfterEach(func() { ctrl.Finish() })
@@ -57,7 +70,7 @@ var _ = Describe("", func() {
 
 		response, err := handler.Handle(ctx, request)
 		Expect(err).To(HaveOccurred())
-		Expect(err).To(Equal(fmt.Errorf("unauthenticated request")))
+		Expect(err).To(Equal(core.NewUnauthenticatedError()))
 		Expect(response).To(BeNil())
 	})
 
@@ -87,6 +100,8 @@ var _ = Describe("", func() {
 			Expect(response).NotTo(BeNil())
 			expectedWorkspace := w.DeepCopy()
 			expectedWorkspace.Spec.Visibility = workspacesv1alpha1.WorkspaceVisibilityCommunity
+			expectManagedBy(response.Workspace, "kubectl", v1.ManagedFieldsOperationUpdate)
+			response.Workspace.ManagedFields = nil
 			Expect(response.Workspace).To(BeEquivalentTo(expectedWorkspace))
 		})
 
@@ -115,8 +130,274 @@ var _ = Describe("", func() {
 			Expect(response).NotTo(BeNil())
 			expectedWorkspace := w.DeepCopy()
 			expectedWorkspace.Spec.Visibility = workspacesv1alpha1.WorkspaceVisibilityCommunity
+			expectManagedBy(response.Workspace, "kubectl", v1.ManagedFieldsOperationUpdate)
+			response.Workspace.ManagedFields = nil
 			Expect(response.Workspace).To(BeEquivalentTo(expectedWorkspace))
 		})
+
+		Context("with user foo", func() {
+			username := "foo"
+			var uctx context.Context
+
+			BeforeEach(func() {
+				uctx = context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, username)
+			})
+
+			expectRead := func(rw workspacesv1alpha1.Workspace) {
+				reader.EXPECT().
+					ReadUserWorkspace(uctx, username, w.Namespace, w.Name, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, user, owner, workspace string, ww *workspacesv1alpha1.Workspace, opts ...client.GetOption) error {
+						rw.DeepCopyInto(ww)
+						return nil
+					})
+			}
+			expectUpdate := func() {
+				updater.EXPECT().
+					UpdateUserWorkspace(uctx, username, gomock.Any(), gomock.Any()).
+					Return(nil)
+			}
+			expectNoUpdate := func() {
+				updater.EXPECT().
+					UpdateUserWorkspace(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			}
+
+			It("should allow JSON patch", func() {
+				// given
+				request.PatchType = types.JSONPatchType
+				request.Patch = []byte(`[
+					{"op":"test","path":"/spec/visibility","value":"private"},
+					{"op":"replace","path":"/spec/visibility","value":"community"}
+				]`)
+				expectRead(w)
+				expectUpdate()
+
+				// when
+				response, err := handler.Handle(uctx, request)
+
+				// then
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Workspace.Spec.Visibility).To(Equal(workspacesv1alpha1.WorkspaceVisibilityCommunity))
+				expectManagedBy(response.Workspace, "kubectl", v1.ManagedFieldsOperationUpdate)
+			})
+
+			It("should reject JSON patch with a failing test operation", func() {
+				// given
+				request.PatchType = types.JSONPatchType
+				request.Patch = []byte(`[
+					{"op":"test","path":"/spec/visibility","value":"community"},
+					{"op":"replace","path":"/spec/visibility","value":"private"}
+				]`)
+				expectRead(w)
+				expectNoUpdate()
+
+				// when
+				response, err := handler.Handle(uctx, request)
+
+				// then
+				Expect(response).To(BeNil())
+				Expect(kerrors.ReasonForError(err)).To(Equal(v1.StatusReasonInvalid))
+			})
+
+			It("should reject malformed JSON patch", func() {
+				// given
+				request.PatchType = types.JSONPatchType
+				request.Patch = []byte(`{"spec":{"visibility":"community"}}`)
+				expectRead(w)
+				expectNoUpdate()
+
+				// when
+				_, err := handler.Handle(uctx, request)
+
+				// then
+				Expect(kerrors.IsBadRequest(err)).To(BeTrue())
+			})
+
+			It("should require a field manager for apply patch", func() {
+				// given
+				request.PatchType = types.ApplyPatchType
+				request.FieldManager = ""
+				request.Patch = applyConfiguration(w.Name, "community")
+
+				// when
+				_, err := handler.Handle(uctx, request)
+
+				// then
+				Expect(kerrors.IsBadRequest(err)).To(BeTrue())
+			})
+
+			It("should allow apply patch", func() {
+				// given
+				mw := *w.DeepCopy()
+				mw.ManagedFields = []v1.ManagedFieldsEntry{{
+					Manager:    "kubectl",
+					Operation:  v1.ManagedFieldsOperationApply,
+					APIVersion: workspacesv1alpha1.GroupVersion.String(),
+					FieldsType: "FieldsV1",
+					FieldsV1:   &v1.FieldsV1{Raw: []byte(`{"f:spec":{"f:visibility":{}}}`)},
+				}}
+				request.PatchType = types.ApplyPatchType
+				request.Patch = applyConfiguration(w.Name, "community")
+				expectRead(mw)
+				expectUpdate()
+
+				// when
+				response, err := handler.Handle(uctx, request)
+
+				// then
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Created).To(BeFalse())
+				Expect(response.Workspace.Spec.Visibility).To(Equal(workspacesv1alpha1.WorkspaceVisibilityCommunity))
+				expectManagedBy(response.Workspace, "kubectl", v1.ManagedFieldsOperationApply)
+			})
+
+			It("should conflict with the fields set before the first apply", func() {
+				// given
+				request.PatchType = types.ApplyPatchType
+				request.Patch = applyConfiguration(w.Name, "community")
+				expectRead(w)
+				expectNoUpdate()
+
+				// when
+				_, err := handler.Handle(uctx, request)
+
+				// then
+				Expect(kerrors.IsConflict(err)).To(BeTrue())
+			})
+
+			It("should reject apply patch for a different workspace", func() {
+				// given
+				request.PatchType = types.ApplyPatchType
+				request.Patch = applyConfiguration("other", "community")
+				expectRead(w)
+				expectNoUpdate()
+
+				// when
+				_, err := handler.Handle(uctx, request)
+
+				// then
+				Expect(kerrors.IsBadRequest(err)).To(BeTrue())
+			})
+
+			When("the field is owned by another manager", func() {
+				var ow workspacesv1alpha1.Workspace
+
+				BeforeEach(func() {
+					request.PatchType = types.ApplyPatchType
+					request.FieldManager = "argocd"
+					request.Patch = applyConfiguration(w.Name, "private")
+					expectRead(w)
+					expectUpdate()
+					response, err := handler.Handle(uctx, request)
+					Expect(err).NotTo(HaveOccurred())
+					ow = *response.Workspace
+
+					request.FieldManager = "kubectl"
+					request.Patch = applyConfiguration(w.Name, "community")
+				})
+
+				It("should conflict", func() {
+					// given
+					expectRead(ow)
+					expectNoUpdate()
+
+					// when
+					_, err := handler.Handle(uctx, request)
+
+					// then
+					Expect(kerrors.IsConflict(err)).To(BeTrue())
+				})
+
+				It("should take ownership if forced", func() {
+					// given
+					request.Force = true
+					expectRead(ow)
+					expectUpdate()
+
+					// when
+					response, err := handler.Handle(uctx, request)
+
+					// then
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.Workspace.Spec.Visibility).To(Equal(workspacesv1alpha1.WorkspaceVisibilityCommunity))
+					expectManagedBy(response.Workspace, "kubectl", v1.ManagedFieldsOperationApply)
+				})
+			})
+
+			It("should patch if the resourceVersion precondition holds", func() {
+				// given
+				request.PatchType = types.MergePatchType
+				request.Patch = []byte(`{"spec":{"visibility":"community"}}`)
+				request.ResourceVersion = w.ResourceVersion
+				expectRead(w)
+				expectUpdate()
+
+				// when
+				response, err := handler.Handle(uctx, request)
+
+				// then
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Workspace.Spec.Visibility).To(Equal(workspacesv1alpha1.WorkspaceVisibilityCommunity))
+			})
+
+			It("should conflict if the resourceVersion precondition does not hold", func() {
+				// given
+				request.PatchType = types.MergePatchType
+				request.Patch = []byte(`{"spec":{"visibility":"community"}}`)
+				request.ResourceVersion = "1"
+				expectRead(w)
+				expectNoUpdate()
+
+				// when
+				response, err := handler.Handle(uctx, request)
+
+				// then
+				Expect(response).To(BeNil())
+				Expect(kerrors.IsConflict(err)).To(BeTrue())
+			})
+
+			It("should forward the dry run option to the updater", func() {
+				// given
+				request.PatchType = types.JSONPatchType
+				request.Patch = []byte(`[{"op":"replace","path":"/spec/visibility","value":"community"}]`)
+				request.DryRun = true
+				expectRead(w)
+				updater.EXPECT().
+					UpdateUserWorkspace(uctx, username, gomock.Any(), &client.UpdateOptions{DryRun: []string{v1.DryRunAll}}).
+					Return(nil)
+
+				// when
+				response, err := handler.Handle(uctx, request)
+
+				// then
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Workspace.Spec.Visibility).To(Equal(workspacesv1alpha1.WorkspaceVisibilityCommunity))
+			})
+
+			It("should create the workspace with apply patch if it does not exist", func() {
+				// given
+				request.PatchType = types.ApplyPatchType
+				request.Patch = applyConfiguration(w.Name, "community")
+				reader.EXPECT().
+					ReadUserWorkspace(uctx, username, w.Namespace, w.Name, gomock.Any(), gomock.Any()).
+					Return(kerrors.NewNotFound(workspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource(), w.Name))
+				creator.EXPECT().
+					CreateUserWorkspace(uctx, username, gomock.Any(), gomock.Any()).
+					Return(nil)
+				expectNoUpdate()
+
+				// when
+				response, err := handler.Handle(uctx, request)
+
+				// then
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Created).To(BeTrue())
+				Expect(response.Workspace.Name).To(Equal(w.Name))
+				Expect(response.Workspace.Namespace).To(Equal(w.Namespace))
+				Expect(response.Workspace.Spec.Visibility).To(Equal(workspacesv1alpha1.WorkspaceVisibilityCommunity))
+				expectManagedBy(response.Workspace, "kubectl", v1.ManagedFieldsOperationApply)
+			})
+		})
 	})
 
 	DescribeTable("Unsupported patch types are rejected",
@@ -144,6 +425,25 @@ var _ = Describe("", func() {
 		},
 		Entry("empty patchType", types.PatchType("")),
 		Entry("invalid patchType", types.PatchType("bar")),
-		Entry("apply patch", types.ApplyPatchType),
 	)
 })
+
+// expectManagedBy expects the workspace to have a managedFields entry for the given manager and operation
+func expectManagedBy(w *workspacesv1alpha1.Workspace, manager string, operation v1.ManagedFieldsOperationType) {
+	Expect(w.ManagedFields).To(ContainElement(And(
+		HaveField("Manager", manager),
+		HaveField("Operation", operation),
+	)))
+}
+
+// applyConfiguration builds the YAML configuration of a Workspace to use in an apply patch
+func applyConfiguration(name, visibility string) []byte {
+	return []byte(fmt.Sprintf(`
+apiVersion: workspaces.konflux-ci.dev/v1alpha1
+kind: Workspace
+metadata:
+  name: %s
+spec:
+  visibility: %s
+`, name, visibility))
+}
@@ -10,6 +10,7 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/konflux-workspaces/workspaces/server/core"
 	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
 	"github.com/konflux-workspaces/workspaces/server/core/workspace"
 
@@ -40,7 +41,7 @@ var _ = Describe("WorkspaceRead", func() {
 
 		response, err := handler.Handle(ctx, request)
 		Expect(err).To(HaveOccurred())
-		Expect(err).To(Equal(fmt.Errorf("unauthenticated request")))
+		Expect(err).To(Equal(core.NewUnauthenticatedError()))
 		Expect(response).To(BeNil())
 	})
 
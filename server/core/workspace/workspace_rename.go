@@ -0,0 +1,69 @@
+package workspace
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+	"github.com/konflux-workspaces/workspaces/server/core"
+	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
+	"github.com/konflux-workspaces/workspaces/server/log"
+)
+
+// RenameWorkspaceCommand contains the information needed to rename a Workspace the user owns
+type RenameWorkspaceCommand struct {
+	Owner     string
+	Workspace string
+	// NewName is the name the Workspace is renamed to
+	NewName string
+}
+
+// RenameWorkspaceResponse contains the renamed workspace
+type RenameWorkspaceResponse struct {
+	Workspace *restworkspacesv1alpha1.Workspace
+}
+
+// WorkspaceRenamer is the interface the data source needs to implement to allow the RenameWorkspaceHandler to rename a Workspace
+type WorkspaceRenamer interface {
+	RenameUserWorkspace(ctx context.Context, user string, obj *restworkspacesv1alpha1.Workspace, name string, opts ...client.UpdateOption) error
+}
+
+// RenameWorkspaceHandler processes RenameWorkspaceCommand and returns RenameWorkspaceResponse renaming data in a WorkspaceRenamer
+type RenameWorkspaceHandler struct {
+	renamer WorkspaceRenamer
+}
+
+// NewRenameWorkspaceHandler creates a new RenameWorkspaceHandler that uses a specified WorkspaceRenamer
+func NewRenameWorkspaceHandler(renamer WorkspaceRenamer) *RenameWorkspaceHandler {
+	return &RenameWorkspaceHandler{renamer: renamer}
+}
+
+// Handle handles a RenameWorkspaceCommand and returns a RenameWorkspaceResponse or an error
+func (h *RenameWorkspaceHandler) Handle(ctx context.Context, command RenameWorkspaceCommand) (*RenameWorkspaceResponse, error) {
+	// authorization
+	// Only owners are allowed to rename workspaces, this is checked by the WorkspaceRenamer
+	u, ok := ctx.Value(ccontext.UserSignupComplaintNameKey).(string)
+	if !ok {
+		return nil, core.NewUnauthenticatedError()
+	}
+
+	// data access
+	w := &restworkspacesv1alpha1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      command.Workspace,
+			Namespace: command.Owner,
+		},
+	}
+	log.FromContext(ctx).Debug("renaming workspace", "workspace", w, "name", command.NewName)
+	opts := &client.UpdateOptions{}
+	if err := h.renamer.RenameUserWorkspace(ctx, u, w, command.NewName, opts); err != nil {
+		return nil, err
+	}
+
+	// reply
+	return &RenameWorkspaceResponse{
+		Workspace: w,
+	}, nil
+}
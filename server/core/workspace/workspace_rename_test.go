@@ -0,0 +1,92 @@
+package workspace_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/konflux-workspaces/workspaces/server/core"
+	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
+	"github.com/konflux-workspaces/workspaces/server/core/workspace"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("Rename", func() {
+	var (
+		ctrl    *gomock.Controller
+		ctx     context.Context
+		renamer *MockWorkspaceRenamer
+		request workspace.RenameWorkspaceCommand
+		handler workspace.RenameWorkspaceHandler
+		w       *restworkspacesv1alpha1.Workspace
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		renamer = NewMockWorkspaceRenamer(ctrl)
+		request = workspace.RenameWorkspaceCommand{Owner: "owner", Workspace: "foo", NewName: "bar"}
+		handler = *workspace.NewRenameWorkspaceHandler(renamer)
+		w = &restworkspacesv1alpha1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "owner", Name: "foo"},
+		}
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	It("should not allow unauthenticated requests", func() {
+		// don't set the "user" value within ctx
+
+		response, err := handler.Handle(ctx, request)
+		Expect(err).To(HaveOccurred())
+		Expect(err).To(Equal(core.NewUnauthenticatedError()))
+		Expect(response).To(BeNil())
+	})
+
+	It("should allow authenticated requests", func() {
+		// given
+		username := "owner"
+		ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, username)
+		opts := &client.UpdateOptions{}
+		renamer.EXPECT().
+			RenameUserWorkspace(ctx, username, w, request.NewName, opts).
+			DoAndReturn(func(_ context.Context, _ string, obj *restworkspacesv1alpha1.Workspace, name string, _ ...client.UpdateOption) error {
+				obj.Name = name
+				return nil
+			})
+
+		// when
+		response, err := handler.Handle(ctx, request)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.Workspace.Namespace).To(Equal("owner"))
+		Expect(response.Workspace.Name).To(Equal("bar"))
+	})
+
+	It("should forward errors from the workspace renamer", func() {
+		// given
+		username := "owner"
+		ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, username)
+		opts := &client.UpdateOptions{}
+		error := fmt.Errorf("Failed to rename workspace!")
+		renamer.EXPECT().
+			RenameUserWorkspace(ctx, username, w, request.NewName, opts).
+			Return(error)
+
+		// when
+		response, err := handler.Handle(ctx, request)
+
+		// then
+		Expect(response).To(BeNil())
+		Expect(err).To(HaveOccurred())
+		Expect(err).To(Equal(error))
+	})
+})
@@ -0,0 +1,175 @@
+package workspace
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+	"github.com/konflux-workspaces/workspaces/server/core"
+	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
+	"github.com/konflux-workspaces/workspaces/server/log"
+)
+
+// ProposeWorkspaceOwnershipTransferCommand contains the information needed to propose the transfer
+// of the ownership of a Workspace the user owns to another user
+type ProposeWorkspaceOwnershipTransferCommand struct {
+	Owner     string
+	Workspace string
+	// NewOwner is the user the ownership is proposed to
+	NewOwner string
+}
+
+// ProposeWorkspaceOwnershipTransferResponse contains the workspace with the pending ownership transfer
+type ProposeWorkspaceOwnershipTransferResponse struct {
+	Workspace *restworkspacesv1alpha1.Workspace
+}
+
+// CancelWorkspaceOwnershipTransferCommand contains the information needed to cancel,
+// or decline, the pending transfer of the ownership of a Workspace
+type CancelWorkspaceOwnershipTransferCommand struct {
+	Owner     string
+	Workspace string
+}
+
+// CancelWorkspaceOwnershipTransferResponse contains the workspace with no pending ownership transfer
+type CancelWorkspaceOwnershipTransferResponse struct {
+	Workspace *restworkspacesv1alpha1.Workspace
+}
+
+// AcceptWorkspaceOwnershipTransferCommand contains the information needed to accept
+// the pending transfer of the ownership of a Workspace
+type AcceptWorkspaceOwnershipTransferCommand struct {
+	Owner     string
+	Workspace string
+}
+
+// AcceptWorkspaceOwnershipTransferResponse contains the workspace owned by the new owner
+type AcceptWorkspaceOwnershipTransferResponse struct {
+	Workspace *restworkspacesv1alpha1.Workspace
+}
+
+// WorkspaceOwnershipTransferrer is the interface the data source needs to implement to allow
+// the ownership transfer handlers to transfer the ownership of a Workspace
+type WorkspaceOwnershipTransferrer interface {
+	ProposeUserWorkspaceOwnershipTransfer(ctx context.Context, user string, obj *restworkspacesv1alpha1.Workspace, newOwner string, opts ...client.UpdateOption) error
+	CancelUserWorkspaceOwnershipTransfer(ctx context.Context, user string, obj *restworkspacesv1alpha1.Workspace, opts ...client.UpdateOption) error
+	AcceptUserWorkspaceOwnershipTransfer(ctx context.Context, user string, obj *restworkspacesv1alpha1.Workspace, opts ...client.UpdateOption) error
+}
+
+// ProposeWorkspaceOwnershipTransferHandler processes ProposeWorkspaceOwnershipTransferCommand
+// and returns ProposeWorkspaceOwnershipTransferResponse updating data in a WorkspaceOwnershipTransferrer
+type ProposeWorkspaceOwnershipTransferHandler struct {
+	transferrer WorkspaceOwnershipTransferrer
+}
+
+// NewProposeWorkspaceOwnershipTransferHandler creates a new ProposeWorkspaceOwnershipTransferHandler
+// that uses a specified WorkspaceOwnershipTransferrer
+func NewProposeWorkspaceOwnershipTransferHandler(transferrer WorkspaceOwnershipTransferrer) *ProposeWorkspaceOwnershipTransferHandler {
+	return &ProposeWorkspaceOwnershipTransferHandler{transferrer: transferrer}
+}
+
+// Handle handles a ProposeWorkspaceOwnershipTransferCommand and returns a ProposeWorkspaceOwnershipTransferResponse or an error
+func (h *ProposeWorkspaceOwnershipTransferHandler) Handle(ctx context.Context, command ProposeWorkspaceOwnershipTransferCommand) (*ProposeWorkspaceOwnershipTransferResponse, error) {
+	// authorization
+	// Only owners are allowed to propose an ownership transfer, this is checked by the WorkspaceOwnershipTransferrer
+	u, ok := ctx.Value(ccontext.UserSignupComplaintNameKey).(string)
+	if !ok {
+		return nil, core.NewUnauthenticatedError()
+	}
+
+	// data access
+	w := newTransferredWorkspace(command.Owner, command.Workspace)
+	log.FromContext(ctx).Debug("proposing workspace ownership transfer", "workspace", w, "newOwner", command.NewOwner)
+	opts := &client.UpdateOptions{}
+	if err := h.transferrer.ProposeUserWorkspaceOwnershipTransfer(ctx, u, w, command.NewOwner, opts); err != nil {
+		return nil, err
+	}
+
+	// reply
+	return &ProposeWorkspaceOwnershipTransferResponse{
+		Workspace: w,
+	}, nil
+}
+
+// CancelWorkspaceOwnershipTransferHandler processes CancelWorkspaceOwnershipTransferCommand
+// and returns CancelWorkspaceOwnershipTransferResponse updating data in a WorkspaceOwnershipTransferrer
+type CancelWorkspaceOwnershipTransferHandler struct {
+	transferrer WorkspaceOwnershipTransferrer
+}
+
+// NewCancelWorkspaceOwnershipTransferHandler creates a new CancelWorkspaceOwnershipTransferHandler
+// that uses a specified WorkspaceOwnershipTransferrer
+func NewCancelWorkspaceOwnershipTransferHandler(transferrer WorkspaceOwnershipTransferrer) *CancelWorkspaceOwnershipTransferHandler {
+	return &CancelWorkspaceOwnershipTransferHandler{transferrer: transferrer}
+}
+
+// Handle handles a CancelWorkspaceOwnershipTransferCommand and returns a CancelWorkspaceOwnershipTransferResponse or an error
+func (h *CancelWorkspaceOwnershipTransferHandler) Handle(ctx context.Context, command CancelWorkspaceOwnershipTransferCommand) (*CancelWorkspaceOwnershipTransferResponse, error) {
+	// authorization
+	// Only the owner and the proposed owner are allowed to cancel an ownership transfer,
+	// this is checked by the WorkspaceOwnershipTransferrer
+	u, ok := ctx.Value(ccontext.UserSignupComplaintNameKey).(string)
+	if !ok {
+		return nil, core.NewUnauthenticatedError()
+	}
+
+	// data access
+	w := newTransferredWorkspace(command.Owner, command.Workspace)
+	log.FromContext(ctx).Debug("cancelling workspace ownership transfer", "workspace", w)
+	opts := &client.UpdateOptions{}
+	if err := h.transferrer.CancelUserWorkspaceOwnershipTransfer(ctx, u, w, opts); err != nil {
+		return nil, err
+	}
+
+	// reply
+	return &CancelWorkspaceOwnershipTransferResponse{
+		Workspace: w,
+	}, nil
+}
+
+// AcceptWorkspaceOwnershipTransferHandler processes AcceptWorkspaceOwnershipTransferCommand
+// and returns AcceptWorkspaceOwnershipTransferResponse updating data in a WorkspaceOwnershipTransferrer
+type AcceptWorkspaceOwnershipTransferHandler struct {
+	transferrer WorkspaceOwnershipTransferrer
+}
+
+// NewAcceptWorkspaceOwnershipTransferHandler creates a new AcceptWorkspaceOwnershipTransferHandler
+// that uses a specified WorkspaceOwnershipTransferrer
+func NewAcceptWorkspaceOwnershipTransferHandler(transferrer WorkspaceOwnershipTransferrer) *AcceptWorkspaceOwnershipTransferHandler {
+	return &AcceptWorkspaceOwnershipTransferHandler{transferrer: transferrer}
+}
+
+// Handle handles a AcceptWorkspaceOwnershipTransferCommand and returns a AcceptWorkspaceOwnershipTransferResponse or an error
+func (h *AcceptWorkspaceOwnershipTransferHandler) Handle(ctx context.Context, command AcceptWorkspaceOwnershipTransferCommand) (*AcceptWorkspaceOwnershipTransferResponse, error) {
+	// authorization
+	// Only the proposed owner is allowed to accept an ownership transfer,
+	// this is checked by the WorkspaceOwnershipTransferrer
+	u, ok := ctx.Value(ccontext.UserSignupComplaintNameKey).(string)
+	if !ok {
+		return nil, core.NewUnauthenticatedError()
+	}
+
+	// data access
+	w := newTransferredWorkspace(command.Owner, command.Workspace)
+	log.FromContext(ctx).Debug("accepting workspace ownership transfer", "workspace", w)
+	opts := &client.UpdateOptions{}
+	if err := h.transferrer.AcceptUserWorkspaceOwnershipTransfer(ctx, u, w, opts); err != nil {
+		return nil, err
+	}
+
+	// reply
+	return &AcceptWorkspaceOwnershipTransferResponse{
+		Workspace: w,
+	}, nil
+}
+
+func newTransferredWorkspace(owner, name string) *restworkspacesv1alpha1.Workspace {
+	return &restworkspacesv1alpha1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: owner,
+		},
+	}
+}
@@ -0,0 +1,205 @@
+package workspace_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/konflux-workspaces/workspaces/server/core"
+	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
+	"github.com/konflux-workspaces/workspaces/server/core/workspace"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("Transfer", func() {
+	var (
+		ctrl        *gomock.Controller
+		ctx         context.Context
+		transferrer *MockWorkspaceOwnershipTransferrer
+		w           *restworkspacesv1alpha1.Workspace
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		transferrer = NewMockWorkspaceOwnershipTransferrer(ctrl)
+		w = &restworkspacesv1alpha1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "owner", Name: "foo"},
+		}
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	Describe("Propose", func() {
+		var (
+			request workspace.ProposeWorkspaceOwnershipTransferCommand
+			handler workspace.ProposeWorkspaceOwnershipTransferHandler
+		)
+
+		BeforeEach(func() {
+			request = workspace.ProposeWorkspaceOwnershipTransferCommand{Owner: "owner", Workspace: "foo", NewOwner: "new-owner"}
+			handler = *workspace.NewProposeWorkspaceOwnershipTransferHandler(transferrer)
+		})
+
+		It("should not allow unauthenticated requests", func() {
+			// don't set the "user" value within ctx
+
+			response, err := handler.Handle(ctx, request)
+			Expect(err).To(Equal(core.NewUnauthenticatedError()))
+			Expect(response).To(BeNil())
+		})
+
+		It("should allow authenticated requests", func() {
+			// given
+			ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, "owner")
+			opts := &client.UpdateOptions{}
+			transferrer.EXPECT().
+				ProposeUserWorkspaceOwnershipTransfer(ctx, "owner", w, request.NewOwner, opts).
+				DoAndReturn(func(_ context.Context, _ string, obj *restworkspacesv1alpha1.Workspace, newOwner string, _ ...client.UpdateOption) error {
+					obj.Spec.OwnershipTransfer = &restworkspacesv1alpha1.WorkspaceOwnershipTransfer{NewOwner: newOwner}
+					return nil
+				})
+
+			// when
+			response, err := handler.Handle(ctx, request)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Workspace.Spec.OwnershipTransfer.NewOwner).To(Equal("new-owner"))
+		})
+
+		It("should forward errors from the workspace transferrer", func() {
+			// given
+			ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, "owner")
+			opts := &client.UpdateOptions{}
+			error := fmt.Errorf("Failed to propose the ownership transfer!")
+			transferrer.EXPECT().
+				ProposeUserWorkspaceOwnershipTransfer(ctx, "owner", w, request.NewOwner, opts).
+				Return(error)
+
+			// when
+			response, err := handler.Handle(ctx, request)
+
+			// then
+			Expect(response).To(BeNil())
+			Expect(err).To(Equal(error))
+		})
+	})
+
+	Describe("Cancel", func() {
+		var (
+			request workspace.CancelWorkspaceOwnershipTransferCommand
+			handler workspace.CancelWorkspaceOwnershipTransferHandler
+		)
+
+		BeforeEach(func() {
+			request = workspace.CancelWorkspaceOwnershipTransferCommand{Owner: "owner", Workspace: "foo"}
+			handler = *workspace.NewCancelWorkspaceOwnershipTransferHandler(transferrer)
+		})
+
+		It("should not allow unauthenticated requests", func() {
+			// don't set the "user" value within ctx
+
+			response, err := handler.Handle(ctx, request)
+			Expect(err).To(Equal(core.NewUnauthenticatedError()))
+			Expect(response).To(BeNil())
+		})
+
+		It("should allow authenticated requests", func() {
+			// given
+			ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, "new-owner")
+			opts := &client.UpdateOptions{}
+			transferrer.EXPECT().
+				CancelUserWorkspaceOwnershipTransfer(ctx, "new-owner", w, opts).
+				Return(nil)
+
+			// when
+			response, err := handler.Handle(ctx, request)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Workspace).To(Equal(w))
+		})
+
+		It("should forward errors from the workspace transferrer", func() {
+			// given
+			ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, "owner")
+			opts := &client.UpdateOptions{}
+			error := fmt.Errorf("Failed to cancel the ownership transfer!")
+			transferrer.EXPECT().
+				CancelUserWorkspaceOwnershipTransfer(ctx, "owner", w, opts).
+				Return(error)
+
+			// when
+			response, err := handler.Handle(ctx, request)
+
+			// then
+			Expect(response).To(BeNil())
+			Expect(err).To(Equal(error))
+		})
+	})
+
+	Describe("Accept", func() {
+		var (
+			request workspace.AcceptWorkspaceOwnershipTransferCommand
+			handler workspace.AcceptWorkspaceOwnershipTransferHandler
+		)
+
+		BeforeEach(func() {
+			request = workspace.AcceptWorkspaceOwnershipTransferCommand{Owner: "owner", Workspace: "foo"}
+			handler = *workspace.NewAcceptWorkspaceOwnershipTransferHandler(transferrer)
+		})
+
+		It("should not allow unauthenticated requests", func() {
+			// don't set the "user" value within ctx
+
+			response, err := handler.Handle(ctx, request)
+			Expect(err).To(Equal(core.NewUnauthenticatedError()))
+			Expect(response).To(BeNil())
+		})
+
+		It("should allow authenticated requests", func() {
+			// given
+			ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, "new-owner")
+			opts := &client.UpdateOptions{}
+			transferrer.EXPECT().
+				AcceptUserWorkspaceOwnershipTransfer(ctx, "new-owner", w, opts).
+				DoAndReturn(func(_ context.Context, user string, obj *restworkspacesv1alpha1.Workspace, _ ...client.UpdateOption) error {
+					obj.Namespace = user
+					return nil
+				})
+
+			// when
+			response, err := handler.Handle(ctx, request)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Workspace.Namespace).To(Equal("new-owner"))
+			Expect(response.Workspace.Name).To(Equal("foo"))
+		})
+
+		It("should forward errors from the workspace transferrer", func() {
+			// given
+			ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, "new-owner")
+			opts := &client.UpdateOptions{}
+			error := fmt.Errorf("Failed to accept the ownership transfer!")
+			transferrer.EXPECT().
+				AcceptUserWorkspaceOwnershipTransfer(ctx, "new-owner", w, opts).
+				Return(error)
+
+			// when
+			response, err := handler.Handle(ctx, request)
+
+			// then
+			Expect(response).To(BeNil())
+			Expect(err).To(Equal(error))
+		})
+	})
+})
@@ -2,11 +2,12 @@ package workspace
 
 import (
 	"context"
-	"fmt"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+	"github.com/konflux-workspaces/workspaces/server/core"
 	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
 	"github.com/konflux-workspaces/workspaces/server/log"
 )
@@ -15,6 +16,8 @@ import (
 type UpdateWorkspaceCommand struct {
 	Owner     string
 	Workspace restworkspacesv1alpha1.Workspace
+	// DryRun processes the command without persisting the changes
+	DryRun bool
 }
 
 // UpdateWorkspaceResponse contains the workspace the user requested
@@ -43,7 +46,7 @@ func (h *UpdateWorkspaceHandler) Handle(ctx context.Context, query UpdateWorkspa
 	// If required, implement here complex logic like multiple-domains filtering, etc
 	u, ok := ctx.Value(ccontext.UserSignupComplaintNameKey).(string)
 	if !ok {
-		return nil, fmt.Errorf("unauthenticated request")
+		return nil, core.NewUnauthenticatedError()
 	}
 
 	// validate query
@@ -53,6 +56,9 @@ func (h *UpdateWorkspaceHandler) Handle(ctx context.Context, query UpdateWorkspa
 	w := query.Workspace.DeepCopy()
 	log.FromContext(ctx).Debug("updating workspace", "workspace", w)
 	opts := &client.UpdateOptions{}
+	if query.DryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
 	if err := h.updater.UpdateUserWorkspace(ctx, u, w, opts); err != nil {
 		return nil, err
 	}
@@ -8,8 +8,10 @@ import (
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/konflux-workspaces/workspaces/server/core"
 	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
 	"github.com/konflux-workspaces/workspaces/server/core/workspace"
 
@@ -40,7 +42,7 @@ var _ = Describe("", func() {
 
 		response, err := handler.Handle(ctx, request)
 		Expect(err).To(HaveOccurred())
-		Expect(err).To(Equal(fmt.Errorf("unauthenticated request")))
+		Expect(err).To(Equal(core.NewUnauthenticatedError()))
 		Expect(response).To(BeNil())
 	})
 
@@ -63,6 +65,26 @@ var _ = Describe("", func() {
 		}))
 	})
 
+	It("should forward the dry run option", func() {
+		// given
+		username := "foo"
+		ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, username)
+		request.DryRun = true
+		opts := &client.UpdateOptions{DryRun: []string{metav1.DryRunAll}}
+		updater.EXPECT().
+			UpdateUserWorkspace(ctx, username, &request.Workspace, opts).
+			Return(nil)
+
+		// when
+		response, err := handler.Handle(ctx, request)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response).To(Equal(&workspace.UpdateWorkspaceResponse{
+			Workspace: &request.Workspace,
+		}))
+	})
+
 	It("should forward errors from the workspace creator", func() {
 		// given
 		username := "foo"
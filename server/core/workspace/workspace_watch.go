@@ -0,0 +1,76 @@
+package workspace
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/konflux-workspaces/workspaces/server/core"
+	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
+)
+
+// WatchWorkspaceQuery contains the information needed to watch the workspaces the user has access to
+type WatchWorkspaceQuery struct {
+	Namespace       string
+	ResourceVersion string
+
+	// LabelSelector restricts the watched workspaces by their labels
+	LabelSelector labels.Selector
+	// FieldSelector restricts the watched workspaces by their fields
+	FieldSelector fields.Selector
+
+	// TimeoutSeconds is the duration of the watch, nil or 0 means no timeout
+	TimeoutSeconds *int64
+}
+
+// WatchWorkspaceResponse contains the stream of events on the workspaces the user can access
+type WatchWorkspaceResponse struct {
+	Watcher watch.Interface
+}
+
+// WorkspaceWatcher is the interface the data source needs to implement to allow the WatchWorkspaceHandler to stream events from it
+type WorkspaceWatcher interface {
+	WatchUserWorkspaces(ctx context.Context, user string, opts ...client.ListOption) (watch.Interface, error)
+}
+
+// WatchWorkspaceHandler process WatchWorkspaceQuery and returns a WatchWorkspaceResponse streaming data from a WorkspaceWatcher
+type WatchWorkspaceHandler struct {
+	watcher WorkspaceWatcher
+}
+
+// NewWatchWorkspaceHandler creates a new WatchWorkspaceHandler that uses a specified WorkspaceWatcher
+func NewWatchWorkspaceHandler(watcher WorkspaceWatcher) *WatchWorkspaceHandler {
+	return &WatchWorkspaceHandler{watcher: watcher}
+}
+
+// Handle handles a WatchWorkspaceQuery and returns a WatchWorkspaceResponse or an error
+func (h *WatchWorkspaceHandler) Handle(ctx context.Context, query WatchWorkspaceQuery) (*WatchWorkspaceResponse, error) {
+	// authorization
+	u, ok := ctx.Value(ccontext.UserSignupComplaintNameKey).(string)
+	if !ok {
+		return nil, core.NewUnauthenticatedError()
+	}
+
+	// data access
+	opts := &client.ListOptions{
+		Namespace:     query.Namespace,
+		LabelSelector: query.LabelSelector,
+		FieldSelector: query.FieldSelector,
+		Raw: &metav1.ListOptions{
+			Watch:           true,
+			ResourceVersion: query.ResourceVersion,
+			TimeoutSeconds:  query.TimeoutSeconds,
+		},
+	}
+	w, err := h.watcher.WatchUserWorkspaces(ctx, u, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// reply
+	return &WatchWorkspaceResponse{Watcher: w}, nil
+}
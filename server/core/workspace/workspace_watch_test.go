@@ -0,0 +1,101 @@
+package workspace_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/konflux-workspaces/workspaces/server/core"
+	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
+	"github.com/konflux-workspaces/workspaces/server/core/workspace"
+)
+
+var _ = Describe("WorkspaceWatch", func() {
+	var (
+		ctrl    *gomock.Controller
+		ctx     context.Context
+		watcher *MockWorkspaceWatcher
+		request workspace.WatchWorkspaceQuery
+		handler workspace.WatchWorkspaceHandler
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		watcher = NewMockWorkspaceWatcher(ctrl)
+		timeout := int64(30)
+		request = workspace.WatchWorkspaceQuery{
+			Namespace:       "owner",
+			ResourceVersion: "42",
+			LabelSelector:   labels.SelectorFromSet(labels.Set{"team": "platform"}),
+			FieldSelector:   fields.OneTermEqualSelector("spec.visibility", "private"),
+			TimeoutSeconds:  &timeout,
+		}
+		handler = *workspace.NewWatchWorkspaceHandler(watcher)
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	It("should not allow unauthenticated requests", func() {
+		// don't set the "user" value within ctx
+
+		response, err := handler.Handle(ctx, request)
+		Expect(err).To(HaveOccurred())
+		Expect(err).To(Equal(core.NewUnauthenticatedError()))
+		Expect(response).To(BeNil())
+	})
+
+	It("should allow authenticated requests", func() {
+		// given
+		username := "foo"
+		ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, username)
+		fw := watch.NewFake()
+		opts := &client.ListOptions{
+			Namespace:     request.Namespace,
+			LabelSelector: request.LabelSelector,
+			FieldSelector: request.FieldSelector,
+			Raw: &metav1.ListOptions{
+				Watch:           true,
+				ResourceVersion: request.ResourceVersion,
+				TimeoutSeconds:  request.TimeoutSeconds,
+			},
+		}
+		watcher.EXPECT().
+			WatchUserWorkspaces(ctx, username, opts).
+			Return(fw, nil)
+
+		// when
+		response, err := handler.Handle(ctx, request)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response).To(Equal(&workspace.WatchWorkspaceResponse{Watcher: fw}))
+	})
+
+	It("should forward errors from the workspace watcher", func() {
+		// given
+		username := "foo"
+		ctx := context.WithValue(ctx, ccontext.UserSignupComplaintNameKey, username)
+		error := fmt.Errorf("Failed to watch workspaces!")
+		watcher.EXPECT().
+			WatchUserWorkspaces(ctx, username, gomock.Any()).
+			Return(nil, error)
+
+		// when
+		response, err := handler.Handle(ctx, request)
+
+		// then
+		Expect(response).To(BeNil())
+		Expect(err).To(HaveOccurred())
+		Expect(err).To(Equal(error))
+	})
+})
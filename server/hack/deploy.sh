@@ -50,11 +50,18 @@ else
 fi
 
 # updating config locally
+config_literals=(
+  --from-literal=log.level="${SERVER_LOG_LEVEL}"
+  --from-literal=kubesaw.namespace="${TOOLCHAIN_HOST}"
+)
+if [[ -n "${JWKS_URL}" ]]; then
+  config_literals+=(--from-literal=jwt.jwks-url="${JWKS_URL}")
+fi
+
 ${KUSTOMIZE} edit set namespace "$1"
 ${KUSTOMIZE} edit add configmap rest-api-server-config \
         --behavior=replace \
-        --from-literal=log.level="${SERVER_LOG_LEVEL}" \
-        --from-literal=kubesaw.namespace="${TOOLCHAIN_HOST}"
+        "${config_literals[@]}"
 
 cd "${f}/config/server"
 ${KUSTOMIZE} edit set image workspaces/rest-api="$2"
@@ -0,0 +1,27 @@
+#!/bin/bash
+
+set -e -o pipefail
+
+LOCATION=$(readlink -f "$0")
+DIR=$(dirname "${LOCATION}")
+SERVER_DIR="$(realpath "${DIR}"/..)"
+GO=${GO:-go}
+GO_TO_PROTOBUF=${GO_TO_PROTOBUF:-go-to-protobuf}
+
+# go-to-protobuf expects the packages, and the protobuf definitions they import,
+# to be laid out as in a GOPATH: they are linked in a temporary directory
+GOPATH_DIR=$(mktemp -d)
+trap 'rm -rf "${GOPATH_DIR}"' EXIT
+
+mkdir -p "${GOPATH_DIR}/github.com/konflux-workspaces/workspaces" "${GOPATH_DIR}/github.com/gogo" "${GOPATH_DIR}/k8s.io"
+ln -s "${SERVER_DIR}" "${GOPATH_DIR}/github.com/konflux-workspaces/workspaces/server"
+ln -s "$(cd "${SERVER_DIR}" && ${GO} list -m -f '{{.Dir}}' github.com/gogo/protobuf)" "${GOPATH_DIR}/github.com/gogo/protobuf"
+ln -s "$(cd "${SERVER_DIR}" && ${GO} list -m -f '{{.Dir}}' k8s.io/apimachinery)" "${GOPATH_DIR}/k8s.io/apimachinery"
+
+cd "${SERVER_DIR}"
+${GO_TO_PROTOBUF} \
+    --go-header-file hack/boilerplate.go.txt \
+    --output-dir "${GOPATH_DIR}" \
+    --proto-import "${GOPATH_DIR}" \
+    --apimachinery-packages=-k8s.io/apimachinery/pkg/util/intstr,-k8s.io/apimachinery/pkg/api/resource,-k8s.io/apimachinery/pkg/runtime/schema,-k8s.io/apimachinery/pkg/runtime,-k8s.io/apimachinery/pkg/apis/meta/v1 \
+    --packages github.com/konflux-workspaces/workspaces/server/api/v1alpha1
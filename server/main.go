@@ -2,24 +2,42 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"os"
 	"strconv"
 	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/konflux-workspaces/workspaces/server/core/workspace"
+	"github.com/konflux-workspaces/workspaces/server/metrics"
 	"github.com/konflux-workspaces/workspaces/server/persistence/iwclient"
 	"github.com/konflux-workspaces/workspaces/server/persistence/readclient"
 	"github.com/konflux-workspaces/workspaces/server/persistence/writeclient"
 	"github.com/konflux-workspaces/workspaces/server/rest"
+	"github.com/konflux-workspaces/workspaces/server/rest/health"
+	"github.com/konflux-workspaces/workspaces/server/rest/middleware"
+	"github.com/konflux-workspaces/workspaces/server/rest/openapi"
+	"github.com/konflux-workspaces/workspaces/server/telemetry"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 const DefaultAddr string = ":8080"
+const DefaultMetricsAddr string = ":8082"
 const EnvLogLevel = "LOG_LEVEL"
+const EnvMetricsAddr = "METRICS_ADDR"
+
+const (
+	EnvAuthTrustedProxy = "AUTH_TRUSTED_PROXY"
+	EnvJwtJwksUrl       = "JWT_JWKS_URL"
+	EnvJwtPublicKeys    = "JWT_PUBLIC_KEYS"
+	EnvJwtIssuer        = "JWT_ISSUER"
+	EnvJwtAudience      = "JWT_AUDIENCE"
+)
 
 func main() {
 	l := constructLog()
@@ -54,6 +72,23 @@ func run(l *slog.Logger) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	// setup tracing
+	if telemetry.Enabled() {
+		l.Info("setting up tracing")
+		tp, err := telemetry.NewOtlpTracerProvider(ctx)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			sctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if err := tp.Shutdown(sctx); err != nil {
+				l.Error("error shutting down the tracer provider", "error", err)
+			}
+		}()
+		telemetry.SetTracerProvider(tp)
+	}
+
 	// setup read model
 	l.Info("setting up cache")
 	c, crc, err := readclient.NewDefaultWithCache(ctx, cfg, wns, kns)
@@ -65,18 +100,59 @@ func run(l *slog.Logger) error {
 	iwcli := iwclient.New(crc, wns, kns)
 	writer := writeclient.NewWithConfig(cfg, wns, iwcli)
 
+	// setup authentication
+	l.Info("setting up authentication")
+	auth, err := buildAuthMiddleware(ctx, l)
+	if err != nil {
+		return err
+	}
+
+	// setup server-side apply
+	l.Info("setting up field manager")
+	tc, err := openapi.NewTypeConverter()
+	if err != nil {
+		return err
+	}
+	fm, err := workspace.NewFieldManager(tc)
+	if err != nil {
+		return err
+	}
+
 	// setup REST over HTTP server
 	l.Info("setting up REST over HTTP server")
-	s := rest.New(
+	s, err := rest.New(
 		l,
 		DefaultAddr,
 		crc,
-		workspace.NewReadWorkspaceHandler(c).Handle,
-		workspace.NewListWorkspaceHandler(c).Handle,
-		workspace.NewCreateWorkspaceHandler(writer).Handle,
-		workspace.NewUpdateWorkspaceHandler(writer).Handle,
-		workspace.NewPatchWorkspaceHandler(c, writer).Handle,
+		auth,
+		rest.Handlers{
+			Read:            telemetry.Traced("ReadWorkspace", workspace.NewReadWorkspaceHandler(c).Handle),
+			List:            telemetry.Traced("ListWorkspace", workspace.NewListWorkspaceHandler(c).Handle),
+			Watch:           telemetry.Traced("WatchWorkspace", workspace.NewWatchWorkspaceHandler(c).Handle),
+			Create:          telemetry.Traced("CreateWorkspace", workspace.NewCreateWorkspaceHandler(writer).Handle),
+			Update:          telemetry.Traced("UpdateWorkspace", workspace.NewUpdateWorkspaceHandler(writer).Handle),
+			Patch:           telemetry.Traced("PatchWorkspace", workspace.NewPatchWorkspaceHandler(c, writer, writer, fm).Handle),
+			Delete:          telemetry.Traced("DeleteWorkspace", workspace.NewDeleteWorkspaceHandler(writer).Handle),
+			AddMember:       telemetry.Traced("AddWorkspaceMember", workspace.NewAddWorkspaceMemberHandler(c, writer).Handle),
+			RemoveMember:    telemetry.Traced("RemoveWorkspaceMember", workspace.NewRemoveWorkspaceMemberHandler(c, writer).Handle),
+			Rename:          telemetry.Traced("RenameWorkspace", workspace.NewRenameWorkspaceHandler(writer).Handle),
+			ProposeTransfer: telemetry.Traced("ProposeWorkspaceOwnershipTransfer", workspace.NewProposeWorkspaceOwnershipTransferHandler(writer).Handle),
+			CancelTransfer:  telemetry.Traced("CancelWorkspaceOwnershipTransfer", workspace.NewCancelWorkspaceOwnershipTransferHandler(writer).Handle),
+			AcceptTransfer:  telemetry.Traced("AcceptWorkspaceOwnershipTransfer", workspace.NewAcceptWorkspaceOwnershipTransferHandler(writer).Handle),
+			ListEvents:      telemetry.Traced("ListWorkspaceEvents", workspace.NewListWorkspaceEventsHandler(c).Handle),
+			KubesawHealth:   health.NewDefaultKubesawHandler(crc, kns),
+		},
 	)
+	if err != nil {
+		return err
+	}
+
+	// setup metrics server
+	ms := &http.Server{
+		Addr:              getMetricsAddr(),
+		Handler:           metrics.Handler(),
+		ReadHeaderTimeout: 3 * time.Second,
+	}
 
 	// HTTP Server graceful shutdown
 	go func() {
@@ -85,12 +161,24 @@ func run(l *slog.Logger) error {
 		sctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
 		defer cancel()
 
+		if err := ms.Shutdown(sctx); err != nil {
+			l.Error("error gracefully shutting down the metrics server", "error", err)
+		}
 		if err := s.Shutdown(sctx); err != nil {
 			l.Error("error gracefully shutting down the HTTP server", "error", err)
 			os.Exit(1)
 		}
 	}()
 
+	// start metrics server
+	go func() {
+		l.Info("starting metrics server", "address", ms.Addr)
+		if err := ms.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			l.Error("error running metrics server", "error", err)
+			cancel()
+		}
+	}()
+
 	// start the cache
 	go func() {
 		l.Info("starting cache")
@@ -106,6 +194,7 @@ func run(l *slog.Logger) error {
 	if !crc.WaitForCacheSync(ctx) {
 		return fmt.Errorf("error synching cache")
 	}
+	metrics.CacheSynced.Set(1)
 
 	// start HTTP server
 	l.Info("starting HTTP server", "address", s.Addr)
@@ -116,6 +205,63 @@ func run(l *slog.Logger) error {
 	return nil
 }
 
+// buildAuthMiddleware builds the authentication middleware from the configuration provided via environment variables.
+// Bearer JWTs are verified with the keys retrieved from the JWKS endpoint or with the provided PEM encoded public keys.
+// The X-Subject header is trusted only if explicitly requested.
+func buildAuthMiddleware(ctx context.Context, l *slog.Logger) (rest.AuthMiddlewareFunc, error) {
+	if v := os.Getenv(EnvAuthTrustedProxy); v != "" {
+		trusted, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for Environment Variable %s: %w", EnvAuthTrustedProxy, err)
+		}
+		if trusted {
+			l.Warn("trusting the X-Subject header set by the proxy, the server must not be reachable bypassing it")
+			return rest.WithTrustedProxyAuth, nil
+		}
+	}
+
+	var ks *middleware.JWKS
+	switch u, k := os.Getenv(EnvJwtJwksUrl), os.Getenv(EnvJwtPublicKeys); {
+	case u != "":
+		l.Debug("retrieving JWT verification keys from JWKS endpoint", "url", u)
+		jwks, err := middleware.NewRemoteJWKS(ctx, u, nil)
+		if err != nil {
+			return nil, err
+		}
+		ks = jwks
+	case k != "":
+		l.Debug("parsing JWT verification keys")
+		kk, err := middleware.ParsePEMPublicKeys([]byte(k))
+		if err != nil {
+			return nil, err
+		}
+		jwks, err := middleware.NewStaticJWKS(kk...)
+		if err != nil {
+			return nil, err
+		}
+		ks = jwks
+	default:
+		return nil, fmt.Errorf("one of the Environment Variables %s or %s is required when %s is not enabled", EnvJwtJwksUrl, EnvJwtPublicKeys, EnvAuthTrustedProxy)
+	}
+
+	opts := []jwt.ParserOption{}
+	if iss := os.Getenv(EnvJwtIssuer); iss != "" {
+		opts = append(opts, jwt.WithIssuer(iss))
+	}
+	if aud := os.Getenv(EnvJwtAudience); aud != "" {
+		opts = append(opts, jwt.WithAudience(aud))
+	}
+	return rest.WithJwtAuth(ks.KeyfuncCtx, opts...), nil
+}
+
+// getMetricsAddr fetches the address the metrics server listens on from the appropriate environment variable
+func getMetricsAddr() string {
+	if addr := os.Getenv(EnvMetricsAddr); addr != "" {
+		return addr
+	}
+	return DefaultMetricsAddr
+}
+
 // constructLog constructs a new instance of the logger
 func constructLog() *slog.Logger {
 	logLevel := getLogLevel()
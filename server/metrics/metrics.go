@@ -0,0 +1,81 @@
+// Package metrics defines the Prometheus metrics exposed by the REST API server
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	KonfluxWorkspacesServerHTTPRequestsTotal             = "konflux_workspaces_server_http_requests_total"
+	KonfluxWorkspacesServerHTTPRequestDurationSeconds    = "konflux_workspaces_server_http_request_duration_seconds"
+	KonfluxWorkspacesServerCacheSynced                   = "konflux_workspaces_server_cache_synced"
+	KonfluxWorkspacesServerUserSignupLookupFailuresTotal = "konflux_workspaces_server_usersignup_lookup_failures_total"
+)
+
+// Reasons of the UserSignup lookup failures
+const (
+	UserSignupLookupFailureReasonError    = "error"
+	UserSignupLookupFailureReasonNotFound = "not_found"
+)
+
+var (
+	// Registry is the registry the server's metrics are registered in
+	Registry = prometheus.NewRegistry()
+
+	// HTTPRequestsTotal counts the HTTP requests served by route, verb and status code
+	HTTPRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: KonfluxWorkspacesServerHTTPRequestsTotal,
+			Help: "Number of HTTP requests served, by route, verb and status code.",
+		},
+		[]string{"route", "verb", "code"},
+	)
+
+	// HTTPRequestDuration measures the time taken to serve the HTTP requests by route, verb and status code.
+	// Watch requests are not observed, as their duration depends on the client.
+	HTTPRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    KonfluxWorkspacesServerHTTPRequestDurationSeconds,
+			Help:    "Time taken to serve HTTP requests, by route, verb and status code.",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"route", "verb", "code"},
+	)
+
+	// CacheSynced is 1 once the server's cache is synced, 0 otherwise
+	CacheSynced = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: KonfluxWorkspacesServerCacheSynced,
+			Help: "Whether the server's cache is synced.",
+		},
+	)
+
+	// UserSignupLookupFailures counts the failed lookups of the authenticated users' UserSignups by reason
+	UserSignupLookupFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: KonfluxWorkspacesServerUserSignupLookupFailuresTotal,
+			Help: "Number of failed lookups of the authenticated users' UserSignups, by reason.",
+		},
+		[]string{"reason"},
+	)
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		HTTPRequestsTotal,
+		HTTPRequestDuration,
+		CacheSynced,
+		UserSignupLookupFailures,
+	)
+}
+
+// Handler returns the http.Handler exposing the metrics in Registry
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
@@ -0,0 +1,26 @@
+/*
+Copyright 2024 The Workspaces Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientinterface
+
+// Keys of the UserSignup field indexers provided by the cache,
+// used to look up the UserSignup of the authenticated user
+const (
+	// IndexKeyUserSignupSub key for UserSignup's indexer on field for the user's Sub
+	IndexKeyUserSignupSub string = "spec.identityClaims.sub"
+	// IndexKeyUserSignupUserID key for UserSignup's indexer on field for the user's UserID
+	IndexKeyUserSignupUserID string = "spec.identityClaims.userID"
+)
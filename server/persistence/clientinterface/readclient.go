@@ -19,6 +19,8 @@ package clientinterface
 import (
 	"context"
 
+	corev1 "k8s.io/api/core/v1"
+
 	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
 	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,7 +35,7 @@ type SpaceKey struct {
 // InternalWorkspacesReader is the definition for a InternalWorkspaces Read Client
 type InternalWorkspacesReader interface {
 	GetAsUser(context.Context, string, SpaceKey, *workspacesv1alpha1.InternalWorkspace, ...client.GetOption) error
-	ListAsUser(context.Context, string, *workspacesv1alpha1.InternalWorkspaceList) error
+	ListAsUser(context.Context, string, *workspacesv1alpha1.InternalWorkspaceList, ...client.ListOption) error
 }
 
 // InternalWorkspacesMapper is the definition for a InternalWorkspaces/Workspaces Mapper
@@ -41,13 +43,20 @@ type InternalWorkspacesMapper interface {
 	InternalWorkspaceListToWorkspaceList(*workspacesv1alpha1.InternalWorkspaceList) (*restworkspacesv1alpha1.WorkspaceList, error)
 	InternalWorkspaceToWorkspace(*workspacesv1alpha1.InternalWorkspace) (*restworkspacesv1alpha1.Workspace, error)
 	WorkspaceToInternalWorkspace(*restworkspacesv1alpha1.Workspace) (*workspacesv1alpha1.InternalWorkspace, error)
+	InternalWorkspaceEventListToEventList(*restworkspacesv1alpha1.Workspace, *corev1.EventList) (*restworkspacesv1alpha1.WorkspaceEventList, error)
 }
 
 type DirectAccessChecker interface {
 	UserHasDirectAccess(context.Context, string, string) (bool, error)
 }
 
+// InternalWorkspacesEventsLister is the definition for a client listing the Events recorded on InternalWorkspaces
+type InternalWorkspacesEventsLister interface {
+	ListEvents(context.Context, *workspacesv1alpha1.InternalWorkspace, *corev1.EventList) error
+}
+
 type InternalWorkspacesReadClient interface {
 	InternalWorkspacesReader
 	DirectAccessChecker
+	InternalWorkspacesEventsLister
 }
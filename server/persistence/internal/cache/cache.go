@@ -6,6 +6,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -17,7 +18,8 @@ import (
 )
 
 // NewCache creates a controller-runtime cache.Cache instance configured to monitor
-// spacebindings.toolchain.dev.openshift.com and workspaces.workspaces.io.
+// spacebindings.toolchain.dev.openshift.com, workspaces.workspaces.io, the events recorded on the latter,
+// and the toolchainstatuses.toolchain.dev.openshift.com reporting the health of KubeSaw.
 // IMPORTANT: returned cache needs to be started and initialized.
 func NewCache(ctx context.Context, cfg *rest.Config, workspacesNamespace, kubesawNamespace string) (cache.Cache, error) {
 	s, err := createScheme()
@@ -42,9 +44,18 @@ func NewCache(ctx context.Context, cfg *rest.Config, workspacesNamespace, kubesa
 	if _, err := c.GetInformer(ctx, &toolchainv1alpha1.UserSignup{}); err != nil {
 		return nil, err
 	}
+	if _, err := c.GetInformer(ctx, &toolchainv1alpha1.BannedUser{}); err != nil {
+		return nil, err
+	}
 	if _, err := c.GetInformer(ctx, &workspacesv1alpha1.InternalWorkspace{}); err != nil {
 		return nil, err
 	}
+	if _, err := c.GetInformer(ctx, &corev1.Event{}); err != nil {
+		return nil, err
+	}
+	if _, err := c.GetInformer(ctx, &toolchainv1alpha1.ToolchainStatus{}); err != nil {
+		return nil, err
+	}
 
 	// configure field indexers for filtering
 	for k, f := range UserSignupIndexers {
@@ -57,6 +68,11 @@ func NewCache(ctx context.Context, cfg *rest.Config, workspacesNamespace, kubesa
 			return nil, err
 		}
 	}
+	for k, f := range EventsIndexers {
+		if err := c.IndexField(ctx, &corev1.Event{}, k, f); err != nil {
+			return nil, err
+		}
+	}
 
 	return c, nil
 }
@@ -68,8 +84,15 @@ func newCache(cfg *rest.Config, scheme *runtime.Scheme, mapper meta.RESTMapper,
 		ReaderFailOnMissingInformer: true,
 		ByObject: map[client.Object]cache.ByObject{
 			&toolchainv1alpha1.UserSignup{}:         {Namespaces: map[string]cache.Config{kubesawNamespace: {}}},
+			&toolchainv1alpha1.BannedUser{}:         {Namespaces: map[string]cache.Config{kubesawNamespace: {}}},
 			&toolchainv1alpha1.SpaceBinding{}:       {Namespaces: map[string]cache.Config{kubesawNamespace: {}}},
 			&workspacesv1alpha1.InternalWorkspace{}: {Namespaces: map[string]cache.Config{workspacesNamespace: {}}},
+			&corev1.Event{}: {
+				Namespaces: map[string]cache.Config{workspacesNamespace: {}},
+				// only the events recorded on InternalWorkspaces are served
+				Field: fields.OneTermEqualSelector("involvedObject.kind", "InternalWorkspace"),
+			},
+			&toolchainv1alpha1.ToolchainStatus{}: {Namespaces: map[string]cache.Config{kubesawNamespace: {}}},
 		},
 	})
 }
@@ -1,8 +1,11 @@
 package cache
 
 import (
+	corev1 "k8s.io/api/core/v1"
+
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
 	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+	"github.com/konflux-workspaces/workspaces/server/persistence/clientinterface"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -22,12 +25,21 @@ const (
 
 	// IndexKeyUserComplaintName key for InternalWorkspace's indexer on field for UserSignup's ComplaintName
 	IndexKeyUserComplaintName string = "status.complaintName"
+
+	// IndexKeyEventInvolvedObjectName key for Event's indexer on field for the involved object's name
+	IndexKeyEventInvolvedObjectName string = "involvedObject.name"
 )
 
 var UserSignupIndexers = map[string]client.IndexerFunc{
 	IndexKeyUserComplaintName: newSingleFieldIndexer(func(u *toolchainv1alpha1.UserSignup) string {
 		return u.Status.CompliantUsername
 	}),
+	clientinterface.IndexKeyUserSignupSub: newSingleFieldIndexer(func(u *toolchainv1alpha1.UserSignup) string {
+		return u.Spec.IdentityClaims.Sub
+	}),
+	clientinterface.IndexKeyUserSignupUserID: newSingleFieldIndexer(func(u *toolchainv1alpha1.UserSignup) string {
+		return u.Spec.IdentityClaims.UserID
+	}),
 }
 
 var InternalWorkspacesIndexers = map[string]client.IndexerFunc{
@@ -51,6 +63,12 @@ var InternalWorkspacesIndexers = map[string]client.IndexerFunc{
 	}),
 }
 
+var EventsIndexers = map[string]client.IndexerFunc{
+	IndexKeyEventInvolvedObjectName: newSingleFieldIndexer(func(e *corev1.Event) string {
+		return e.InvolvedObject.Name
+	}),
+}
+
 func newSingleFieldIndexer[T client.Object](f func(T) string) func(client.Object) []string {
 	return func(obj client.Object) []string {
 		t, ok := obj.(T)
@@ -8,6 +8,7 @@ import (
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
 	"github.com/konflux-workspaces/workspaces/server/persistence/clientinterface"
 	"github.com/konflux-workspaces/workspaces/server/persistence/mapper"
+	"github.com/konflux-workspaces/workspaces/server/telemetry"
 )
 
 var (
@@ -32,6 +33,9 @@ func New(backend client.Reader, workspacesNamespace, kubesawNamespace string) *C
 }
 
 func (c *Client) UserHasDirectAccess(ctx context.Context, user, space string) (bool, error) {
+	ctx, span := telemetry.StartSpan(ctx, "Client.UserHasDirectAccess")
+	defer span.End()
+
 	ml := client.MatchingLabels{
 		toolchainv1alpha1.SpaceBindingMasterUserRecordLabelKey: user,
 		toolchainv1alpha1.SpaceBindingSpaceLabelKey:            space,
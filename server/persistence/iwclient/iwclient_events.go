@@ -0,0 +1,40 @@
+package iwclient
+
+import (
+	"context"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/konflux-workspaces/workspaces/server/persistence/internal/cache"
+	"github.com/konflux-workspaces/workspaces/server/telemetry"
+
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+)
+
+// ListEvents retrieves the Events recorded on the provided InternalWorkspace.
+// Events recorded on a deleted InternalWorkspace with the same name are not returned.
+func (c *Client) ListEvents(
+	ctx context.Context,
+	workspace *workspacesv1alpha1.InternalWorkspace,
+	events *corev1.EventList,
+) error {
+	ctx, span := telemetry.StartSpan(ctx, "Client.ListEvents")
+	defer span.End()
+
+	ee := corev1.EventList{}
+	opts := []client.ListOption{
+		client.InNamespace(c.workspacesNamespace),
+		client.MatchingFields{cache.IndexKeyEventInvolvedObjectName: workspace.Name},
+	}
+	if err := c.backend.List(ctx, &ee, opts...); err != nil {
+		return err
+	}
+
+	ee.Items = slices.DeleteFunc(ee.Items, func(e corev1.Event) bool {
+		return e.InvolvedObject.Kind != "InternalWorkspace" || e.InvolvedObject.UID != workspace.UID
+	})
+	ee.DeepCopyInto(events)
+	return nil
+}
@@ -0,0 +1,66 @@
+package iwclient_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+
+	"github.com/konflux-workspaces/workspaces/server/persistence/iwclient"
+)
+
+var _ = Describe("ListEvents", func() {
+	var ctx context.Context
+	var c *iwclient.Client
+
+	ksns := "kubesaw-namespace"
+	wsns := "workspaces-namespace"
+
+	workspace := workspacesv1alpha1.InternalWorkspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      generateName("workspace"),
+			Namespace: wsns,
+			UID:       "workspace-uid",
+		},
+	}
+	event := func(name, namespace, kind, involvedName, uid string) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			InvolvedObject: corev1.ObjectReference{
+				Kind:      kind,
+				Name:      involvedName,
+				Namespace: wsns,
+				UID:       types.UID(uid),
+			},
+			Reason: name,
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		c = buildCache(wsns, ksns,
+			&workspace,
+			event("recorded", wsns, "InternalWorkspace", workspace.Name, string(workspace.UID)),
+			event("other-workspace", wsns, "InternalWorkspace", generateName("other"), "other-uid"),
+			event("deleted-workspace", wsns, "InternalWorkspace", workspace.Name, "deleted-uid"),
+			event("other-kind", wsns, "Pod", workspace.Name, string(workspace.UID)),
+			event("other-namespace", ksns, "InternalWorkspace", workspace.Name, string(workspace.UID)),
+		)
+	})
+
+	It("returns only the events recorded on the InternalWorkspace", func() {
+		// when
+		ee := corev1.EventList{}
+		err := c.ListEvents(ctx, &workspace, &ee)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ee.Items).To(HaveLen(1))
+		Expect(ee.Items[0].Name).To(Equal("recorded"))
+	})
+})
@@ -4,25 +4,35 @@ import (
 	"context"
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/utils/set"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/konflux-workspaces/workspaces/server/persistence/internal/cache"
+	"github.com/konflux-workspaces/workspaces/server/telemetry"
 
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
 	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
 )
 
-// ListAsUser lists all the community workspaces together with the ones the user is allowed access to
-func (c *Client) ListAsUser(ctx context.Context, user string, workspaces *workspacesv1alpha1.InternalWorkspaceList) error {
+// ListAsUser lists all the community workspaces together with the ones the user is allowed access to.
+// The field selector provided in opts is resolved through the cache's InternalWorkspace indexes,
+// so it is required to only contain exact matches on indexed fields.
+func (c *Client) ListAsUser(ctx context.Context, user string, workspaces *workspacesv1alpha1.InternalWorkspaceList, opts ...client.ListOption) error {
+	ctx, span := telemetry.StartSpan(ctx, "Client.ListAsUser")
+	defer span.End()
+
+	listOpts := client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+
 	// list community workspaces
 	ww := workspacesv1alpha1.InternalWorkspaceList{}
-	if err := c.listCommunityWorkspaces(ctx, &ww); err != nil {
+	if err := c.listCommunityWorkspaces(ctx, listOpts.FieldSelector, &ww); err != nil {
 		return fmt.Errorf("error retrieving community workspaces: %w", err)
 	}
 
 	// fetch workspaces to which the user has direct access and that are visibile to the whole community
-	if err := c.fetchMissingWorkspaces(ctx, user, &ww); err != nil {
+	if err := c.fetchMissingWorkspaces(ctx, user, listOpts.FieldSelector, &ww); err != nil {
 		return fmt.Errorf("error fetching directly accessible workspaces: %w", err)
 	}
 
@@ -31,7 +41,7 @@ func (c *Client) ListAsUser(ctx context.Context, user string, workspaces *worksp
 	return nil
 }
 
-func (c *Client) fetchMissingWorkspaces(ctx context.Context, user string, workspaces *workspacesv1alpha1.InternalWorkspaceList) error {
+func (c *Client) fetchMissingWorkspaces(ctx context.Context, user string, selector fields.Selector, workspaces *workspacesv1alpha1.InternalWorkspaceList) error {
 	// retrieve names of missing workspaces
 	nmww, err := c.calculateNamesOfMissingWorkspaces(ctx, user, workspaces)
 	if err != nil {
@@ -41,7 +51,7 @@ func (c *Client) fetchMissingWorkspaces(ctx context.Context, user string, worksp
 	// add workspaces to which the user has direct access to return list
 	for _, s := range nmww {
 		aww := workspacesv1alpha1.InternalWorkspaceList{}
-		opt := client.MatchingFields{cache.IndexKeyInternalWorkspaceSpaceName: s}
+		opt := matchingFields(selector, cache.IndexKeyInternalWorkspaceSpaceName, s)
 		if err := c.backend.List(ctx, &aww, opt); err != nil {
 			return err
 		}
@@ -81,9 +91,18 @@ func (c *Client) listUserSpaceBindings(
 	return c.backend.List(ctx, spaceBindings, opt)
 }
 
-func (c *Client) listCommunityWorkspaces(ctx context.Context, workspaces *workspacesv1alpha1.InternalWorkspaceList) error {
-	opt := client.MatchingFields{
-		cache.IndexKeyInternalWorkspaceVisibility: string(workspacesv1alpha1.InternalWorkspaceVisibilityCommunity),
-	}
+func (c *Client) listCommunityWorkspaces(ctx context.Context, selector fields.Selector, workspaces *workspacesv1alpha1.InternalWorkspaceList) error {
+	opt := matchingFields(selector,
+		cache.IndexKeyInternalWorkspaceVisibility, string(workspacesv1alpha1.InternalWorkspaceVisibilityCommunity))
 	return c.backend.List(ctx, workspaces, opt)
 }
+
+// matchingFields selects the objects whose field indexed by key has the given value
+// and that match the provided selector, if any
+func matchingFields(selector fields.Selector, key, value string) client.MatchingFieldsSelector {
+	s := fields.OneTermEqualSelector(key, value)
+	if selector != nil && !selector.Empty() {
+		s = fields.AndSelectors(s, selector)
+	}
+	return client.MatchingFieldsSelector{Selector: s}
+}
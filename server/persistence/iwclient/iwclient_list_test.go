@@ -13,6 +13,7 @@ import (
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
 	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
 
+	"github.com/konflux-workspaces/workspaces/server/persistence/internal/cache"
 	"github.com/konflux-workspaces/workspaces/server/persistence/iwclient"
 	"github.com/konflux-workspaces/workspaces/server/persistence/iwclient/mocks"
 )
@@ -410,6 +411,64 @@ var _ = Describe("List", func() {
 		})
 	})
 
+	When("a field selector is provided", func() {
+		BeforeEach(func() {
+			buildWorkspace := func(name, owner string, visibility workspacesv1alpha1.InternalWorkspaceVisibility) *workspacesv1alpha1.InternalWorkspace {
+				return &workspacesv1alpha1.InternalWorkspace{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: wsns},
+					Spec:       workspacesv1alpha1.InternalWorkspaceSpec{DisplayName: name, Visibility: visibility},
+					Status: workspacesv1alpha1.InternalWorkspaceStatus{
+						Owner: workspacesv1alpha1.UserInfoStatus{Username: owner},
+						Space: workspacesv1alpha1.SpaceInfo{Name: name},
+					},
+				}
+			}
+
+			c = buildCache(wsns, ksns,
+				buildWorkspace("alice-community", "alice", workspacesv1alpha1.InternalWorkspaceVisibilityCommunity),
+				buildWorkspace("bob-community", "bob", workspacesv1alpha1.InternalWorkspaceVisibilityCommunity),
+				buildWorkspace("alice-private", "alice", workspacesv1alpha1.InternalWorkspaceVisibilityPrivate),
+				&toolchainv1alpha1.SpaceBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "alice-private-user",
+						Namespace: ksns,
+						Labels: map[string]string{
+							toolchainv1alpha1.SpaceBindingMasterUserRecordLabelKey: "user",
+							toolchainv1alpha1.SpaceBindingSpaceLabelKey:            "alice-private",
+						},
+					},
+					Spec: toolchainv1alpha1.SpaceBindingSpec{
+						MasterUserRecord: "user",
+						SpaceRole:        "contributor",
+						Space:            "alice-private",
+					},
+				},
+			)
+		})
+
+		DescribeTable("selects the workspaces through the cache's indexes", func(selector client.MatchingFields, expected ...string) {
+			// when
+			var ww workspacesv1alpha1.InternalWorkspaceList
+			err := c.ListAsUser(ctx, "user", &ww, selector)
+			Expect(err).NotTo(HaveOccurred())
+
+			// then
+			nn := []string{}
+			for _, w := range ww.Items {
+				nn = append(nn, w.Name)
+			}
+			Expect(nn).To(ConsistOf(expected))
+		},
+			Entry("by owner", client.MatchingFields{cache.IndexKeyInternalWorkspaceOwnerUsername: "alice"}, "alice-community", "alice-private"),
+			Entry("by visibility", client.MatchingFields{cache.IndexKeyInternalWorkspaceVisibility: "private"}, "alice-private"),
+			Entry("by owner and visibility", client.MatchingFields{
+				cache.IndexKeyInternalWorkspaceOwnerUsername: "bob",
+				cache.IndexKeyInternalWorkspaceVisibility:    "community",
+			}, "bob-community"),
+			Entry("by a value no workspace has", client.MatchingFields{cache.IndexKeyInternalWorkspaceOwnerUsername: "carol"}),
+		)
+	})
+
 	When("ListAsUser returns an error", func() {
 		var reader *mocks.MockFakeCRReader
 		var ctrl *gomock.Controller
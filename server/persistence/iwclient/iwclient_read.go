@@ -9,6 +9,7 @@ import (
 	"github.com/konflux-workspaces/workspaces/server/log"
 	"github.com/konflux-workspaces/workspaces/server/persistence/clientinterface"
 	"github.com/konflux-workspaces/workspaces/server/persistence/internal/cache"
+	"github.com/konflux-workspaces/workspaces/server/telemetry"
 
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
 	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
@@ -20,7 +21,8 @@ var (
 	ErrMoreThanOneFound  error = fmt.Errorf("more than one workspace found")
 )
 
-// GetAsUser retrieves the requested workspace if and only if it is community or `user` is allowed access to
+// GetAsUser retrieves the requested workspace if and only if it is community, `user` is allowed access to,
+// or its ownership is proposed to `user`
 func (c *Client) GetAsUser(
 	ctx context.Context,
 	user string,
@@ -28,6 +30,9 @@ func (c *Client) GetAsUser(
 	workspace *workspacesv1alpha1.InternalWorkspace,
 	opts ...client.GetOption,
 ) error {
+	ctx, span := telemetry.StartSpan(ctx, "Client.GetAsUser")
+	defer span.End()
+
 	l := log.FromContext(ctx).With("key", key, "user", user)
 	l.Debug("retrieving InternalWorkspace")
 	w, err := c.fetchInternalWorkspace(ctx, key.Owner, key.Name, nil)
@@ -43,6 +48,13 @@ func (c *Client) GetAsUser(
 		return nil
 	}
 
+	// the user the ownership is proposed to is allowed visibility, so that they can accept it
+	if t := w.Spec.OwnershipTransfer; t != nil && t.NewOwner == user {
+		l.Debug("InternalWorkspace ownership is proposed to the user, returning it")
+		w.DeepCopyInto(workspace)
+		return nil
+	}
+
 	// check if user has direct visibility on the space
 	l.Debug("InternalWorkspace is private, checking for a SpaceBinding for the user")
 	ok, err := c.UserHasDirectAccess(ctx, user, w.GetName())
@@ -83,7 +95,25 @@ func (c *Client) fetchInternalWorkspace(
 		return nil, ErrWorkspaceNotFound
 	}
 
-	return &ww.Items[0], nil
+	// concurrent creations may produce more than one InternalWorkspace with the same display name.
+	// The operator only provisions the oldest one, so it is the one returned.
+	o := &ww.Items[0]
+	for i := range ww.Items[1:] {
+		if w := &ww.Items[i+1]; isOlder(w, o) {
+			o = w
+		}
+	}
+	return o, nil
+}
+
+// isOlder returns true if a has been created before b.
+// InternalWorkspaces created in the same second are ordered by name,
+// as the operator does when resolving display name conflicts.
+func isOlder(a, b *workspacesv1alpha1.InternalWorkspace) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.Name < b.Name
 }
 
 func (c *Client) fetchUserSignupByComplaintName(
@@ -101,3 +131,38 @@ func (c *Client) fetchUserSignupByComplaintName(
 	}
 	return &uu.Items[0], nil
 }
+
+// GetUserSignupByComplaintName retrieves the UserSignup of the user identified by the provided CompliantUsername
+func (c *Client) GetUserSignupByComplaintName(
+	ctx context.Context,
+	complaintName string,
+	userSignup *toolchainv1alpha1.UserSignup,
+) error {
+	ctx, span := telemetry.StartSpan(ctx, "Client.GetUserSignupByComplaintName")
+	defer span.End()
+
+	u, err := c.fetchUserSignupByComplaintName(ctx, complaintName)
+	if err != nil {
+		return err
+	}
+
+	u.DeepCopyInto(userSignup)
+	return nil
+}
+
+// IsDisplayNameInUse checks whether the owner already owns an InternalWorkspace with the provided DisplayName
+func (c *Client) IsDisplayNameInUse(ctx context.Context, owner, displayName string) (bool, error) {
+	ctx, span := telemetry.StartSpan(ctx, "Client.IsDisplayNameInUse")
+	defer span.End()
+
+	ww := workspacesv1alpha1.InternalWorkspaceList{}
+	opt := client.MatchingFields{
+		cache.IndexKeyInternalWorkspaceDisplayName:   displayName,
+		cache.IndexKeyInternalWorkspaceOwnerUsername: owner,
+	}
+	if err := c.backend.List(ctx, &ww, opt); err != nil {
+		return false, err
+	}
+
+	return len(ww.Items) > 0, nil
+}
@@ -3,6 +3,7 @@ package iwclient_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -172,6 +173,62 @@ var _ = Describe("Read", func() {
 		})
 	})
 
+	When("the ownership of a workspace is proposed to another user", func() {
+		w := &workspacesv1alpha1.InternalWorkspace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      generateName("transferred-ws"),
+				Namespace: wsns,
+			},
+			Spec: workspacesv1alpha1.InternalWorkspaceSpec{
+				DisplayName: "transferred-ws",
+				OwnershipTransfer: &workspacesv1alpha1.InternalWorkspaceOwnershipTransfer{
+					NewOwner: "new-owner-user",
+				},
+			},
+			Status: workspacesv1alpha1.InternalWorkspaceStatus{
+				Owner: workspacesv1alpha1.UserInfoStatus{
+					Username: "owner-user",
+				},
+			},
+		}
+		BeforeEach(func() {
+			c = buildCache(wsns, ksns,
+				w,
+				&toolchainv1alpha1.UserSignup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      w.Status.Owner.Username,
+						Namespace: ksns,
+					},
+					Status: toolchainv1alpha1.UserSignupStatus{
+						CompliantUsername: w.Status.Owner.Username,
+					},
+				},
+			)
+		})
+
+		It("is returned in the proposed owner's read", func() {
+			// when
+			var rw workspacesv1alpha1.InternalWorkspace
+			key := clientinterface.SpaceKey{Owner: "owner-user", Name: "transferred-ws"}
+			err := c.GetAsUser(ctx, "new-owner-user", key, &rw)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*w).To(Equal(rw))
+		})
+
+		It("is NOT returned in other users' read", func() {
+			// when
+			rw := workspacesv1alpha1.InternalWorkspace{}
+			key := clientinterface.SpaceKey{Owner: "owner-user", Name: "transferred-ws"}
+			err := c.GetAsUser(ctx, "not-owner-user", key, &rw)
+
+			// then
+			Expect(err).To(MatchError(iwclient.ErrUnauthorized))
+			Expect(rw).To(BeZero())
+		})
+	})
+
 	When("more than one valid workspace exist", func() {
 		var ww []*workspacesv1alpha1.InternalWorkspace
 
@@ -267,6 +324,59 @@ var _ = Describe("Read", func() {
 		})
 	})
 
+	When("more than one workspace with the same display name exist", func() {
+		var older, newer *workspacesv1alpha1.InternalWorkspace
+
+		BeforeEach(func() {
+			// given two workspaces of the same owner with the same display name,
+			// whose names are ordered the other way around their creation
+			older = &workspacesv1alpha1.InternalWorkspace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "z-duplicated-ws",
+					Namespace:         wsns,
+					CreationTimestamp: metav1.NewTime(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)),
+				},
+				Spec: workspacesv1alpha1.InternalWorkspaceSpec{
+					DisplayName: "duplicated-ws",
+					Visibility:  workspacesv1alpha1.InternalWorkspaceVisibilityCommunity,
+				},
+				Status: workspacesv1alpha1.InternalWorkspaceStatus{
+					Owner: workspacesv1alpha1.UserInfoStatus{
+						Username: "owner-user",
+					},
+				},
+			}
+			newer = older.DeepCopy()
+			newer.Name = "a-duplicated-ws"
+			newer.CreationTimestamp = metav1.NewTime(time.Date(2024, time.January, 1, 0, 1, 0, 0, time.UTC))
+
+			c = buildCache(wsns, ksns,
+				newer,
+				older,
+				&toolchainv1alpha1.UserSignup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "owner-user",
+						Namespace: ksns,
+					},
+					Status: toolchainv1alpha1.UserSignupStatus{
+						CompliantUsername: "owner-user",
+					},
+				},
+			)
+		})
+
+		It("returns the oldest one in read", func() {
+			// when
+			var rw workspacesv1alpha1.InternalWorkspace
+			key := clientinterface.SpaceKey{Owner: "owner-user", Name: "duplicated-ws"}
+			err := c.GetAsUser(ctx, "owner-user", key, &rw)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rw.Name).To(Equal(older.Name))
+		})
+	})
+
 	// workspace shared with user
 	When("workspace is shared with other users", func() {
 		var expectedWorkspace workspacesv1alpha1.InternalWorkspace
@@ -3,6 +3,7 @@ package iwclient_test
 import (
 	. "github.com/onsi/gomega"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -20,6 +21,8 @@ func buildCache(wsns, ksns string, objs ...client.Object) *iwclient.Client {
 	Expect(err).NotTo(HaveOccurred())
 	err = toolchainv1alpha1.AddToScheme(scheme)
 	Expect(err).NotTo(HaveOccurred())
+	err = corev1.AddToScheme(scheme)
+	Expect(err).NotTo(HaveOccurred())
 
 	fcb := fake.NewClientBuilder().
 		WithScheme(scheme).
@@ -31,6 +34,9 @@ func buildCache(wsns, ksns string, objs ...client.Object) *iwclient.Client {
 	for key, indexer := range cache.InternalWorkspacesIndexers {
 		fcb.WithIndex(&workspacesv1alpha1.InternalWorkspace{}, key, indexer)
 	}
+	for key, indexer := range cache.EventsIndexers {
+		fcb.WithIndex(&corev1.Event{}, key, indexer)
+	}
 
 	return iwclient.New(fcb.Build(), wsns, ksns)
 }
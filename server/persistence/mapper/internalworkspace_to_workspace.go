@@ -1,6 +1,7 @@
 package mapper
 
 import (
+	"encoding/json"
 	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -18,6 +19,41 @@ func (m *Mapper) InternalWorkspaceToWorkspace(workspace *workspacesv1alpha1.Inte
 		}
 	}
 
+	// retrieve external annotations
+	var waa map[string]string
+	for k, v := range workspace.GetAnnotations() {
+		if !strings.HasPrefix(k, workspacesv1alpha1.LabelInternalDomain) {
+			if waa == nil {
+				waa = map[string]string{}
+			}
+			waa[k] = v
+		}
+	}
+
+	var mm []restworkspacesv1alpha1.WorkspaceMember
+	if len(workspace.Spec.Members) > 0 {
+		mm = make([]restworkspacesv1alpha1.WorkspaceMember, len(workspace.Spec.Members))
+		for i, m := range workspace.Spec.Members {
+			mm[i] = restworkspacesv1alpha1.WorkspaceMember{
+				Username: m.Username,
+				Role:     restworkspacesv1alpha1.WorkspaceRole(m.Role),
+			}
+		}
+	}
+
+	var ot *restworkspacesv1alpha1.WorkspaceOwnershipTransfer
+	if t := workspace.Spec.OwnershipTransfer; t != nil {
+		ot = &restworkspacesv1alpha1.WorkspaceOwnershipTransfer{NewOwner: t.NewOwner}
+	}
+
+	// a malformed annotation only drops the ownership information of the fields
+	var mff []metav1.ManagedFieldsEntry
+	if a, ok := workspace.GetAnnotations()[AnnotationManagedFields]; ok {
+		if err := json.Unmarshal([]byte(a), &mff); err != nil {
+			mff = nil
+		}
+	}
+
 	return &restworkspacesv1alpha1.Workspace{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "Workspace",
@@ -28,10 +64,15 @@ func (m *Mapper) InternalWorkspaceToWorkspace(workspace *workspacesv1alpha1.Inte
 			Namespace:         workspace.Status.Owner.Username,
 			CreationTimestamp: workspace.CreationTimestamp,
 			Labels:            wll,
+			Annotations:       waa,
 			Generation:        workspace.Generation,
+			ResourceVersion:   workspace.ResourceVersion,
+			ManagedFields:     mff,
 		},
 		Spec: restworkspacesv1alpha1.WorkspaceSpec{
-			Visibility: restworkspacesv1alpha1.WorkspaceVisibility(workspace.Spec.Visibility),
+			Visibility:        restworkspacesv1alpha1.WorkspaceVisibility(workspace.Spec.Visibility),
+			Members:           mm,
+			OwnershipTransfer: ot,
 		},
 		Status: restworkspacesv1alpha1.WorkspaceStatus{
 			Space: &restworkspacesv1alpha1.SpaceInfo{
@@ -41,7 +82,8 @@ func (m *Mapper) InternalWorkspaceToWorkspace(workspace *workspacesv1alpha1.Inte
 			Owner: &restworkspacesv1alpha1.UserInfoStatus{
 				Email: workspace.Spec.Owner.JwtInfo.Email,
 			},
-			Conditions: workspace.Status.Conditions,
+			Conditions:         workspace.Status.Conditions,
+			ObservedGeneration: workspace.Status.ObservedGeneration,
 		},
 	}, nil
 }
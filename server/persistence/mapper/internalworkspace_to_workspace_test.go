@@ -55,6 +55,22 @@ var _ = Describe("InternalworkspaceToWorkspace", func() {
 				Expect(w.Spec.Visibility).To(Equal(restworkspacesv1alpha1.WorkspaceVisibilityPrivate))
 			})
 		})
+
+		When("an ownership transfer is pending", func() {
+			BeforeEach(func() {
+				internalWorkspace.Spec.OwnershipTransfer = &workspacesv1alpha1.InternalWorkspaceOwnershipTransfer{NewOwner: "new-owner"}
+			})
+
+			It("converts successfully", func() {
+				// when
+				w, err := mapper.Default.InternalWorkspaceToWorkspace(&internalWorkspace)
+
+				// then
+				Expect(err).NotTo(HaveOccurred())
+				validateMappedWorkspace(w, internalWorkspace)
+				Expect(w.Spec.OwnershipTransfer).To(Equal(&restworkspacesv1alpha1.WorkspaceOwnershipTransfer{NewOwner: "new-owner"}))
+			})
+		})
 	})
 })
 
@@ -67,11 +83,19 @@ func buildExampleValidInternalWorkspace(displayName, workspacesNamespace, ownerN
 				"expected-label": "not-empty",
 				workspacesv1alpha1.LabelInternalDomain + "not-expected-label": "not-empty",
 			},
+			Annotations: map[string]string{
+				"expected-annotation": "not-empty",
+				workspacesv1alpha1.LabelInternalDomain + "not-expected-annotation": "not-empty",
+			},
 			Generation:        1,
+			ResourceVersion:   "42",
 			CreationTimestamp: metav1.Now(),
 		},
 		Spec: workspacesv1alpha1.InternalWorkspaceSpec{
 			DisplayName: displayName,
+			Members: []workspacesv1alpha1.InternalWorkspaceMember{
+				{Username: "member", Role: workspacesv1alpha1.InternalWorkspaceRoleContributor},
+			},
 		},
 		Status: workspacesv1alpha1.InternalWorkspaceStatus{
 			Owner: workspacesv1alpha1.UserInfoStatus{
@@ -85,6 +109,7 @@ func buildExampleValidInternalWorkspace(displayName, workspacesNamespace, ownerN
 			Conditions: []metav1.Condition{
 				{Message: "test", Type: "test", Reason: "test", Status: metav1.ConditionTrue},
 			},
+			ObservedGeneration: 1,
 		},
 	}
 }
@@ -98,12 +123,20 @@ func validateMappedWorkspace(w *restworkspacesv1alpha1.Workspace, from workspace
 		Not(HaveKey(restworkspacesv1alpha1.LabelIsOwner)),
 		Not(HaveKey(workspacesv1alpha1.LabelInternalDomain+"not-expected-label")),
 	))
+	Expect(w.GetAnnotations()).To(Equal(map[string]string{"expected-annotation": "not-empty"}))
 	Expect(w.Generation).To(Equal(int64(1)))
+	Expect(w.ResourceVersion).To(Equal(from.ResourceVersion))
 	Expect(w.CreationTimestamp).To(Equal(from.CreationTimestamp))
 	Expect(w.Spec).ToNot(BeNil())
+	Expect(w.Spec.Members).To(HaveLen(len(from.Spec.Members)))
+	for i, m := range from.Spec.Members {
+		Expect(w.Spec.Members[i].Username).To(Equal(m.Username))
+		Expect(string(w.Spec.Members[i].Role)).To(Equal(string(m.Role)))
+	}
 	Expect(w.Status).ToNot(BeNil())
 	Expect(w.Status.Space).ToNot(BeNil())
 	Expect(w.Status.Space.Name).To(Equal(from.Status.Space.Name))
 	Expect(w.Status.Space.TargetCluster).To(Equal(from.Status.Space.TargetCluster))
 	Expect(w.Status.Conditions).To(Equal(from.Status.Conditions))
+	Expect(w.Status.ObservedGeneration).To(Equal(from.Status.ObservedGeneration))
 }
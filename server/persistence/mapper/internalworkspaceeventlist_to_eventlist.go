@@ -0,0 +1,63 @@
+package mapper
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+// InternalWorkspaceEventListToEventList maps the Events recorded on an InternalWorkspace
+// to WorkspaceEvents of the Workspace it backs, sorted from the oldest to the most recent one
+func (m *Mapper) InternalWorkspaceEventListToEventList(workspace *restworkspacesv1alpha1.Workspace, events *corev1.EventList) (*restworkspacesv1alpha1.WorkspaceEventList, error) {
+	ee := restworkspacesv1alpha1.WorkspaceEventList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "WorkspaceEventList",
+			APIVersion: restworkspacesv1alpha1.GroupVersion.String(),
+		},
+		Items: make([]restworkspacesv1alpha1.WorkspaceEvent, 0),
+	}
+	if events == nil {
+		return &ee, nil
+	}
+
+	for _, e := range events.Items {
+		ee.Items = append(ee.Items, restworkspacesv1alpha1.WorkspaceEvent{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "WorkspaceEvent",
+				APIVersion: restworkspacesv1alpha1.GroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              e.Name,
+				Namespace:         workspace.Namespace,
+				CreationTimestamp: e.CreationTimestamp,
+				ResourceVersion:   e.ResourceVersion,
+			},
+			Type:           e.Type,
+			Reason:         e.Reason,
+			Message:        e.Message,
+			Count:          e.Count,
+			FirstTimestamp: e.FirstTimestamp,
+			LastTimestamp:  eventTime(e),
+		})
+	}
+
+	slices.SortStableFunc(ee.Items, func(a, b restworkspacesv1alpha1.WorkspaceEvent) int {
+		return a.LastTimestamp.Compare(b.LastTimestamp.Time)
+	})
+	return &ee, nil
+}
+
+// eventTime returns the time an Event was last observed at
+func eventTime(e corev1.Event) metav1.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp
+	case !e.EventTime.IsZero():
+		return metav1.NewTime(e.EventTime.Time)
+	default:
+		return e.CreationTimestamp
+	}
+}
@@ -0,0 +1,93 @@
+package mapper_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+	"github.com/konflux-workspaces/workspaces/server/persistence/mapper"
+)
+
+var _ = Describe("InternalworkspaceeventlistToEventlist", func() {
+	workspace := restworkspacesv1alpha1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{Name: "workspace", Namespace: "owner"},
+	}
+
+	It("returns an empty list for nil input", func() {
+		// when
+		ee, err := mapper.Default.InternalWorkspaceEventListToEventList(&workspace, nil)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ee.Kind).To(Equal("WorkspaceEventList"))
+		Expect(ee.Items).To(BeEmpty())
+	})
+
+	It("maps the events to the workspace sorted by time", func() {
+		// given
+		now := time.Now().Truncate(time.Second)
+		internalEvent := func(name string, t time.Time) corev1.Event {
+			return corev1.Event{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:          name,
+					Namespace:     "workspaces-system",
+					ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "operator"}},
+				},
+				InvolvedObject: corev1.ObjectReference{
+					Kind:       "InternalWorkspace",
+					APIVersion: "workspaces.konflux-ci.dev/v1alpha1",
+					Namespace:  "workspaces-system",
+					Name:       "workspace-abcde",
+					UID:        "workspace-uid",
+				},
+				Type:          corev1.EventTypeNormal,
+				Reason:        name,
+				Message:       "message " + name,
+				Count:         1,
+				LastTimestamp: metav1.NewTime(t),
+			}
+		}
+		events := corev1.EventList{Items: []corev1.Event{
+			internalEvent("newer", now),
+			internalEvent("older", now.Add(-time.Minute)),
+		}}
+
+		// when
+		ee, err := mapper.Default.InternalWorkspaceEventListToEventList(&workspace, &events)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ee.Items).To(HaveLen(2))
+		Expect(ee.Items[0].Reason).To(Equal("older"))
+		Expect(ee.Items[1].Reason).To(Equal("newer"))
+		for _, e := range ee.Items {
+			Expect(e.ObjectMeta.Namespace).To(Equal(workspace.Namespace))
+			Expect(e.ObjectMeta.ManagedFields).To(BeEmpty())
+			Expect(e.Message).To(Equal("message " + e.Reason))
+			Expect(e.Type).To(Equal(corev1.EventTypeNormal))
+			Expect(e.Kind).To(Equal("WorkspaceEvent"))
+			Expect(e.APIVersion).To(Equal(restworkspacesv1alpha1.GroupVersion.String()))
+		}
+	})
+
+	It("reports the event time of events with no last timestamp", func() {
+		// given
+		now := time.Now().Truncate(time.Second)
+		events := corev1.EventList{Items: []corev1.Event{{
+			ObjectMeta: metav1.ObjectMeta{Name: "event"},
+			EventTime:  metav1.NewMicroTime(now),
+		}}}
+
+		// when
+		ee, err := mapper.Default.InternalWorkspaceEventListToEventList(&workspace, &events)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ee.Items).To(HaveLen(1))
+		Expect(ee.Items[0].LastTimestamp.Time).To(BeTemporally("==", now))
+	})
+})
@@ -1,5 +1,11 @@
 package mapper
 
+import workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+
+// AnnotationManagedFields is the InternalWorkspace annotation storing the
+// managedFields of the Workspace, as tracked by server-side apply
+const AnnotationManagedFields string = workspacesv1alpha1.LabelInternalDomain + "managed-fields"
+
 type Mapper struct{}
 
 var Default = &Mapper{}
@@ -1,6 +1,7 @@
 package mapper
 
 import (
+	"encoding/json"
 	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -19,14 +20,26 @@ func (m *Mapper) WorkspaceToInternalWorkspace(workspace *restworkspacesv1alpha1.
 		}
 	}
 
+	var aa map[string]string
+	for k, v := range workspace.GetAnnotations() {
+		if !strings.HasPrefix(k, workspacesv1alpha1.LabelInternalDomain) {
+			if aa == nil {
+				aa = map[string]string{}
+			}
+			aa[k] = v
+		}
+	}
+
 	iw := &workspacesv1alpha1.InternalWorkspace{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "InternalWorkspace",
 			APIVersion: workspacesv1alpha1.GroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Labels:     ll,
-			Generation: workspace.Generation,
+			Labels:          ll,
+			Annotations:     aa,
+			Generation:      workspace.Generation,
+			ResourceVersion: workspace.ResourceVersion,
 		},
 		Spec: workspacesv1alpha1.InternalWorkspaceSpec{
 			DisplayName: workspace.Name,
@@ -45,6 +58,31 @@ func (m *Mapper) WorkspaceToInternalWorkspace(workspace *restworkspacesv1alpha1.
 		},
 	}
 
+	if len(workspace.Spec.Members) > 0 {
+		iw.Spec.Members = make([]workspacesv1alpha1.InternalWorkspaceMember, len(workspace.Spec.Members))
+		for i, m := range workspace.Spec.Members {
+			iw.Spec.Members[i] = workspacesv1alpha1.InternalWorkspaceMember{
+				Username: m.Username,
+				Role:     workspacesv1alpha1.InternalWorkspaceRole(m.Role),
+			}
+		}
+	}
+
+	if t := workspace.Spec.OwnershipTransfer; t != nil {
+		iw.Spec.OwnershipTransfer = &workspacesv1alpha1.InternalWorkspaceOwnershipTransfer{NewOwner: t.NewOwner}
+	}
+
+	if len(workspace.ManagedFields) > 0 {
+		mff, err := json.Marshal(workspace.ManagedFields)
+		if err != nil {
+			return nil, err
+		}
+		if iw.Annotations == nil {
+			iw.Annotations = map[string]string{}
+		}
+		iw.Annotations[AnnotationManagedFields] = string(mff)
+	}
+
 	if o := workspace.Status.Owner; o != nil {
 		iw.Spec.Owner.JwtInfo.Email = o.Email
 	}
@@ -53,6 +53,48 @@ var _ = Describe("WorkspaceToInternalworkspace", func() {
 				Expect(iw.Spec.Visibility).To(Equal(workspacesv1alpha1.InternalWorkspaceVisibilityPrivate))
 			})
 		})
+
+		When("an ownership transfer is pending", func() {
+			BeforeEach(func() {
+				workspace.Spec.OwnershipTransfer = &restworkspacesv1alpha1.WorkspaceOwnershipTransfer{NewOwner: "new-owner"}
+			})
+
+			It("converts successfully", func() {
+				// when
+				iw, err := mapper.Default.WorkspaceToInternalWorkspace(&workspace)
+
+				// then
+				Expect(err).NotTo(HaveOccurred())
+				validateMappedInternalWorkspace(iw, &workspace)
+				Expect(iw.Spec.OwnershipTransfer).To(Equal(&workspacesv1alpha1.InternalWorkspaceOwnershipTransfer{NewOwner: "new-owner"}))
+			})
+		})
+
+		When("managed fields are set", func() {
+			BeforeEach(func() {
+				workspace.ManagedFields = []metav1.ManagedFieldsEntry{{
+					Manager:    "kubectl",
+					Operation:  metav1.ManagedFieldsOperationApply,
+					APIVersion: restworkspacesv1alpha1.GroupVersion.String(),
+					FieldsType: "FieldsV1",
+					FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:visibility":{}}}`)},
+				}}
+			})
+
+			It("stores them in an annotation", func() {
+				// when
+				iw, err := mapper.Default.WorkspaceToInternalWorkspace(&workspace)
+
+				// then
+				Expect(err).NotTo(HaveOccurred())
+				Expect(iw.Annotations).To(HaveKey(mapper.AnnotationManagedFields))
+				Expect(iw.Annotations).To(HaveKey("expected-annotation"))
+
+				w, err := mapper.Default.InternalWorkspaceToWorkspace(iw)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(w.ManagedFields).To(Equal(workspace.ManagedFields))
+			})
+		})
 	})
 })
 
@@ -65,10 +107,18 @@ func buildExampleValidWorkspace(displayName, ownerName string) restworkspacesv1a
 				"expected-label": "not-empty",
 				workspacesv1alpha1.LabelInternalDomain + "not-expected-label": "not-empty",
 			},
-			Generation: 1,
+			Annotations: map[string]string{
+				"expected-annotation": "not-empty",
+				workspacesv1alpha1.LabelInternalDomain + "not-expected-annotation": "not-empty",
+			},
+			Generation:      1,
+			ResourceVersion: "42",
 		},
 		Spec: restworkspacesv1alpha1.WorkspaceSpec{
 			Visibility: restworkspacesv1alpha1.WorkspaceVisibilityCommunity,
+			Members: []restworkspacesv1alpha1.WorkspaceMember{
+				{Username: "member", Role: restworkspacesv1alpha1.WorkspaceRoleContributor},
+			},
 		},
 		Status: restworkspacesv1alpha1.WorkspaceStatus{
 			Owner: &restworkspacesv1alpha1.UserInfoStatus{
@@ -84,12 +134,20 @@ func buildExampleValidWorkspace(displayName, ownerName string) restworkspacesv1a
 func validateMappedInternalWorkspace(w *workspacesv1alpha1.InternalWorkspace, from *restworkspacesv1alpha1.Workspace) {
 	Expect(w).ToNot(BeNil())
 	Expect(w.Generation).To(Equal(int64(1)))
+	Expect(w.ResourceVersion).To(Equal("42"))
 	Expect(w.GetName()).To(BeZero())
 	Expect(w.GetNamespace()).To(BeZero())
 	Expect(w.GetLabels()).To(HaveKey("expected-label"))
 	Expect(w.GetLabels()["expected-label"]).To(Equal("not-empty"))
 	Expect(w.GetLabels()).NotTo(HaveKey(workspacesv1alpha1.LabelInternalDomain + "not-expected-label"))
+	Expect(w.GetAnnotations()).To(HaveKeyWithValue("expected-annotation", "not-empty"))
+	Expect(w.GetAnnotations()).NotTo(HaveKey(workspacesv1alpha1.LabelInternalDomain + "not-expected-annotation"))
 	Expect(w.Spec).ToNot(BeNil())
 	Expect(w.Spec.DisplayName).To(Equal(from.Name))
+	Expect(w.Spec.Members).To(HaveLen(len(from.Spec.Members)))
+	for i, m := range from.Spec.Members {
+		Expect(w.Spec.Members[i].Username).To(Equal(m.Username))
+		Expect(string(w.Spec.Members[i].Role)).To(Equal(string(m.Role)))
+	}
 	Expect(w.Status.Owner.Username).To(Equal(from.Namespace))
 }
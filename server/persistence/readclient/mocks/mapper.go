@@ -15,6 +15,7 @@ import (
 	v1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
 	v1alpha10 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
 	gomock "go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
 )
 
 // MockFakeIWMapper is a mock of FakeIWMapper interface.
@@ -40,6 +41,21 @@ func (m *MockFakeIWMapper) EXPECT() *MockFakeIWMapperMockRecorder {
 	return m.recorder
 }
 
+// InternalWorkspaceEventListToEventList mocks base method.
+func (m *MockFakeIWMapper) InternalWorkspaceEventListToEventList(arg0 *v1alpha10.Workspace, arg1 *v1.EventList) (*v1alpha10.WorkspaceEventList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InternalWorkspaceEventListToEventList", arg0, arg1)
+	ret0, _ := ret[0].(*v1alpha10.WorkspaceEventList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InternalWorkspaceEventListToEventList indicates an expected call of InternalWorkspaceEventListToEventList.
+func (mr *MockFakeIWMapperMockRecorder) InternalWorkspaceEventListToEventList(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InternalWorkspaceEventListToEventList", reflect.TypeOf((*MockFakeIWMapper)(nil).InternalWorkspaceEventListToEventList), arg0, arg1)
+}
+
 // InternalWorkspaceListToWorkspaceList mocks base method.
 func (m *MockFakeIWMapper) InternalWorkspaceListToWorkspaceList(arg0 *v1alpha1.InternalWorkspaceList) (*v1alpha10.WorkspaceList, error) {
 	m.ctrl.T.Helper()
@@ -16,6 +16,7 @@ import (
 	v1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
 	clientinterface "github.com/konflux-workspaces/workspaces/server/persistence/clientinterface"
 	gomock "go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -62,17 +63,36 @@ func (mr *MockFakeIWReadClientMockRecorder) GetAsUser(arg0, arg1, arg2, arg3 any
 }
 
 // ListAsUser mocks base method.
-func (m *MockFakeIWReadClient) ListAsUser(arg0 context.Context, arg1 string, arg2 *v1alpha1.InternalWorkspaceList) error {
+func (m *MockFakeIWReadClient) ListAsUser(arg0 context.Context, arg1 string, arg2 *v1alpha1.InternalWorkspaceList, arg3 ...client.ListOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAsUser", arg0, arg1, arg2)
+	varargs := []any{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAsUser", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ListAsUser indicates an expected call of ListAsUser.
-func (mr *MockFakeIWReadClientMockRecorder) ListAsUser(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockFakeIWReadClientMockRecorder) ListAsUser(arg0, arg1, arg2 any, arg3 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAsUser", reflect.TypeOf((*MockFakeIWReadClient)(nil).ListAsUser), varargs...)
+}
+
+// ListEvents mocks base method.
+func (m *MockFakeIWReadClient) ListEvents(arg0 context.Context, arg1 *v1alpha1.InternalWorkspace, arg2 *v1.EventList) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockFakeIWReadClientMockRecorder) ListEvents(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAsUser", reflect.TypeOf((*MockFakeIWReadClient)(nil).ListAsUser), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockFakeIWReadClient)(nil).ListEvents), arg0, arg1, arg2)
 }
 
 // UserHasDirectAccess mocks base method.
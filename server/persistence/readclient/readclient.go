@@ -12,11 +12,12 @@ import (
 	"github.com/konflux-workspaces/workspaces/server/persistence/mapper"
 )
 
-// ReadClient implements the WorkspaceLister and WorkspaceReader interfaces
-// using a client.Reader as backend
+// ReadClient implements the WorkspaceLister, WorkspaceReader and WorkspaceWatcher interfaces
+// using a client.Reader as backend and the cache's informers as source of events
 type ReadClient struct {
 	internalClient clientinterface.InternalWorkspacesReadClient
 	mapper         clientinterface.InternalWorkspacesMapper
+	informers      cache.Informers
 }
 
 // NewDefaultWithCache creates a controller-runtime cache and use it as KubeReadClient's backend.
@@ -28,7 +29,7 @@ func NewDefaultWithCache(ctx context.Context, cfg *rest.Config, workspacesNamesp
 	}
 
 	internalClient := iwclient.New(c, workspacesNamespace, kubesawNamespace)
-	return NewDefaultWithInternalClient(internalClient), c, nil
+	return NewWithInformers(internalClient, mapper.Default, c), c, nil
 }
 
 // NewDefaultWithInternalClient creates a new KubeReadClient with the provided backend and default InternalWorkspaces/Workspaces mapper
@@ -38,8 +39,15 @@ func NewDefaultWithInternalClient(internalClient clientinterface.InternalWorkspa
 
 // New creates a new KubeReadClient with the provided backend and a custom InternalWorkspaces/Workspaces mapper
 func New(internalClient clientinterface.InternalWorkspacesReadClient, mapper clientinterface.InternalWorkspacesMapper) *ReadClient {
+	return NewWithInformers(internalClient, mapper, nil)
+}
+
+// NewWithInformers creates a new KubeReadClient with the provided backend, a custom InternalWorkspaces/Workspaces mapper,
+// and the informers used as source of events for watch requests
+func NewWithInformers(internalClient clientinterface.InternalWorkspacesReadClient, mapper clientinterface.InternalWorkspacesMapper, informers cache.Informers) *ReadClient {
 	return &ReadClient{
 		internalClient: internalClient,
 		mapper:         mapper,
+		informers:      informers,
 	}
 }
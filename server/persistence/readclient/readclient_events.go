@@ -0,0 +1,58 @@
+package readclient
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/konflux-workspaces/workspaces/server/core/workspace"
+	"github.com/konflux-workspaces/workspaces/server/log"
+	"github.com/konflux-workspaces/workspaces/server/persistence/clientinterface"
+
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+	"github.com/konflux-workspaces/workspaces/server/telemetry"
+)
+
+var _ workspace.WorkspaceEventsLister = &ReadClient{}
+
+// ListUserWorkspaceEvents returns the Events of the Workspace only if the user has access to it
+func (c *ReadClient) ListUserWorkspaceEvents(
+	ctx context.Context,
+	user string,
+	owner string,
+	space string,
+	obj *restworkspacesv1alpha1.WorkspaceEventList,
+	_ ...client.ListOption,
+) error {
+	ctx, span := telemetry.StartSpan(ctx, "ReadClient.ListUserWorkspaceEvents")
+	defer span.End()
+
+	l := log.FromContext(ctx).With("user", user, "owner", owner, "space", space)
+	var w workspacesv1alpha1.InternalWorkspace
+	key := clientinterface.SpaceKey{Owner: owner, Name: space}
+	if err := c.internalClient.GetAsUser(ctx, user, key, &w); err != nil {
+		l.Error("error retrieving Workspace", "error", err)
+		return kerrors.NewNotFound(restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource(), space)
+	}
+
+	var ee corev1.EventList
+	if err := c.internalClient.ListEvents(ctx, &w, &ee); err != nil {
+		l.Error("error listing Workspace's events", "error", err)
+		return kerrors.NewInternalError(err)
+	}
+
+	rw := restworkspacesv1alpha1.Workspace{}
+	rw.Name, rw.Namespace = space, owner
+	r, err := c.mapper.InternalWorkspaceEventListToEventList(&rw, &ee)
+	if err != nil {
+		l.Error("error mapping internal workspace events to workspace events", "error", err)
+		return kerrors.NewInternalError(err)
+	}
+
+	// return events
+	r.DeepCopyInto(obj)
+	return nil
+}
@@ -0,0 +1,115 @@
+package readclient_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/konflux-workspaces/workspaces/server/persistence/clientinterface"
+	"github.com/konflux-workspaces/workspaces/server/persistence/iwclient"
+	"github.com/konflux-workspaces/workspaces/server/persistence/readclient"
+	"github.com/konflux-workspaces/workspaces/server/persistence/readclient/mocks"
+
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("ListEvents", func() {
+	var ctx context.Context
+	var ctrl *gomock.Controller
+	var frc *mocks.MockFakeIWReadClient
+	var mp *mocks.MockFakeIWMapper
+	var rc *readclient.ReadClient
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		frc = mocks.NewMockFakeIWReadClient(ctrl)
+		mp = mocks.NewMockFakeIWMapper(ctrl)
+		rc = readclient.New(frc, mp)
+	})
+
+	It("returns the mapped events of the workspace", func() {
+		// given
+		internalEvents := corev1.EventList{Items: []corev1.Event{{Reason: "OwnerResolved"}}}
+		frc.EXPECT().
+			GetAsUser(ctx, "user", clientinterface.SpaceKey{Owner: "owner", Name: "workspace"}, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ clientinterface.SpaceKey, w *workspacesv1alpha1.InternalWorkspace, _ ...client.GetOption) error {
+				w.Name = "workspace-internal"
+				return nil
+			}).
+			Times(1)
+		frc.EXPECT().
+			ListEvents(ctx, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, w *workspacesv1alpha1.InternalWorkspace, ee *corev1.EventList) error {
+				Expect(w.Name).To(Equal("workspace-internal"))
+				internalEvents.DeepCopyInto(ee)
+				return nil
+			}).
+			Times(1)
+
+		mappedEvents := restworkspacesv1alpha1.WorkspaceEventList{Items: []restworkspacesv1alpha1.WorkspaceEvent{{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "owner"},
+			Reason:     "OwnerResolved",
+		}}}
+		mp.EXPECT().
+			InternalWorkspaceEventListToEventList(gomock.Any(), &internalEvents).
+			DoAndReturn(func(w *restworkspacesv1alpha1.Workspace, _ *corev1.EventList) (*restworkspacesv1alpha1.WorkspaceEventList, error) {
+				Expect(w.Namespace).To(Equal("owner"))
+				Expect(w.Name).To(Equal("workspace"))
+				return &mappedEvents, nil
+			}).
+			Times(1)
+
+		// when
+		returnedEvents := restworkspacesv1alpha1.WorkspaceEventList{}
+		err := rc.ListUserWorkspaceEvents(ctx, "user", "owner", "workspace", &returnedEvents)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(returnedEvents).To(Equal(mappedEvents))
+	})
+
+	DescribeTable("InternalClient returns an error on retrieving the workspace", func(rerr error) {
+		// given
+		frc.EXPECT().
+			GetAsUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(rerr).
+			Times(1)
+
+		// when
+		err := rc.ListUserWorkspaceEvents(ctx, "user", "owner", "workspace", &restworkspacesv1alpha1.WorkspaceEventList{})
+
+		// then
+		Expect(err).To(MatchError(kerrors.IsNotFound, "IsNotFound"))
+	},
+		Entry("not found -> not found", iwclient.ErrWorkspaceNotFound),
+		Entry("unauthorized -> not found", iwclient.ErrUnauthorized),
+	)
+
+	It("returns InternalError if events can not be listed", func() {
+		// given
+		frc.EXPECT().
+			GetAsUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(1)
+		frc.EXPECT().
+			ListEvents(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(fmt.Errorf("list error")).
+			Times(1)
+
+		// when
+		err := rc.ListUserWorkspaceEvents(ctx, "user", "owner", "workspace", &restworkspacesv1alpha1.WorkspaceEventList{})
+
+		// then
+		Expect(err).To(MatchError(kerrors.IsInternalError, "IsInternalError"))
+	})
+})
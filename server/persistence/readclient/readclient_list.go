@@ -1,18 +1,26 @@
 package readclient
 
 import (
+	"cmp"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
 	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
 	"github.com/konflux-workspaces/workspaces/server/core/workspace"
+	icache "github.com/konflux-workspaces/workspaces/server/persistence/internal/cache"
 	"github.com/konflux-workspaces/workspaces/server/persistence/mutate"
+	"github.com/konflux-workspaces/workspaces/server/telemetry"
 )
 
 var _ workspace.WorkspaceLister = &ReadClient{}
@@ -24,11 +32,8 @@ func (c *ReadClient) ListUserWorkspaces(
 	objs *restworkspacesv1alpha1.WorkspaceList,
 	opts ...client.ListOption,
 ) error {
-	// retrieve workspaces visible to user
-	iww := workspacesv1alpha1.InternalWorkspaceList{}
-	if err := c.internalClient.ListAsUser(ctx, user, &iww); err != nil {
-		return kerrors.NewInternalError(fmt.Errorf("error retrieving the list of workspaces for user %v", user))
-	}
+	ctx, span := telemetry.StartSpan(ctx, "ReadClient.ListUserWorkspaces")
+	defer span.End()
 
 	// map list options
 	listOpts, err := mapListOptions(opts...)
@@ -36,8 +41,14 @@ func (c *ReadClient) ListUserWorkspaces(
 		return err
 	}
 
+	// retrieve workspaces visible to user, selecting the exact field matches through the cache's indexes
+	iww := workspacesv1alpha1.InternalWorkspaceList{}
+	if err := c.internalClient.ListAsUser(ctx, user, &iww, indexedFieldSelector(listOpts)...); err != nil {
+		return kerrors.NewInternalError(fmt.Errorf("error retrieving the list of workspaces for user %v", user))
+	}
+
 	// filter internal workspaces
-	fiww, err := filterByLabels(&iww, listOpts)
+	fiww, err := filterBySelectors(&iww, listOpts)
 	if err != nil {
 		return err
 	}
@@ -51,6 +62,12 @@ func (c *ReadClient) ListUserWorkspaces(
 	// filter by namespace
 	filterByNamespace(ww, listOpts.Namespace)
 
+	// sort and paginate
+	sortWorkspaces(ww)
+	if err := paginate(ww, listOpts.Limit, listOpts.Continue); err != nil {
+		return err
+	}
+
 	for i := range ww.Items {
 		// apply is-owner label
 		// TODO(sadlerap): merge these into a single applier method?
@@ -81,11 +98,89 @@ func filterByNamespace(ww *restworkspacesv1alpha1.WorkspaceList, namespace strin
 	ww.Items = fww
 }
 
-func filterByLabels(ww *workspacesv1alpha1.InternalWorkspaceList, listOpts *client.ListOptions) (*workspacesv1alpha1.InternalWorkspaceList, error) {
+// sortWorkspaces sorts the workspaces by namespace and name,
+// so that the order is stable across requests
+func sortWorkspaces(ww *restworkspacesv1alpha1.WorkspaceList) {
+	slices.SortFunc(ww.Items, func(a, b restworkspacesv1alpha1.Workspace) int {
+		return compareWorkspaceKey(a, continueToken{Namespace: b.Namespace, Name: b.Name})
+	})
+}
+
+// continueToken identifies the last workspace returned by a paginated list request.
+// As it does not depend on the position of the workspace in the list,
+// it stays valid if workspaces are added or removed between requests.
+type continueToken struct {
+	Namespace string `json:"ns"`
+	Name      string `json:"n"`
+}
+
+func compareWorkspaceKey(w restworkspacesv1alpha1.Workspace, t continueToken) int {
+	return cmp.Or(
+		cmp.Compare(w.Namespace, t.Namespace),
+		cmp.Compare(w.Name, t.Name),
+	)
+}
+
+// paginate removes from the sorted list the workspaces already returned, as stated by the continue token,
+// and limits the list to `limit` workspaces, populating Continue and RemainingItemCount if more are available
+func paginate(ww *restworkspacesv1alpha1.WorkspaceList, limit int64, cont string) error {
+	if cont != "" {
+		t, err := decodeContinueToken(cont)
+		if err != nil {
+			return kerrors.NewBadRequest(fmt.Sprintf("invalid continue token: %v", err))
+		}
+
+		i, found := slices.BinarySearchFunc(ww.Items, t, compareWorkspaceKey)
+		if found {
+			i++
+		}
+		ww.Items = ww.Items[i:]
+	}
+
+	if limit <= 0 || int64(len(ww.Items)) <= limit {
+		return nil
+	}
+
+	remaining := int64(len(ww.Items)) - limit
+	ww.Items = ww.Items[:limit]
+	last := ww.Items[limit-1]
+	c, err := encodeContinueToken(continueToken{Namespace: last.Namespace, Name: last.Name})
+	if err != nil {
+		return kerrors.NewInternalError(err)
+	}
+	ww.Continue = c
+	ww.RemainingItemCount = &remaining
+	return nil
+}
+
+func encodeContinueToken(t continueToken) (string, error) {
+	d, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(d), nil
+}
+
+func decodeContinueToken(c string) (continueToken, error) {
+	t := continueToken{}
+	d, err := base64.RawURLEncoding.DecodeString(c)
+	if err != nil {
+		return t, err
+	}
+	if err := json.Unmarshal(d, &t); err != nil {
+		return t, err
+	}
+	if t.Namespace == "" || t.Name == "" {
+		return t, fmt.Errorf("missing workspace key")
+	}
+	return t, nil
+}
+
+func filterBySelectors(ww *workspacesv1alpha1.InternalWorkspaceList, listOpts *client.ListOptions) (*workspacesv1alpha1.InternalWorkspaceList, error) {
 	rww := workspacesv1alpha1.InternalWorkspaceList{}
 	for _, w := range ww.Items {
 		// selection
-		if !matchesListOpts(listOpts, w.GetLabels()) {
+		if !matchesListOpts(listOpts, w.GetLabels()) || !matchesFieldSelector(listOpts, &w) {
 			continue
 		}
 
@@ -98,24 +193,79 @@ func mapListOptions(opts ...client.ListOption) (*client.ListOptions, error) {
 	listOpts := client.ListOptions{}
 	listOpts.ApplyOptions(opts)
 
-	if listOpts.LabelSelector == nil {
-		return &listOpts, nil
+	if listOpts.LabelSelector != nil {
+		rr, _ := listOpts.LabelSelector.Requirements()
+		for _, ls := range rr {
+			if strings.HasPrefix(ls.Key(), workspacesv1alpha1.LabelInternalDomain) {
+				return nil, kerrors.NewBadRequest(fmt.Sprintf("invalid label selector: key '%s' is reserved", ls.Key()))
+			}
+		}
 	}
 
-	rr, _ := listOpts.LabelSelector.Requirements()
-	for _, ls := range rr {
-		if strings.HasPrefix(ls.Key(), workspacesv1alpha1.LabelInternalDomain) {
-			return nil, fmt.Errorf("invalid label selector: key '%s' is reserved", ls.Key())
+	if listOpts.FieldSelector != nil {
+		for _, r := range listOpts.FieldSelector.Requirements() {
+			if _, ok := workspaceFieldIndexes[r.Field]; !ok {
+				return nil, kerrors.NewBadRequest(fmt.Sprintf("field label not supported: %s", r.Field))
+			}
 		}
 	}
 
 	return &listOpts, nil
 }
 
+// workspaceFieldIndexes maps the Workspace's fields supported by field selectors
+// to the keys of the InternalWorkspace's indexers calculating their values
+var workspaceFieldIndexes = map[string]string{
+	"metadata.namespace": icache.IndexKeyInternalWorkspaceOwnerUsername,
+	"spec.visibility":    icache.IndexKeyInternalWorkspaceVisibility,
+	"status.space.name":  icache.IndexKeyInternalWorkspaceSpaceName,
+	"status.owner.email": icache.IndexKeyInternalWorkspaceOwnerEmail,
+}
+
+// indexedFieldSelector translates the exact matches of the field selector into
+// matches on the keys of the cache's InternalWorkspace indexes.
+// The other requirements are checked by matchesFieldSelector.
+func indexedFieldSelector(listOpts *client.ListOptions) []client.ListOption {
+	if listOpts.FieldSelector == nil {
+		return nil
+	}
+
+	ss := []fields.Selector{}
+	for _, r := range listOpts.FieldSelector.Requirements() {
+		switch r.Operator {
+		case selection.Equals, selection.DoubleEquals:
+			ss = append(ss, fields.OneTermEqualSelector(workspaceFieldIndexes[r.Field], r.Value))
+		}
+	}
+
+	if len(ss) == 0 {
+		return nil
+	}
+	return []client.ListOption{client.MatchingFieldsSelector{Selector: fields.AndSelectors(ss...)}}
+}
+
+// matchesFieldSelector checks the InternalWorkspace against the field selector,
+// computing the values of the selected fields with the cache's indexer functions.
+// It is used for the requirements that can not be resolved through the cache's
+// indexes, like the `!=` ones, and for the events of watch requests.
+func matchesFieldSelector(listOpts *client.ListOptions, w *workspacesv1alpha1.InternalWorkspace) bool {
+	if listOpts == nil || listOpts.FieldSelector == nil || listOpts.FieldSelector.Empty() {
+		return true
+	}
+
+	ff := fields.Set{}
+	for f, k := range workspaceFieldIndexes {
+		if vv := icache.InternalWorkspacesIndexers[k](w); len(vv) > 0 {
+			ff[f] = vv[0]
+		}
+	}
+	return listOpts.FieldSelector.Matches(ff)
+}
+
 func matchesListOpts(
 	listOpts *client.ListOptions,
 	objLabels map[string]string,
 ) bool {
-	return objLabels == nil || listOpts == nil || listOpts.LabelSelector == nil ||
+	return listOpts == nil || listOpts.LabelSelector == nil ||
 		listOpts.LabelSelector.Matches(labels.Set(objLabels))
 }
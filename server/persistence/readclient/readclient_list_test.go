@@ -11,10 +11,12 @@ import (
 
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 
 	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
 	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
 	"github.com/konflux-workspaces/workspaces/server/persistence/iwclient"
+	icache "github.com/konflux-workspaces/workspaces/server/persistence/internal/cache"
 	"github.com/konflux-workspaces/workspaces/server/persistence/readclient"
 	"github.com/konflux-workspaces/workspaces/server/persistence/readclient/mocks"
 )
@@ -46,7 +48,7 @@ var _ = Describe("List", func() {
 		// It returns no error so we can test the filtering by label.
 		frc.EXPECT().
 			ListAsUser(ctx, user, gomock.Any()).
-			DoAndReturn(func(_ context.Context, _ string, iww *workspacesv1alpha1.InternalWorkspaceList) error {
+			DoAndReturn(func(_ context.Context, _ string, iww *workspacesv1alpha1.InternalWorkspaceList, _ ...client.ListOption) error {
 				iww.Items = unfilteredInternalWorkspaces
 				return nil
 			}).
@@ -103,6 +105,14 @@ var _ = Describe("List", func() {
 				},
 			},
 		}, []metav1.ObjectMeta{}),
+		Entry("unlabeled InternalWorkspaces returns empty list", []workspacesv1alpha1.InternalWorkspace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "unlabeled",
+					Namespace: "unlabeled",
+				},
+			},
+		}, []metav1.ObjectMeta{}),
 		Entry("single matching InternalWorkspaces returns one workspace", []workspacesv1alpha1.InternalWorkspace{
 			{
 				ObjectMeta: metav1.ObjectMeta{
@@ -216,7 +226,7 @@ var _ = Describe("List", func() {
 		wslist := restworkspacesv1alpha1.WorkspaceList{}
 		frc.EXPECT().
 			ListAsUser(ctx, user, gomock.Any()).
-			Do(func(_ context.Context, user string, ws *workspacesv1alpha1.InternalWorkspaceList) {
+			Do(func(_ context.Context, user string, ws *workspacesv1alpha1.InternalWorkspaceList, _ ...client.ListOption) {
 				ws.Items = []workspacesv1alpha1.InternalWorkspace{
 					{
 						Spec: workspacesv1alpha1.InternalWorkspaceSpec{
@@ -298,7 +308,7 @@ var _ = Describe("List", func() {
 		wslist := restworkspacesv1alpha1.WorkspaceList{}
 		frc.EXPECT().
 			ListAsUser(ctx, user, gomock.Any()).
-			Do(func(_ context.Context, user string, ws *workspacesv1alpha1.InternalWorkspaceList) {
+			Do(func(_ context.Context, user string, ws *workspacesv1alpha1.InternalWorkspaceList, _ ...client.ListOption) {
 				ws.Items = []workspacesv1alpha1.InternalWorkspace{
 					{
 						Spec: workspacesv1alpha1.InternalWorkspaceSpec{
@@ -350,19 +360,267 @@ var _ = Describe("List", func() {
 			// given
 			internalLabel := workspacesv1alpha1.LabelInternalDomain + "whatever"
 
-			// internal client expected to be called once.
-			// It returns no error so we can test the filtering by label.
+			// the request is refused before listing the workspaces
+			frc.EXPECT().
+				ListAsUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Times(0)
+
+			// when
+			actualWorkspaces := restworkspacesv1alpha1.WorkspaceList{}
+			err := rc.ListUserWorkspaces(ctx, user, &actualWorkspaces, client.MatchingLabels{internalLabel: "whatever"})
+
+			// then
+			Expect(err).To(MatchError(kerrors.IsBadRequest, "IsBadRequest"))
+			Expect(err).To(MatchError(fmt.Sprintf("invalid label selector: key '%s' is reserved", internalLabel)))
+		})
+	})
+
+	Describe("Pagination", func() {
+		names := []string{"e", "a", "d", "b", "c"}
+
+		expectList := func(names ...string) {
 			frc.EXPECT().
 				ListAsUser(ctx, user, gomock.Any()).
 				Return(nil).
 				Times(1)
+			frc.EXPECT().
+				UserHasDirectAccess(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(false, nil).
+				AnyTimes()
+			mp.EXPECT().
+				InternalWorkspaceListToWorkspaceList(gomock.Any()).
+				DoAndReturn(func(_ *workspacesv1alpha1.InternalWorkspaceList) (*restworkspacesv1alpha1.WorkspaceList, error) {
+					ww := restworkspacesv1alpha1.WorkspaceList{}
+					for _, n := range names {
+						ww.Items = append(ww.Items, restworkspacesv1alpha1.Workspace{
+							ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: user},
+						})
+					}
+					return &ww, nil
+				}).
+				Times(1)
+		}
+
+		workspaceNames := func(ww restworkspacesv1alpha1.WorkspaceList) []string {
+			nn := []string{}
+			for _, w := range ww.Items {
+				nn = append(nn, w.Name)
+			}
+			return nn
+		}
+
+		It("returns all the workspaces sorted if no limit is set", func() {
+			// given
+			expectList(names...)
 
 			// when
-			actualWorkspaces := restworkspacesv1alpha1.WorkspaceList{}
-			err := rc.ListUserWorkspaces(ctx, user, &actualWorkspaces, client.MatchingLabels{internalLabel: "whatever"})
+			ww := restworkspacesv1alpha1.WorkspaceList{}
+			err := rc.ListUserWorkspaces(ctx, user, &ww)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(workspaceNames(ww)).To(Equal([]string{"a", "b", "c", "d", "e"}))
+			Expect(ww.Continue).To(BeEmpty())
+			Expect(ww.RemainingItemCount).To(BeNil())
+		})
+
+		It("returns the workspaces in pages", func() {
+			// given
+			expectList(names...)
+			expectList(names...)
+			expectList(names...)
+
+			// when
+			first := restworkspacesv1alpha1.WorkspaceList{}
+			err := rc.ListUserWorkspaces(ctx, user, &first, client.Limit(2))
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(workspaceNames(first)).To(Equal([]string{"a", "b"}))
+			Expect(first.Continue).NotTo(BeEmpty())
+			Expect(first.RemainingItemCount).To(HaveValue(BeEquivalentTo(3)))
+
+			// when
+			second := restworkspacesv1alpha1.WorkspaceList{}
+			err = rc.ListUserWorkspaces(ctx, user, &second, client.Limit(2), client.Continue(first.Continue))
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(workspaceNames(second)).To(Equal([]string{"c", "d"}))
+			Expect(second.Continue).NotTo(BeEmpty())
+			Expect(second.RemainingItemCount).To(HaveValue(BeEquivalentTo(1)))
+
+			// when
+			last := restworkspacesv1alpha1.WorkspaceList{}
+			err = rc.ListUserWorkspaces(ctx, user, &last, client.Limit(2), client.Continue(second.Continue))
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(workspaceNames(last)).To(Equal([]string{"e"}))
+			Expect(last.Continue).To(BeEmpty())
+			Expect(last.RemainingItemCount).To(BeNil())
+		})
+
+		It("continues after the last returned workspace even if it has been deleted", func() {
+			// given
+			expectList(names...)
+			expectList("a", "c", "d", "e")
+
+			first := restworkspacesv1alpha1.WorkspaceList{}
+			Expect(rc.ListUserWorkspaces(ctx, user, &first, client.Limit(2))).To(Succeed())
+
+			// when
+			second := restworkspacesv1alpha1.WorkspaceList{}
+			err := rc.ListUserWorkspaces(ctx, user, &second, client.Limit(2), client.Continue(first.Continue))
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(workspaceNames(second)).To(Equal([]string{"c", "d"}))
+		})
+
+		It("returns BadRequest if the continue token is invalid", func() {
+			// given
+			expectList(names...)
+
+			// when
+			ww := restworkspacesv1alpha1.WorkspaceList{}
+			err := rc.ListUserWorkspaces(ctx, user, &ww, client.Continue("not-a-valid-token"))
+
+			// then
+			Expect(err).To(MatchError(kerrors.IsBadRequest, "IsBadRequest"))
+		})
+	})
+
+	Describe("Field selectors", func() {
+		internalWorkspaces := []workspacesv1alpha1.InternalWorkspace{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "owned-private"},
+				Spec: workspacesv1alpha1.InternalWorkspaceSpec{
+					Visibility: workspacesv1alpha1.InternalWorkspaceVisibilityPrivate,
+					Owner:      workspacesv1alpha1.UserInfo{JwtInfo: workspacesv1alpha1.JwtInfo{Email: "user@example.com"}},
+				},
+				Status: workspacesv1alpha1.InternalWorkspaceStatus{
+					Owner: workspacesv1alpha1.UserInfoStatus{Username: user},
+					Space: workspacesv1alpha1.SpaceInfo{Name: "owned-private"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "shared-private"},
+				Spec: workspacesv1alpha1.InternalWorkspaceSpec{
+					Visibility: workspacesv1alpha1.InternalWorkspaceVisibilityPrivate,
+					Owner:      workspacesv1alpha1.UserInfo{JwtInfo: workspacesv1alpha1.JwtInfo{Email: "other@example.com"}},
+				},
+				Status: workspacesv1alpha1.InternalWorkspaceStatus{
+					Owner: workspacesv1alpha1.UserInfoStatus{Username: "other"},
+					Space: workspacesv1alpha1.SpaceInfo{Name: "shared-private"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "other-community"},
+				Spec: workspacesv1alpha1.InternalWorkspaceSpec{
+					Visibility: workspacesv1alpha1.InternalWorkspaceVisibilityCommunity,
+					Owner:      workspacesv1alpha1.UserInfo{JwtInfo: workspacesv1alpha1.JwtInfo{Email: "other@example.com"}},
+				},
+				Status: workspacesv1alpha1.InternalWorkspaceStatus{
+					Owner: workspacesv1alpha1.UserInfoStatus{Username: "other"},
+					Space: workspacesv1alpha1.SpaceInfo{Name: "other-community"},
+				},
+			},
+		}
+
+		DescribeTable("filters the workspaces", func(selector string, expectedSpaces ...string) {
+			// given
+			frc.EXPECT().
+				ListAsUser(ctx, user, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, iww *workspacesv1alpha1.InternalWorkspaceList, _ ...client.ListOption) error {
+					iww.Items = internalWorkspaces
+					return nil
+				}).
+				Times(1)
+			frc.EXPECT().
+				UserHasDirectAccess(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(true, nil).
+				AnyTimes()
+			mp.EXPECT().
+				InternalWorkspaceListToWorkspaceList(gomock.Any()).
+				DoAndReturn(func(iww *workspacesv1alpha1.InternalWorkspaceList) (*restworkspacesv1alpha1.WorkspaceList, error) {
+					ww := restworkspacesv1alpha1.WorkspaceList{}
+					for _, w := range iww.Items {
+						ww.Items = append(ww.Items, restworkspacesv1alpha1.Workspace{
+							ObjectMeta: metav1.ObjectMeta{Name: w.Status.Space.Name, Namespace: w.Status.Owner.Username},
+						})
+					}
+					return &ww, nil
+				}).
+				Times(1)
+
+			// when
+			ww := restworkspacesv1alpha1.WorkspaceList{}
+			err := rc.ListUserWorkspaces(ctx, user, &ww, client.MatchingFieldsSelector{Selector: fields.ParseSelectorOrDie(selector)})
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			nn := []string{}
+			for _, w := range ww.Items {
+				nn = append(nn, w.Name)
+			}
+			Expect(nn).To(ConsistOf(expectedSpaces))
+		},
+			Entry("by owner", "metadata.namespace="+user, "owned-private"),
+			Entry("by other owners", "metadata.namespace!="+user, "shared-private", "other-community"),
+			Entry("by visibility", "spec.visibility=community", "other-community"),
+			Entry("by space name", "status.space.name=shared-private", "shared-private"),
+			Entry("by owner's email", "status.owner.email=other@example.com", "shared-private", "other-community"),
+			Entry("by multiple fields", "metadata.namespace!="+user+",spec.visibility=private", "shared-private"),
+		)
+
+		DescribeTable("selects the exact matches through the cache's indexes", func(selector string, expected []client.ListOption) {
+			// given
+			frc.EXPECT().
+				ListAsUser(ctx, user, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, _ *workspacesv1alpha1.InternalWorkspaceList, opts ...client.ListOption) error {
+					Expect(opts).To(ConsistOf(expected))
+					return nil
+				}).
+				Times(1)
+			mp.EXPECT().
+				InternalWorkspaceListToWorkspaceList(gomock.Any()).
+				Return(&restworkspacesv1alpha1.WorkspaceList{}, nil).
+				Times(1)
+
+			// when
+			ww := restworkspacesv1alpha1.WorkspaceList{}
+			err := rc.ListUserWorkspaces(ctx, user, &ww, client.MatchingFieldsSelector{Selector: fields.ParseSelectorOrDie(selector)})
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+		},
+			Entry("exact matches", "metadata.namespace="+user+",spec.visibility==private", []client.ListOption{
+				client.MatchingFieldsSelector{Selector: fields.AndSelectors(
+					fields.OneTermEqualSelector(icache.IndexKeyInternalWorkspaceOwnerUsername, user),
+					fields.OneTermEqualSelector(icache.IndexKeyInternalWorkspaceVisibility, "private"),
+				)},
+			}),
+			Entry("exact and non-exact matches", "metadata.namespace!="+user+",spec.visibility=private", []client.ListOption{
+				client.MatchingFieldsSelector{Selector: fields.AndSelectors(
+					fields.OneTermEqualSelector(icache.IndexKeyInternalWorkspaceVisibility, "private"),
+				)},
+			}),
+			Entry("non-exact matches", "metadata.namespace!="+user, nil),
+		)
+
+		It("returns BadRequest if the field is not supported", func() {
+			// given
+			frc.EXPECT().
+				ListAsUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Times(0)
+
+			// when
+			ww := restworkspacesv1alpha1.WorkspaceList{}
+			err := rc.ListUserWorkspaces(ctx, user, &ww, client.MatchingFields{"spec.owner": "whatever"})
 
 			// then
-			Expect(err).To(MatchError(fmt.Errorf("invalid label selector: key '%s' is reserved", internalLabel)))
+			Expect(err).To(MatchError(kerrors.IsBadRequest, "IsBadRequest"))
 		})
 	})
 })
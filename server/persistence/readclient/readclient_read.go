@@ -13,6 +13,7 @@ import (
 
 	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
 	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+	"github.com/konflux-workspaces/workspaces/server/telemetry"
 )
 
 var _ workspace.WorkspaceReader = &ReadClient{}
@@ -26,6 +27,9 @@ func (c *ReadClient) ReadUserWorkspace(
 	obj *restworkspacesv1alpha1.Workspace,
 	_ ...client.GetOption,
 ) error {
+	ctx, span := telemetry.StartSpan(ctx, "ReadClient.ReadUserWorkspace")
+	defer span.End()
+
 	l := log.FromContext(ctx).With("user", user, "owner", owner, "space", space)
 	var w workspacesv1alpha1.InternalWorkspace
 	key := clientinterface.SpaceKey{Owner: owner, Name: space}
@@ -0,0 +1,372 @@
+package readclient
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/watch"
+	toolscache "k8s.io/client-go/tools/cache"
+	"k8s.io/utils/set"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/konflux-workspaces/workspaces/server/core/workspace"
+	"github.com/konflux-workspaces/workspaces/server/log"
+	"github.com/konflux-workspaces/workspaces/server/persistence/mutate"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+	"github.com/konflux-workspaces/workspaces/server/telemetry"
+)
+
+var _ workspace.WorkspaceWatcher = &ReadClient{}
+
+// watchEventsBufferSize is the number of events buffered for each watcher
+const watchEventsBufferSize int = 100
+
+// WatchUserWorkspaces streams the events on the workspaces the user has access to.
+// Events are generated from the informers of the cache backing the ReadClient, so
+// the initial list of workspaces is notified as a sequence of ADDED events.
+// If a resourceVersion is provided, workspaces not changed since that version are
+// not notified in the initial list.
+// If a timeout is provided, the watch is stopped when it expires.
+func (c *ReadClient) WatchUserWorkspaces(
+	ctx context.Context,
+	user string,
+	opts ...client.ListOption,
+) (watch.Interface, error) {
+	ctx, span := telemetry.StartSpan(ctx, "ReadClient.WatchUserWorkspaces")
+	defer span.End()
+
+	gr := restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource()
+	if c.informers == nil {
+		return nil, kerrors.NewMethodNotSupported(gr, "watch")
+	}
+
+	// map list options
+	listOpts, err := mapListOptions(opts...)
+	if err != nil {
+		return nil, kerrors.NewBadRequest(err.Error())
+	}
+
+	// parse requested resource version
+	rv, err := parseResourceVersion(listOpts)
+	if err != nil {
+		return nil, kerrors.NewBadRequest(err.Error())
+	}
+
+	// retrieve informers
+	iwi, err := c.informers.GetInformer(ctx, &workspacesv1alpha1.InternalWorkspace{})
+	if err != nil {
+		return nil, kerrors.NewInternalError(fmt.Errorf("error retrieving the informer for workspaces: %w", err))
+	}
+	sbi, err := c.informers.GetInformer(ctx, &toolchainv1alpha1.SpaceBinding{})
+	if err != nil {
+		return nil, kerrors.NewInternalError(fmt.Errorf("error retrieving the informer for spacebindings: %w", err))
+	}
+
+	// start watching
+	w := &userWorkspacesWatcher{
+		ctx:             ctx,
+		client:          c,
+		user:            user,
+		listOpts:        listOpts,
+		resourceVersion: rv,
+		timeout:         parseTimeout(listOpts),
+		result:          make(chan watch.Event, watchEventsBufferSize),
+		done:            make(chan struct{}),
+		workspaces:      map[string]*workspacesv1alpha1.InternalWorkspace{},
+		visible:         set.New[string](),
+	}
+	if err := w.start(iwi, sbi); err != nil {
+		w.Stop()
+		return nil, kerrors.NewInternalError(fmt.Errorf("error watching workspaces for user %v: %w", user, err))
+	}
+	return w, nil
+}
+
+func parseResourceVersion(listOpts *client.ListOptions) (uint64, error) {
+	if listOpts.Raw == nil {
+		return 0, nil
+	}
+
+	// as for kube-apiserver, an empty or "0" resourceVersion means "any"
+	switch rv := listOpts.Raw.ResourceVersion; rv {
+	case "", "0":
+		return 0, nil
+	default:
+		v, err := strconv.ParseUint(rv, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid resource version %q", rv)
+		}
+		return v, nil
+	}
+}
+
+func parseTimeout(listOpts *client.ListOptions) time.Duration {
+	if listOpts.Raw == nil || listOpts.Raw.TimeoutSeconds == nil {
+		return 0
+	}
+	return time.Duration(*listOpts.Raw.TimeoutSeconds) * time.Second
+}
+
+type informerRegistration struct {
+	informer     cache.Informer
+	registration toolscache.ResourceEventHandlerRegistration
+}
+
+// userWorkspacesWatcher translates the events from the InternalWorkspace and SpaceBinding
+// informers into events on the Workspaces visible to a user.
+type userWorkspacesWatcher struct {
+	ctx             context.Context
+	client          *ReadClient
+	user            string
+	listOpts        *client.ListOptions
+	resourceVersion uint64
+	timeout         time.Duration
+
+	result   chan watch.Event
+	done     chan struct{}
+	stopOnce sync.Once
+
+	mu            sync.Mutex
+	stopped       bool
+	registrations []informerRegistration
+	// latest known version of the InternalWorkspaces, by name
+	workspaces map[string]*workspacesv1alpha1.InternalWorkspace
+	// names of the InternalWorkspaces the user has been notified about
+	visible set.Set[string]
+}
+
+var _ watch.Interface = &userWorkspacesWatcher{}
+
+// ResultChan implements watch.Interface
+func (w *userWorkspacesWatcher) ResultChan() <-chan watch.Event {
+	return w.result
+}
+
+// Stop implements watch.Interface
+func (w *userWorkspacesWatcher) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.done)
+
+		for _, r := range w.registrations {
+			if r.registration == nil {
+				continue
+			}
+			if err := r.informer.RemoveEventHandler(r.registration); err != nil {
+				log.FromContext(w.ctx).Error("error removing event handler", "error", err)
+			}
+		}
+
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		w.stopped = true
+		close(w.result)
+	})
+}
+
+func (w *userWorkspacesWatcher) start(workspacesInformer, spaceBindingsInformer cache.Informer) error {
+	iwr, err := workspacesInformer.AddEventHandler(toolscache.ResourceEventHandlerDetailedFuncs{
+		AddFunc:    w.onInternalWorkspaceAdd,
+		UpdateFunc: func(_, obj interface{}) { w.onInternalWorkspaceUpdate(obj) },
+		DeleteFunc: w.onInternalWorkspaceDelete,
+	})
+	if err != nil {
+		return err
+	}
+	w.registrations = append(w.registrations, informerRegistration{workspacesInformer, iwr})
+
+	sbr, err := spaceBindingsInformer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    w.onSpaceBindingEvent,
+		UpdateFunc: func(_, obj interface{}) { w.onSpaceBindingEvent(obj) },
+		DeleteFunc: w.onSpaceBindingEvent,
+	})
+	if err != nil {
+		return err
+	}
+	w.registrations = append(w.registrations, informerRegistration{spaceBindingsInformer, sbr})
+
+	// stop watching when the request is done or the timeout expires
+	var timer *time.Timer
+	var timeout <-chan time.Time
+	if w.timeout > 0 {
+		timer = time.NewTimer(w.timeout)
+		timeout = timer.C
+	}
+	go func() {
+		if timer != nil {
+			defer timer.Stop()
+		}
+
+		select {
+		case <-w.ctx.Done():
+			w.Stop()
+		case <-timeout:
+			w.Stop()
+		case <-w.done:
+		}
+	}()
+	return nil
+}
+
+func (w *userWorkspacesWatcher) onInternalWorkspaceAdd(obj interface{}, isInInitialList bool) {
+	iw, ok := obj.(*workspacesv1alpha1.InternalWorkspace)
+	if !ok {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.workspaces[iw.Name] = iw
+	// workspaces not changed since the requested resource version are already known to the user
+	w.notify(iw, false, isInInitialList && w.isKnownToUser(iw))
+}
+
+func (w *userWorkspacesWatcher) onInternalWorkspaceUpdate(obj interface{}) {
+	iw, ok := obj.(*workspacesv1alpha1.InternalWorkspace)
+	if !ok {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.workspaces[iw.Name] = iw
+	w.notify(iw, true, false)
+}
+
+func (w *userWorkspacesWatcher) onInternalWorkspaceDelete(obj interface{}) {
+	if d, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = d.Obj
+	}
+	iw, ok := obj.(*workspacesv1alpha1.InternalWorkspace)
+	if !ok {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.workspaces, iw.Name)
+	if w.visible.Has(iw.Name) {
+		w.visible.Delete(iw.Name)
+		w.send(watch.Deleted, iw)
+	}
+}
+
+// onSpaceBindingEvent re-evaluates the visibility of the workspace referred by a user's SpaceBinding
+func (w *userWorkspacesWatcher) onSpaceBindingEvent(obj interface{}) {
+	if d, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = d.Obj
+	}
+	sb, ok := obj.(*toolchainv1alpha1.SpaceBinding)
+	if !ok || sb.GetLabels()[toolchainv1alpha1.SpaceBindingMasterUserRecordLabelKey] != w.user {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	// InternalWorkspaces and Spaces share the same name
+	if iw, ok := w.workspaces[sb.Spec.Space]; ok {
+		w.notify(iw, false, false)
+	}
+}
+
+// notify sends the user the event resulting from the change of the InternalWorkspace.
+// If changed is true, a MODIFIED event is sent when the workspace stays visible.
+// If silent is true, no ADDED event is sent when the workspace becomes visible.
+// It must be invoked holding w.mu.
+func (w *userWorkspacesWatcher) notify(iw *workspacesv1alpha1.InternalWorkspace, changed, silent bool) {
+	wasVisible := w.visible.Has(iw.Name)
+	isVisible, err := w.isVisibleToUser(iw)
+	if err != nil {
+		w.sendError(err)
+		return
+	}
+
+	switch {
+	case isVisible && !wasVisible:
+		w.visible.Insert(iw.Name)
+		if !silent {
+			w.send(watch.Added, iw)
+		}
+	case isVisible && wasVisible && changed:
+		w.send(watch.Modified, iw)
+	case !isVisible && wasVisible:
+		w.visible.Delete(iw.Name)
+		w.send(watch.Deleted, iw)
+	}
+}
+
+// isVisibleToUser applies the same rules used for listing the workspaces
+func (w *userWorkspacesWatcher) isVisibleToUser(iw *workspacesv1alpha1.InternalWorkspace) (bool, error) {
+	// filter by namespace
+	if ns := w.listOpts.Namespace; ns != "" && iw.Status.Owner.Username != ns {
+		return false, nil
+	}
+
+	// filter by labels and fields
+	if !matchesListOpts(w.listOpts, iw.GetLabels()) || !matchesFieldSelector(w.listOpts, iw) {
+		return false, nil
+	}
+
+	// community workspaces are visible to everyone
+	if iw.Spec.Visibility == workspacesv1alpha1.InternalWorkspaceVisibilityCommunity {
+		return true, nil
+	}
+
+	// private workspaces are visible to users with a SpaceBinding
+	return w.client.internalClient.UserHasDirectAccess(w.ctx, w.user, iw.GetName())
+}
+
+func (w *userWorkspacesWatcher) isKnownToUser(iw *workspacesv1alpha1.InternalWorkspace) bool {
+	if w.resourceVersion == 0 {
+		return false
+	}
+
+	rv, err := strconv.ParseUint(iw.GetResourceVersion(), 10, 64)
+	return err == nil && rv <= w.resourceVersion
+}
+
+// send maps the InternalWorkspace to a Workspace and sends the event to the user.
+// It must be invoked holding w.mu.
+func (w *userWorkspacesWatcher) send(t watch.EventType, iw *workspacesv1alpha1.InternalWorkspace) {
+	ws, err := w.client.mapper.InternalWorkspaceToWorkspace(iw)
+	if err != nil {
+		w.sendError(err)
+		return
+	}
+
+	mutate.ApplyIsOwnerLabel(ws, w.user)
+	if err := mutate.ApplyHasDirectAccessLabel(w.ctx, w.client.internalClient, ws, w.user); err != nil {
+		w.sendError(err)
+		return
+	}
+
+	w.sendEvent(watch.Event{Type: t, Object: ws})
+}
+
+func (w *userWorkspacesWatcher) sendError(err error) {
+	log.FromContext(w.ctx).Error("error processing watch event", "user", w.user, "error", err)
+	s := kerrors.NewInternalError(fmt.Errorf("error processing events for user %v", w.user)).ErrStatus
+	w.sendEvent(watch.Event{Type: watch.Error, Object: &s})
+}
+
+func (w *userWorkspacesWatcher) sendEvent(e watch.Event) {
+	if w.stopped {
+		return
+	}
+
+	select {
+	case w.result <- e:
+	case <-w.done:
+	}
+}
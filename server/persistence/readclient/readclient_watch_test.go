@@ -0,0 +1,331 @@
+package readclient_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+
+	"github.com/konflux-workspaces/workspaces/server/persistence/mapper"
+	"github.com/konflux-workspaces/workspaces/server/persistence/readclient"
+	"github.com/konflux-workspaces/workspaces/server/persistence/readclient/mocks"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("Watch", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var ctrl *gomock.Controller
+	var frc *mocks.MockFakeIWReadClient
+	var informers *informertest.FakeInformers
+	var workspacesInformer *controllertest.FakeInformer
+	var spaceBindingsInformer *controllertest.FakeInformer
+	var rc *readclient.ReadClient
+	var directAccess map[string]bool
+
+	user := "user"
+	owner := "owner"
+
+	buildInternalWorkspace := func(name string, visibility workspacesv1alpha1.InternalWorkspaceVisibility, resourceVersion string) *workspacesv1alpha1.InternalWorkspace {
+		return &workspacesv1alpha1.InternalWorkspace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       "workspaces-system",
+				ResourceVersion: resourceVersion,
+			},
+			Spec: workspacesv1alpha1.InternalWorkspaceSpec{
+				DisplayName: name,
+				Visibility:  visibility,
+			},
+			Status: workspacesv1alpha1.InternalWorkspaceStatus{
+				Owner: workspacesv1alpha1.UserInfoStatus{Username: owner},
+				Space: workspacesv1alpha1.SpaceInfo{Name: name},
+			},
+		}
+	}
+
+	buildSpaceBinding := func(space string) *toolchainv1alpha1.SpaceBinding {
+		return &toolchainv1alpha1.SpaceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      space + "-" + user,
+				Namespace: "toolchain-host-operator",
+				Labels: map[string]string{
+					toolchainv1alpha1.SpaceBindingMasterUserRecordLabelKey: user,
+					toolchainv1alpha1.SpaceBindingSpaceLabelKey:            space,
+				},
+			},
+			Spec: toolchainv1alpha1.SpaceBindingSpec{
+				MasterUserRecord: user,
+				Space:            space,
+				SpaceRole:        "contributor",
+			},
+		}
+	}
+
+	expectEvent := func(w watch.Interface, eventType watch.EventType, name string) {
+		GinkgoHelper()
+		var e watch.Event
+		Eventually(w.ResultChan()).Should(Receive(&e))
+		Expect(e.Type).To(Equal(eventType))
+		ws, ok := e.Object.(*restworkspacesv1alpha1.Workspace)
+		Expect(ok).To(BeTrue())
+		Expect(ws.Name).To(Equal(name))
+		Expect(ws.Namespace).To(Equal(owner))
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		ctrl = gomock.NewController(GinkgoT())
+		frc = mocks.NewMockFakeIWReadClient(ctrl)
+		directAccess = map[string]bool{}
+		frc.EXPECT().
+			UserHasDirectAccess(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, space string) (bool, error) {
+				return directAccess[space], nil
+			}).
+			AnyTimes()
+
+		scheme := runtime.NewScheme()
+		Expect(workspacesv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(toolchainv1alpha1.AddToScheme(scheme)).To(Succeed())
+		informers = &informertest.FakeInformers{Scheme: scheme}
+
+		var err error
+		workspacesInformer, err = informers.FakeInformerFor(ctx, &workspacesv1alpha1.InternalWorkspace{})
+		Expect(err).NotTo(HaveOccurred())
+		spaceBindingsInformer, err = informers.FakeInformerFor(ctx, &toolchainv1alpha1.SpaceBinding{})
+		Expect(err).NotTo(HaveOccurred())
+
+		rc = readclient.NewWithInformers(frc, mapper.Default, informers)
+	})
+
+	AfterEach(func() {
+		cancel()
+		ctrl.Finish()
+	})
+
+	It("is not supported if no informers are provided", func() {
+		// given
+		rc = readclient.New(frc, mapper.Default)
+
+		// when
+		_, err := rc.WatchUserWorkspaces(ctx, user)
+
+		// then
+		Expect(kerrors.IsMethodNotSupported(err)).To(BeTrue())
+	})
+
+	It("refuses invalid resource versions", func() {
+		// when
+		_, err := rc.WatchUserWorkspaces(ctx, user, &client.ListOptions{Raw: &metav1.ListOptions{ResourceVersion: "not-a-version"}})
+
+		// then
+		Expect(kerrors.IsBadRequest(err)).To(BeTrue())
+	})
+
+	It("refuses label selectors on internal labels", func() {
+		// when
+		_, err := rc.WatchUserWorkspaces(ctx, user, client.MatchingLabels{restworkspacesv1alpha1.LabelIsOwner: "true"})
+
+		// then
+		Expect(kerrors.IsBadRequest(err)).To(BeTrue())
+	})
+
+	It("streams events for community workspaces", func() {
+		// given
+		w, err := rc.WatchUserWorkspaces(ctx, user)
+		Expect(err).NotTo(HaveOccurred())
+		defer w.Stop()
+		iw := buildInternalWorkspace("community", workspacesv1alpha1.InternalWorkspaceVisibilityCommunity, "1")
+		uiw := iw.DeepCopy()
+		uiw.ResourceVersion = "2"
+
+		// when
+		workspacesInformer.Add(iw)
+		workspacesInformer.Update(iw, uiw)
+		workspacesInformer.Delete(uiw)
+
+		// then
+		expectEvent(w, watch.Added, iw.Name)
+		expectEvent(w, watch.Modified, iw.Name)
+		expectEvent(w, watch.Deleted, iw.Name)
+	})
+
+	It("does not stream events for private workspaces the user has no access to", func() {
+		// given
+		w, err := rc.WatchUserWorkspaces(ctx, user)
+		Expect(err).NotTo(HaveOccurred())
+		defer w.Stop()
+		piw := buildInternalWorkspace("private", workspacesv1alpha1.InternalWorkspaceVisibilityPrivate, "1")
+		ciw := buildInternalWorkspace("community", workspacesv1alpha1.InternalWorkspaceVisibilityCommunity, "2")
+
+		// when
+		workspacesInformer.Add(piw)
+		workspacesInformer.Add(ciw)
+
+		// then
+		expectEvent(w, watch.Added, ciw.Name)
+		Consistently(w.ResultChan()).ShouldNot(Receive())
+	})
+
+	It("streams events for private workspaces the user is granted access to", func() {
+		// given
+		w, err := rc.WatchUserWorkspaces(ctx, user)
+		Expect(err).NotTo(HaveOccurred())
+		defer w.Stop()
+		iw := buildInternalWorkspace("private", workspacesv1alpha1.InternalWorkspaceVisibilityPrivate, "1")
+		sb := buildSpaceBinding(iw.Name)
+		workspacesInformer.Add(iw)
+
+		// when
+		directAccess[iw.Name] = true
+		spaceBindingsInformer.Add(sb)
+
+		// then
+		expectEvent(w, watch.Added, iw.Name)
+
+		// when
+		directAccess[iw.Name] = false
+		spaceBindingsInformer.Delete(sb)
+
+		// then
+		expectEvent(w, watch.Deleted, iw.Name)
+	})
+
+	It("notifies a DELETED event when a workspace is made private", func() {
+		// given
+		w, err := rc.WatchUserWorkspaces(ctx, user)
+		Expect(err).NotTo(HaveOccurred())
+		defer w.Stop()
+		iw := buildInternalWorkspace("community", workspacesv1alpha1.InternalWorkspaceVisibilityCommunity, "1")
+		uiw := iw.DeepCopy()
+		uiw.Spec.Visibility = workspacesv1alpha1.InternalWorkspaceVisibilityPrivate
+		uiw.ResourceVersion = "2"
+
+		// when
+		workspacesInformer.Add(iw)
+		workspacesInformer.Update(iw, uiw)
+
+		// then
+		expectEvent(w, watch.Added, iw.Name)
+		expectEvent(w, watch.Deleted, iw.Name)
+	})
+
+	It("filters events by namespace", func() {
+		// given
+		w, err := rc.WatchUserWorkspaces(ctx, user, client.InNamespace("not-the-owner"))
+		Expect(err).NotTo(HaveOccurred())
+		defer w.Stop()
+		iw := buildInternalWorkspace("community", workspacesv1alpha1.InternalWorkspaceVisibilityCommunity, "1")
+
+		// when
+		workspacesInformer.Add(iw)
+
+		// then
+		Consistently(w.ResultChan()).ShouldNot(Receive())
+	})
+
+	It("filters events by labels", func() {
+		// given
+		w, err := rc.WatchUserWorkspaces(ctx, user, client.MatchingLabels{"team": "platform"})
+		Expect(err).NotTo(HaveOccurred())
+		defer w.Stop()
+		iw := buildInternalWorkspace("labeled", workspacesv1alpha1.InternalWorkspaceVisibilityCommunity, "1")
+		iw.Labels = map[string]string{"team": "platform"}
+		oiw := buildInternalWorkspace("other", workspacesv1alpha1.InternalWorkspaceVisibilityCommunity, "2")
+		oiw.Labels = map[string]string{"team": "other"}
+
+		// when
+		workspacesInformer.Add(oiw)
+		workspacesInformer.Add(iw)
+
+		// then
+		expectEvent(w, watch.Added, iw.Name)
+		Consistently(w.ResultChan()).ShouldNot(Receive())
+	})
+
+	It("filters out unlabeled workspaces when selecting by labels", func() {
+		// given
+		w, err := rc.WatchUserWorkspaces(ctx, user, client.MatchingLabels{"team": "platform"})
+		Expect(err).NotTo(HaveOccurred())
+		defer w.Stop()
+		iw := buildInternalWorkspace("unlabeled", workspacesv1alpha1.InternalWorkspaceVisibilityCommunity, "1")
+
+		// when
+		workspacesInformer.Add(iw)
+
+		// then
+		Consistently(w.ResultChan()).ShouldNot(Receive())
+	})
+
+	It("filters events by fields", func() {
+		// given
+		directAccess["private"] = true
+		w, err := rc.WatchUserWorkspaces(ctx, user, client.MatchingFields{"spec.visibility": string(workspacesv1alpha1.InternalWorkspaceVisibilityPrivate)})
+		Expect(err).NotTo(HaveOccurred())
+		defer w.Stop()
+		piw := buildInternalWorkspace("private", workspacesv1alpha1.InternalWorkspaceVisibilityPrivate, "1")
+		ciw := buildInternalWorkspace("community", workspacesv1alpha1.InternalWorkspaceVisibilityCommunity, "2")
+		uciw := ciw.DeepCopy()
+		uciw.Spec.Visibility = workspacesv1alpha1.InternalWorkspaceVisibilityPrivate
+		uciw.ResourceVersion = "3"
+
+		// when
+		workspacesInformer.Add(ciw)
+		workspacesInformer.Add(piw)
+
+		// then
+		expectEvent(w, watch.Added, piw.Name)
+		Consistently(w.ResultChan()).ShouldNot(Receive())
+
+		// when
+		directAccess[ciw.Name] = true
+		workspacesInformer.Update(ciw, uciw)
+
+		// then
+		expectEvent(w, watch.Added, ciw.Name)
+	})
+
+	It("refuses unsupported field selectors", func() {
+		// when
+		_, err := rc.WatchUserWorkspaces(ctx, user, client.MatchingFields{"spec.displayName": "foo"})
+
+		// then
+		Expect(kerrors.IsBadRequest(err)).To(BeTrue())
+	})
+
+	It("closes the result channel when the timeout expires", func() {
+		// given
+		timeout := int64(1)
+		w, err := rc.WatchUserWorkspaces(ctx, user, &client.ListOptions{Raw: &metav1.ListOptions{TimeoutSeconds: &timeout}})
+		Expect(err).NotTo(HaveOccurred())
+
+		// then
+		Consistently(w.ResultChan(), "500ms").ShouldNot(BeClosed())
+		Eventually(w.ResultChan(), "2s").Should(BeClosed())
+	})
+
+	It("closes the result channel when the context is done", func() {
+		// given
+		w, err := rc.WatchUserWorkspaces(ctx, user)
+		Expect(err).NotTo(HaveOccurred())
+
+		// when
+		cancel()
+
+		// then
+		Eventually(w.ResultChan()).Should(BeClosed())
+	})
+})
@@ -0,0 +1,104 @@
+//go:build envtest
+
+package envtest_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	"github.com/konflux-workspaces/workspaces/server/persistence/iwclient"
+	"github.com/konflux-workspaces/workspaces/server/persistence/readclient"
+	"github.com/konflux-workspaces/workspaces/server/persistence/writeclient"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+)
+
+// These tests run the WriteClient against a real API Server provided by envtest.
+// They are built with the `envtest` build tag only and require the envtest binaries:
+// run `make test` to download them and run the suite.
+
+const (
+	workspacesNamespace = "workspaces-system"
+	kubesawNamespace    = "toolchain-host"
+)
+
+var (
+	ctx       context.Context
+	cancel    context.CancelFunc
+	testEnv   *envtest.Environment
+	cfg       *rest.Config
+	k8sClient client.Client
+	reader    *readclient.ReadClient
+	writer    *writeclient.WriteClient
+)
+
+func TestEnvtest(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "WriteClient Envtest Suite")
+}
+
+var _ = BeforeSuite(func() {
+	Expect(os.Getenv("KUBEBUILDER_ASSETS")).NotTo(BeEmpty(), "KUBEBUILDER_ASSETS is not set, run `make test`")
+
+	ctx, cancel = context.WithCancel(context.Background())
+
+	By("bootstrapping test environment")
+	testEnv = &envtest.Environment{
+		CRDDirectoryPaths: []string{
+			filepath.Join("..", "..", "..", "..", "operator", "config", "crd", "bases"),
+			filepath.Join("..", "..", "..", "..", "e2e", "assets", "crd", "toolchain"),
+		},
+		ErrorIfCRDPathMissing: true,
+	}
+
+	var err error
+	cfg, err = testEnv.Start()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(cfg).NotTo(BeNil())
+
+	s := runtime.NewScheme()
+	Expect(corev1.AddToScheme(s)).To(Succeed())
+	Expect(toolchainv1alpha1.AddToScheme(s)).To(Succeed())
+	Expect(workspacesv1alpha1.AddToScheme(s)).To(Succeed())
+	k8sClient, err = client.New(cfg, client.Options{Scheme: s})
+	Expect(err).NotTo(HaveOccurred())
+
+	for _, ns := range []string{workspacesNamespace, kubesawNamespace} {
+		Expect(k8sClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}})).To(Succeed())
+	}
+
+	By("setting up the read and write models")
+	r, c, err := readclient.NewDefaultWithCache(ctx, cfg, workspacesNamespace, kubesawNamespace)
+	Expect(err).NotTo(HaveOccurred())
+	go func() {
+		defer GinkgoRecover()
+		Expect(c.Start(ctx)).To(Succeed())
+	}()
+	Expect(c.WaitForCacheSync(ctx)).To(BeTrue())
+
+	reader = r
+	writer = writeclient.NewWithConfig(cfg, workspacesNamespace, iwclient.New(c, workspacesNamespace, kubesawNamespace))
+})
+
+var _ = AfterSuite(func() {
+	if testEnv == nil {
+		return
+	}
+
+	By("tearing down the test environment")
+	cancel()
+	Expect(testEnv.Stop()).To(Succeed())
+})
@@ -0,0 +1,162 @@
+//go:build envtest
+
+package envtest_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("Updating workspaces", Ordered, func() {
+	owner := "owner"
+	other := "other"
+
+	var community, private *workspacesv1alpha1.InternalWorkspace
+
+	BeforeAll(func() {
+		createUser(owner)
+		createUser(other)
+		community = createInternalWorkspace(owner, "community", workspacesv1alpha1.InternalWorkspaceVisibilityCommunity)
+		private = createInternalWorkspace(owner, "private", workspacesv1alpha1.InternalWorkspaceVisibilityPrivate)
+		grantAccess(other, private, "viewer")
+	})
+
+	// readAs reads the workspace as the given user, waiting for the cache to be in sync
+	readAs := func(user string, iw *workspacesv1alpha1.InternalWorkspace) *restworkspacesv1alpha1.Workspace {
+		w := restworkspacesv1alpha1.Workspace{}
+		Eventually(func() error {
+			return reader.ReadUserWorkspace(ctx, user, owner, iw.Spec.DisplayName, &w)
+		}).Should(Succeed())
+		return &w
+	}
+
+	visibilityOf := func(iw *workspacesv1alpha1.InternalWorkspace) workspacesv1alpha1.InternalWorkspaceVisibility {
+		c := workspacesv1alpha1.InternalWorkspace{}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(iw), &c)).To(Succeed())
+		return c.Spec.Visibility
+	}
+
+	It("should not allow a non-owner to flip the visibility of a community workspace", func() {
+		// given
+		w := readAs(other, community)
+		w.Spec.Visibility = restworkspacesv1alpha1.WorkspaceVisibilityPrivate
+
+		// when
+		err := writer.UpdateUserWorkspace(ctx, other, w)
+
+		// then
+		Expect(kerrors.IsForbidden(err)).To(BeTrue(), "unexpected error: %v", err)
+		Expect(visibilityOf(community)).To(Equal(workspacesv1alpha1.InternalWorkspaceVisibilityCommunity))
+	})
+
+	It("should not allow a non-owner to flip the visibility of a private workspace shared with them", func() {
+		// given
+		w := readAs(other, private)
+		w.Spec.Visibility = restworkspacesv1alpha1.WorkspaceVisibilityCommunity
+
+		// when
+		err := writer.UpdateUserWorkspace(ctx, other, w)
+
+		// then
+		Expect(kerrors.IsForbidden(err)).To(BeTrue(), "unexpected error: %v", err)
+		Expect(visibilityOf(private)).To(Equal(workspacesv1alpha1.InternalWorkspaceVisibilityPrivate))
+	})
+
+	It("should reject an invalid workspace in dry run", func() {
+		// given
+		w := readAs(owner, private)
+		w.Spec.Visibility = "not-a-visibility"
+
+		// when
+		err := writer.UpdateUserWorkspace(ctx, owner, w, client.DryRunAll)
+
+		// then
+		Expect(kerrors.IsInvalid(err)).To(BeTrue(), "unexpected error: %v", err)
+		Expect(visibilityOf(private)).To(Equal(workspacesv1alpha1.InternalWorkspaceVisibilityPrivate))
+	})
+
+	It("should allow the owner to flip the visibility of their workspace", func() {
+		// given
+		w := readAs(owner, private)
+		w.Spec.Visibility = restworkspacesv1alpha1.WorkspaceVisibilityCommunity
+
+		// when
+		err := writer.UpdateUserWorkspace(ctx, owner, w)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(visibilityOf(private)).To(Equal(workspacesv1alpha1.InternalWorkspaceVisibilityCommunity))
+	})
+})
+
+func createUser(name string) {
+	u := toolchainv1alpha1.UserSignup{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: kubesawNamespace},
+		Spec: toolchainv1alpha1.UserSignupSpec{
+			IdentityClaims: toolchainv1alpha1.IdentityClaimsEmbedded{
+				PreferredUsername: name,
+				PropagatedClaims: toolchainv1alpha1.PropagatedClaims{
+					Sub:    name + "-sub",
+					Email:  name + "@example.com",
+					UserID: name + "-id",
+				},
+			},
+		},
+	}
+	Expect(k8sClient.Create(ctx, &u)).To(Succeed())
+
+	u.Status.CompliantUsername = name
+	Expect(k8sClient.Status().Update(ctx, &u)).To(Succeed())
+}
+
+func createInternalWorkspace(owner, displayName string, visibility workspacesv1alpha1.InternalWorkspaceVisibility) *workspacesv1alpha1.InternalWorkspace {
+	iw := workspacesv1alpha1.InternalWorkspace{
+		ObjectMeta: metav1.ObjectMeta{GenerateName: displayName + "-", Namespace: workspacesNamespace},
+		Spec: workspacesv1alpha1.InternalWorkspaceSpec{
+			DisplayName: displayName,
+			Visibility:  visibility,
+			Owner: workspacesv1alpha1.UserInfo{
+				JwtInfo: workspacesv1alpha1.JwtInfo{
+					Email:  owner + "@example.com",
+					Sub:    owner + "-sub",
+					UserId: owner + "-id",
+				},
+			},
+		},
+	}
+	Expect(k8sClient.Create(ctx, &iw)).To(Succeed())
+
+	iw.Status = workspacesv1alpha1.InternalWorkspaceStatus{
+		Space: workspacesv1alpha1.SpaceInfo{Name: iw.Name},
+		Owner: workspacesv1alpha1.UserInfoStatus{Username: owner},
+	}
+	Expect(k8sClient.Status().Update(ctx, &iw)).To(Succeed())
+	return &iw
+}
+
+func grantAccess(user string, iw *workspacesv1alpha1.InternalWorkspace, role string) {
+	sb := toolchainv1alpha1.SpaceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      iw.Name + "-" + user,
+			Namespace: kubesawNamespace,
+			Labels: map[string]string{
+				toolchainv1alpha1.SpaceBindingSpaceLabelKey:            iw.Name,
+				toolchainv1alpha1.SpaceBindingMasterUserRecordLabelKey: user,
+			},
+		},
+		Spec: toolchainv1alpha1.SpaceBindingSpec{
+			MasterUserRecord: user,
+			Space:            iw.Name,
+			SpaceRole:        role,
+		},
+	}
+	Expect(k8sClient.Create(ctx, &sb)).To(Succeed())
+}
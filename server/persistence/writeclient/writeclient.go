@@ -1,6 +1,9 @@
 package writeclient
 
 import (
+	"fmt"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -11,8 +14,8 @@ import (
 	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
 )
 
-// BuildClientFunc defines a function that builds a controller-runtime client
-// that impersonates the given user
+// BuildClientFunc defines a function that builds the controller-runtime client
+// used to perform the writes requested by the given user
 type BuildClientFunc func(user string) (client.Client, error)
 
 // WriteClient implements Write primitives on Workspaces.
@@ -38,13 +41,12 @@ func NewWithConfig(config *rest.Config, workspacesNamespace string, workspacesRe
 }
 
 // BuildBuildClientFuncForConfig provides a configured BuildClientFunc for building a controller-runtime client
-// for a given cluster and impersonating an user
+// for a given cluster. The client acts with the server's own credentials, as users are not granted any
+// permission on InternalWorkspaces: the WriteClient authorizes each mutation with its policies.
 func BuildBuildClientFuncForConfig(config *rest.Config) BuildClientFunc {
 	newConfig := rest.CopyConfig(config)
 
-	return func(user string) (client.Client, error) {
-		// newConfig.Impersonate.UserName = user
-
+	return func(_ string) (client.Client, error) {
 		s := runtime.NewScheme()
 		if err := restworkspacesv1alpha1.AddToScheme(s); err != nil {
 			return nil, err
@@ -56,3 +58,17 @@ func BuildBuildClientFuncForConfig(config *rest.Config) BuildClientFunc {
 		return client.New(newConfig, client.Options{Scheme: s})
 	}
 }
+
+// checkResourceVersion returns a Conflict error if the InternalWorkspace `iw` was not mapped from
+// the current version `ciw` of the InternalWorkspace. An empty resourceVersion requests an unconditional
+// update: the REST API only allows it when explicitly requested with the `If-Match: *` header.
+func checkResourceVersion(iw, ciw *workspacesv1alpha1.InternalWorkspace, name string) error {
+	if iw.ResourceVersion == "" || iw.ResourceVersion == ciw.ResourceVersion {
+		return nil
+	}
+
+	return kerrors.NewConflict(
+		restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource(),
+		name,
+		fmt.Errorf("the object has been modified; please apply your changes to the latest version and try again"))
+}
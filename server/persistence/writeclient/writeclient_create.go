@@ -2,7 +2,11 @@ package writeclient
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/konflux-workspaces/workspaces/server/core/workspace"
@@ -10,13 +14,63 @@ import (
 	"github.com/konflux-workspaces/workspaces/server/persistence/mapper"
 	"github.com/konflux-workspaces/workspaces/server/persistence/mutate"
 
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
 	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+	"github.com/konflux-workspaces/workspaces/server/telemetry"
 )
 
 var _ workspace.WorkspaceCreator = &WriteClient{}
 
+// generatedNameSuffixLength is the length of the random suffix the API Server appends to a GenerateName
+const generatedNameSuffixLength = 5
+
+// MaxWorkspaceNameLength is the maximum length of the name of a new workspace.
+// The InternalWorkspace and the Space are named after the workspace, followed by "-" and
+// the random suffix, and the Space name must be a valid DNS-1123 label.
+const MaxWorkspaceNameLength = validation.DNS1123LabelMaxLength - len("-") - generatedNameSuffixLength
+
 // CreateUserWorkspace creates as `user` the InternalWorkspace representing the provided Workspace
 func (c *WriteClient) CreateUserWorkspace(ctx context.Context, user string, workspace *restworkspacesv1alpha1.Workspace, opts ...client.CreateOption) error {
+	ctx, span := telemetry.StartSpan(ctx, "WriteClient.CreateUserWorkspace")
+	defer span.End()
+
+	gr := restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource()
+
+	// users are allowed to create workspaces only in their own namespace
+	if workspace.Namespace != user {
+		return kerrors.NewForbidden(gr, workspace.Name, fmt.Errorf("user %s can not create workspaces in namespace %s", user, workspace.Namespace))
+	}
+
+	// the workspace name is used as GenerateName for the InternalWorkspace and the Space
+	if errs := validation.IsDNS1123Label(workspace.Name); len(errs) > 0 {
+		return kerrors.NewBadRequest(fmt.Sprintf("invalid workspace name %q: %s", workspace.Name, strings.Join(errs, ", ")))
+	}
+	if len(workspace.Name) > MaxWorkspaceNameLength {
+		return kerrors.NewBadRequest(fmt.Sprintf("invalid workspace name %q: %s", workspace.Name, validation.MaxLenError(MaxWorkspaceNameLength)))
+	}
+
+	// validate user labels and annotations
+	if err := validateUserMetadata(workspace); err != nil {
+		return err
+	}
+
+	// workspace names are unique per owner.
+	// The check is performed on the cache, so concurrent requests may both pass it:
+	// the operator re-checks the uniqueness and refuses to provision the newest InternalWorkspace.
+	inUse, err := c.workspacesReader.IsDisplayNameInUse(ctx, user, workspace.Name)
+	if err != nil {
+		return kerrors.NewInternalError(err)
+	}
+	if inUse {
+		return kerrors.NewAlreadyExists(gr, workspace.Name)
+	}
+
+	// retrieve owner's information
+	u := toolchainv1alpha1.UserSignup{}
+	if err := c.workspacesReader.GetUserSignupByComplaintName(ctx, user, &u); err != nil {
+		return kerrors.NewInternalError(fmt.Errorf("error retrieving UserSignup for user %s: %w", user, err))
+	}
+
 	cli, err := c.buildClient(user)
 	if err != nil {
 		return err
@@ -29,10 +83,14 @@ func (c *WriteClient) CreateUserWorkspace(ctx context.Context, user string, work
 	}
 	iw.SetNamespace(c.workspacesNamespace)
 	iw.SetName("")
-	iw.SetGenerateName(workspace.Name)
+	iw.SetGenerateName(workspace.Name + "-")
+	iw.Spec.Owner.JwtInfo.Sub = u.Spec.IdentityClaims.Sub
+	iw.Spec.Owner.JwtInfo.Email = u.Spec.IdentityClaims.Email
+	iw.Spec.Owner.JwtInfo.UserId = u.Spec.IdentityClaims.UserID
 
-	// create InternalWorkspace
-	log.FromContext(ctx).Debug("creating user workspace", "workspace", workspace, "user", user)
+	// create InternalWorkspace.
+	// Dry runs are forwarded to the API Server too, so that the InternalWorkspace is validated and admitted
+	log.FromContext(ctx).Debug("creating user workspace", "workspace", workspace, "user", user, "dryRun", (&client.CreateOptions{}).ApplyOptions(opts).DryRun)
 	if err := cli.Create(ctx, iw, opts...); err != nil {
 		return err
 	}
@@ -42,6 +100,8 @@ func (c *WriteClient) CreateUserWorkspace(ctx context.Context, user string, work
 	if err != nil {
 		return err
 	}
+	// the status is not yet populated by the operator, so restore the owner
+	w.SetNamespace(user)
 
 	// apply the is-owner label
 	mutate.ApplyIsOwnerLabel(w, user)
@@ -3,17 +3,24 @@ package writeclient_test
 import (
 	"context"
 	"slices"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
+	"github.com/konflux-workspaces/workspaces/server/persistence/internal/cache"
 	"github.com/konflux-workspaces/workspaces/server/persistence/iwclient"
 	"github.com/konflux-workspaces/workspaces/server/persistence/writeclient"
 
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
 	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
 	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
 )
@@ -22,20 +29,28 @@ var _ = Describe("WriteclientCreate", func() {
 	var ctx context.Context
 	var fakeClient client.WithWatch
 	var cli *writeclient.WriteClient
+	var workspace restworkspacesv1alpha1.Workspace
 
-	user := "foo"
+	user := "owner"
 	namespace := "bar"
-	workspace := restworkspacesv1alpha1.Workspace{
+	kubesawNamespace := "toolchain-host"
+	userSignup := toolchainv1alpha1.UserSignup{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: "owner",
-			Name:      "workspace-foo",
+			Name:      user,
+			Namespace: kubesawNamespace,
 		},
-		Spec: restworkspacesv1alpha1.WorkspaceSpec{},
-		Status: restworkspacesv1alpha1.WorkspaceStatus{
-			Space: &restworkspacesv1alpha1.SpaceInfo{
-				Name: "space",
+		Spec: toolchainv1alpha1.UserSignupSpec{
+			IdentityClaims: toolchainv1alpha1.IdentityClaimsEmbedded{
+				PropagatedClaims: toolchainv1alpha1.PropagatedClaims{
+					Sub:    "owner-sub",
+					Email:  "owner@email.com",
+					UserID: "owner-userid",
+				},
 			},
 		},
+		Status: toolchainv1alpha1.UserSignupStatus{
+			CompliantUsername: user,
+		},
 	}
 
 	validateCreatedInternalWorkspace := func(w *restworkspacesv1alpha1.Workspace, expectedVisibility workspacesv1alpha1.InternalWorkspaceVisibility) {
@@ -51,24 +66,55 @@ var _ = Describe("WriteclientCreate", func() {
 		Expect(ww.Items).To(Satisfy(func(ww []workspacesv1alpha1.InternalWorkspace) bool {
 			return slices.ContainsFunc(ww, func(lw workspacesv1alpha1.InternalWorkspace) bool {
 				return lw.Spec.DisplayName == w.Name &&
-					lw.Status.Owner.Username == "owner" &&
-					lw.Spec.Visibility == expectedVisibility
+					lw.Status.Owner.Username == user &&
+					lw.Spec.Visibility == expectedVisibility &&
+					lw.Spec.Owner.JwtInfo.Sub == userSignup.Spec.IdentityClaims.Sub &&
+					lw.Spec.Owner.JwtInfo.Email == userSignup.Spec.IdentityClaims.Email &&
+					lw.Spec.Owner.JwtInfo.UserId == userSignup.Spec.IdentityClaims.UserID
 			})
 		}))
 	}
 
-	BeforeEach(func() {
-		ctx = context.Background()
-		fakeClient = fake.NewFakeClient()
-		err := workspacesv1alpha1.AddToScheme(fakeClient.Scheme())
-		Expect(err).NotTo(HaveOccurred())
+	initializeCli := func(objs ...client.Object) {
+		scheme := runtime.NewScheme()
+		Expect(toolchainv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(workspacesv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		fcb := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(objs...)
+		for key, indexer := range cache.UserSignupIndexers {
+			fcb.WithIndex(&toolchainv1alpha1.UserSignup{}, key, indexer)
+		}
+		for key, indexer := range cache.InternalWorkspacesIndexers {
+			fcb.WithIndex(&workspacesv1alpha1.InternalWorkspace{}, key, indexer)
+		}
+		fakeClient = fcb.Build()
 
 		clientFunc := func(string) (client.Client, error) {
 			return fakeClient, nil
 		}
 
-		iwcli := iwclient.New(fakeClient, namespace, namespace)
+		iwcli := iwclient.New(fakeClient, namespace, kubesawNamespace)
 		cli = writeclient.New(clientFunc, namespace, iwcli)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		workspace = restworkspacesv1alpha1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: user,
+				Name:      "workspace-foo",
+			},
+			Spec: restworkspacesv1alpha1.WorkspaceSpec{},
+			Status: restworkspacesv1alpha1.WorkspaceStatus{
+				Space: &restworkspacesv1alpha1.SpaceInfo{
+					Name: "space",
+				},
+			},
+		}
+
+		initializeCli(userSignup.DeepCopy())
 	})
 
 	When("creating a community workspace", func() {
@@ -105,14 +151,223 @@ var _ = Describe("WriteclientCreate", func() {
 			workspace.Spec.Visibility = restworkspacesv1alpha1.WorkspaceVisibilityPrivate
 
 			// when
-			err := cli.CreateUserWorkspace(ctx, "owner", &workspace)
+			err := cli.CreateUserWorkspace(ctx, user, &workspace)
 
 			// then
 			Expect(err).NotTo(HaveOccurred())
+			Expect(workspace.Namespace).To(Equal(user))
 			Expect(workspace.Labels).To(And(
 				HaveKeyWithValue(restworkspacesv1alpha1.LabelIsOwner, "true"),
 				HaveKeyWithValue(restworkspacesv1alpha1.LabelHasDirectAccess, "true")))
 			validateCreatedInternalWorkspace(&workspace, workspacesv1alpha1.InternalWorkspaceVisibilityPrivate)
 		})
 	})
+
+	When("creating a workspace in another user's namespace", func() {
+		It("should fail with 403", func() {
+			// given
+			workspace.Spec.Visibility = restworkspacesv1alpha1.WorkspaceVisibilityPrivate
+
+			// when
+			err := cli.CreateUserWorkspace(ctx, "not-the-owner", &workspace)
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(kerrors.IsForbidden(err)).To(BeTrue())
+		})
+	})
+
+	When("creating a workspace in dry run", func() {
+		It("should return the workspace without creating it", func() {
+			// given
+			workspace.Spec.Visibility = restworkspacesv1alpha1.WorkspaceVisibilityPrivate
+
+			// when
+			err := cli.CreateUserWorkspace(ctx, user, &workspace, client.DryRunAll)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(workspace.Spec.Visibility).To(Equal(restworkspacesv1alpha1.WorkspaceVisibilityPrivate))
+			Expect(workspace.Status.Owner).NotTo(BeNil())
+			Expect(workspace.Status.Owner.Email).To(Equal("owner@email.com"))
+
+			ww := workspacesv1alpha1.InternalWorkspaceList{}
+			Expect(fakeClient.List(ctx, &ww, client.InNamespace(namespace))).To(Succeed())
+			Expect(ww.Items).To(BeEmpty())
+		})
+
+		It("should still validate the workspace", func() {
+			// given
+			workspace.Name = "Not_A_Valid_Name"
+
+			// when
+			err := cli.CreateUserWorkspace(ctx, user, &workspace, client.DryRunAll)
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(kerrors.IsBadRequest(err)).To(BeTrue())
+		})
+
+		It("should be rejected if the API Server rejects the workspace", func() {
+			// given
+			cli = writeclient.New(func(string) (client.Client, error) {
+				return interceptor.NewClient(fakeClient, visibilityValidatingFuncs()), nil
+			}, namespace, iwclient.New(fakeClient, namespace, kubesawNamespace))
+			workspace.Spec.Visibility = "not-a-visibility"
+
+			// when
+			err := cli.CreateUserWorkspace(ctx, user, &workspace, client.DryRunAll)
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(kerrors.IsInvalid(err)).To(BeTrue())
+		})
+	})
+
+	When("creating a workspace with an invalid name", func() {
+		It("should fail with 400", func() {
+			// given
+			workspace.Name = "Not_A_Valid_Name"
+
+			// when
+			err := cli.CreateUserWorkspace(ctx, user, &workspace)
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(kerrors.IsBadRequest(err)).To(BeTrue())
+		})
+	})
+
+	When("creating a workspace whose name leaves no room for the generated suffix", func() {
+		It("should fail with 400", func() {
+			// given
+			workspace.Name = strings.Repeat("a", writeclient.MaxWorkspaceNameLength+1)
+
+			// when
+			err := cli.CreateUserWorkspace(ctx, user, &workspace)
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(kerrors.IsBadRequest(err)).To(BeTrue())
+		})
+	})
+
+	When("creating a workspace with the longest allowed name", func() {
+		It("should create the workspace", func() {
+			// given
+			workspace.Name = strings.Repeat("a", writeclient.MaxWorkspaceNameLength)
+
+			// when
+			err := cli.CreateUserWorkspace(ctx, user, &workspace)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	When("creating a workspace with labels and annotations", func() {
+		It("should persist them", func() {
+			// given
+			workspace.Labels = map[string]string{"team": "platform"}
+			workspace.Annotations = map[string]string{"cost-center": "42"}
+
+			// when
+			err := cli.CreateUserWorkspace(ctx, user, &workspace)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(workspace.Labels).To(HaveKeyWithValue("team", "platform"))
+			Expect(workspace.Annotations).To(HaveKeyWithValue("cost-center", "42"))
+
+			ww := workspacesv1alpha1.InternalWorkspaceList{}
+			Expect(fakeClient.List(ctx, &ww, client.InNamespace(namespace))).To(Succeed())
+			Expect(ww.Items).To(HaveLen(1))
+			Expect(ww.Items[0].Labels).To(HaveKeyWithValue("team", "platform"))
+			Expect(ww.Items[0].Annotations).To(HaveKeyWithValue("cost-center", "42"))
+		})
+	})
+
+	When("creating a workspace with reserved labels", func() {
+		It("should fail with 422", func() {
+			// given
+			workspace.Labels = map[string]string{workspacesv1alpha1.LabelInternalDomain + "member": user}
+
+			// when
+			err := cli.CreateUserWorkspace(ctx, user, &workspace)
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(kerrors.IsInvalid(err)).To(BeTrue())
+		})
+	})
+
+	When("the owner already has a workspace with the same name", func() {
+		BeforeEach(func() {
+			initializeCli(userSignup.DeepCopy(), &workspacesv1alpha1.InternalWorkspace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      workspace.Name + "-fddjk",
+					Namespace: namespace,
+				},
+				Spec: workspacesv1alpha1.InternalWorkspaceSpec{
+					DisplayName: workspace.Name,
+					Visibility:  workspacesv1alpha1.InternalWorkspaceVisibilityPrivate,
+				},
+				Status: workspacesv1alpha1.InternalWorkspaceStatus{
+					Owner: workspacesv1alpha1.UserInfoStatus{
+						Username: user,
+					},
+				},
+			})
+		})
+
+		It("should fail with 409", func() {
+			// given
+			workspace.Spec.Visibility = restworkspacesv1alpha1.WorkspaceVisibilityPrivate
+
+			// when
+			err := cli.CreateUserWorkspace(ctx, user, &workspace)
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(kerrors.IsAlreadyExists(err)).To(BeTrue())
+		})
+	})
 })
+
+// visibilityValidatingFuncs rejects the InternalWorkspaces with an unknown visibility,
+// as the API Server does, and forwards any other request to the intercepted client
+func visibilityValidatingFuncs() interceptor.Funcs {
+	validate := func(obj client.Object) error {
+		iw, ok := obj.(*workspacesv1alpha1.InternalWorkspace)
+		if !ok {
+			return nil
+		}
+		switch iw.Spec.Visibility {
+		case workspacesv1alpha1.InternalWorkspaceVisibilityCommunity, workspacesv1alpha1.InternalWorkspaceVisibilityPrivate:
+			return nil
+		default:
+			return kerrors.NewInvalid(
+				workspacesv1alpha1.GroupVersion.WithKind("InternalWorkspace").GroupKind(),
+				iw.Name,
+				field.ErrorList{field.NotSupported(field.NewPath("spec", "visibility"), iw.Spec.Visibility, []string{
+					string(workspacesv1alpha1.InternalWorkspaceVisibilityCommunity),
+					string(workspacesv1alpha1.InternalWorkspaceVisibilityPrivate),
+				})})
+		}
+	}
+
+	return interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			if err := validate(obj); err != nil {
+				return err
+			}
+			return c.Create(ctx, obj, opts...)
+		},
+		Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+			if err := validate(obj); err != nil {
+				return err
+			}
+			return c.Update(ctx, obj, opts...)
+		},
+	}
+}
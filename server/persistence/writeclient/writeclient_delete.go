@@ -0,0 +1,71 @@
+package writeclient
+
+import (
+	"context"
+	"fmt"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/konflux-workspaces/workspaces/server/core/workspace"
+	"github.com/konflux-workspaces/workspaces/server/log"
+	"github.com/konflux-workspaces/workspaces/server/persistence/clientinterface"
+	"github.com/konflux-workspaces/workspaces/server/persistence/mapper"
+	"github.com/konflux-workspaces/workspaces/server/persistence/mutate"
+
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+	"github.com/konflux-workspaces/workspaces/server/telemetry"
+)
+
+var _ workspace.WorkspaceDeleter = &WriteClient{}
+
+// DeleteUserWorkspace deletes as `user` the InternalWorkspace representing the provided Workspace.
+// Only the owner is allowed to delete a workspace, and the home workspace can not be deleted.
+func (c *WriteClient) DeleteUserWorkspace(ctx context.Context, user string, workspace *restworkspacesv1alpha1.Workspace, opts ...client.DeleteOption) error {
+	ctx, span := telemetry.StartSpan(ctx, "WriteClient.DeleteUserWorkspace")
+	defer span.End()
+
+	gr := restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource()
+
+	// build client for the user
+	cli, err := c.buildClient(user)
+	if err != nil {
+		return err
+	}
+
+	// get the InternalWorkspace as user
+	ciw := workspacesv1alpha1.InternalWorkspace{}
+	key := clientinterface.SpaceKey{Owner: workspace.Namespace, Name: workspace.Name}
+	if err := c.workspacesReader.GetAsUser(ctx, user, key, &ciw); err != nil {
+		return kerrors.NewNotFound(gr, workspace.Name)
+	}
+
+	// only the owner is allowed to delete the workspace
+	if err := authorizeOwner(&ciw, user, workspace.Name, "delete"); err != nil {
+		return err
+	}
+
+	// the home workspace can not be deleted
+	if ciw.Status.Space.IsHome {
+		return kerrors.NewForbidden(gr, workspace.Name, fmt.Errorf("the home workspace can not be deleted"))
+	}
+
+	// map InternalWorkspace to Workspace
+	ws, err := mapper.Default.InternalWorkspaceToWorkspace(&ciw)
+	if err != nil {
+		return kerrors.NewInternalError(err)
+	}
+
+	// delete the InternalWorkspace
+	log.FromContext(ctx).Debug("deleting user workspace", "workspace", ciw, "user", user)
+	if err := cli.Delete(ctx, &ciw, opts...); err != nil {
+		return err
+	}
+
+	mutate.ApplyIsOwnerLabel(ws, user)
+	ws.Labels[restworkspacesv1alpha1.LabelHasDirectAccess] = "true"
+
+	ws.DeepCopyInto(workspace)
+	return nil
+}
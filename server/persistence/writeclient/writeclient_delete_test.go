@@ -0,0 +1,156 @@
+package writeclient_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/konflux-workspaces/workspaces/server/persistence/internal/cache"
+	"github.com/konflux-workspaces/workspaces/server/persistence/iwclient"
+	"github.com/konflux-workspaces/workspaces/server/persistence/writeclient"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("WriteclientDelete", func() {
+	var ctx context.Context
+	var fakeClient client.WithWatch
+	var cli *writeclient.WriteClient
+	var internalWorkspace workspacesv1alpha1.InternalWorkspace
+
+	workspacesNamespace := "workspaces-system"
+	kubesawNamespace := "toolchain-host"
+
+	owner := "owner"
+	workspace := restworkspacesv1alpha1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: owner,
+			Name:      "workspace-foo",
+		},
+	}
+	userSignup := toolchainv1alpha1.UserSignup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      owner,
+			Namespace: kubesawNamespace,
+		},
+		Status: toolchainv1alpha1.UserSignupStatus{
+			CompliantUsername: owner,
+		},
+	}
+
+	initializeCli := func(objs ...client.Object) {
+		scheme := runtime.NewScheme()
+		Expect(toolchainv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(workspacesv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		fcb := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(objs...)
+		for key, indexer := range cache.UserSignupIndexers {
+			fcb.WithIndex(&toolchainv1alpha1.UserSignup{}, key, indexer)
+		}
+		for key, indexer := range cache.InternalWorkspacesIndexers {
+			fcb.WithIndex(&workspacesv1alpha1.InternalWorkspace{}, key, indexer)
+		}
+		fakeClient = fcb.Build()
+
+		clientFunc := func(string) (client.Client, error) {
+			return fakeClient, nil
+		}
+		iwcli := iwclient.New(fakeClient, workspacesNamespace, kubesawNamespace)
+		cli = writeclient.New(clientFunc, workspacesNamespace, iwcli)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		internalWorkspace = workspacesv1alpha1.InternalWorkspace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      workspace.Name + "-fddjk",
+				Namespace: workspacesNamespace,
+			},
+			Spec: workspacesv1alpha1.InternalWorkspaceSpec{
+				Visibility:  workspacesv1alpha1.InternalWorkspaceVisibilityCommunity,
+				DisplayName: workspace.Name,
+			},
+			Status: workspacesv1alpha1.InternalWorkspaceStatus{
+				Space: workspacesv1alpha1.SpaceInfo{
+					Name: workspace.Name + "-fddjk",
+				},
+				Owner: workspacesv1alpha1.UserInfoStatus{
+					Username: owner,
+				},
+			},
+		}
+	})
+
+	When("deleting a non existing workspace", func() {
+		BeforeEach(func() { initializeCli(userSignup.DeepCopy()) })
+
+		It("should fail with 404", func() {
+			// when
+			err := cli.DeleteUserWorkspace(ctx, owner, workspace.DeepCopy())
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(kerrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	When("deleting a non-owned workspace", func() {
+		BeforeEach(func() { initializeCli(userSignup.DeepCopy(), &internalWorkspace) })
+
+		It("should fail with 403", func() {
+			// when
+			err := cli.DeleteUserWorkspace(ctx, "not-the-owner", workspace.DeepCopy())
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(kerrors.IsForbidden(err)).To(BeTrue())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(&internalWorkspace), &workspacesv1alpha1.InternalWorkspace{})).To(Succeed())
+		})
+	})
+
+	When("deleting the home workspace", func() {
+		BeforeEach(func() {
+			internalWorkspace.Status.Space.IsHome = true
+			initializeCli(userSignup.DeepCopy(), &internalWorkspace)
+		})
+
+		It("should fail with 403", func() {
+			// when
+			err := cli.DeleteUserWorkspace(ctx, owner, workspace.DeepCopy())
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(kerrors.IsForbidden(err)).To(BeTrue())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(&internalWorkspace), &workspacesv1alpha1.InternalWorkspace{})).To(Succeed())
+		})
+	})
+
+	When("deleting an owned workspace", func() {
+		BeforeEach(func() { initializeCli(userSignup.DeepCopy(), &internalWorkspace) })
+
+		It("should delete the InternalWorkspace", func() {
+			// given
+			w := workspace.DeepCopy()
+
+			// when
+			err := cli.DeleteUserWorkspace(ctx, owner, w)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(w.Labels).To(HaveKeyWithValue(restworkspacesv1alpha1.LabelIsOwner, "true"))
+			err = fakeClient.Get(ctx, client.ObjectKeyFromObject(&internalWorkspace), &workspacesv1alpha1.InternalWorkspace{})
+			Expect(kerrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,125 @@
+package writeclient
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/konflux-workspaces/workspaces/server/core/workspace"
+	"github.com/konflux-workspaces/workspaces/server/log"
+	"github.com/konflux-workspaces/workspaces/server/persistence/clientinterface"
+	"github.com/konflux-workspaces/workspaces/server/persistence/mapper"
+	"github.com/konflux-workspaces/workspaces/server/persistence/mutate"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+	"github.com/konflux-workspaces/workspaces/server/telemetry"
+)
+
+var _ workspace.WorkspaceMembersUpdater = &WriteClient{}
+
+var validMemberRoles = []workspacesv1alpha1.InternalWorkspaceRole{
+	workspacesv1alpha1.InternalWorkspaceRoleViewer,
+	workspacesv1alpha1.InternalWorkspaceRoleContributor,
+	workspacesv1alpha1.InternalWorkspaceRoleMaintainer,
+	workspacesv1alpha1.InternalWorkspaceRoleAdmin,
+}
+
+// UpdateUserWorkspaceMembers replaces as `user` the members of the InternalWorkspace representing the provided Workspace.
+// Only the owner and the admins of the workspace are allowed to manage its members.
+func (c *WriteClient) UpdateUserWorkspaceMembers(ctx context.Context, user string, workspace *restworkspacesv1alpha1.Workspace, opts ...client.UpdateOption) error {
+	ctx, span := telemetry.StartSpan(ctx, "WriteClient.UpdateUserWorkspaceMembers")
+	defer span.End()
+
+	gr := restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource()
+
+	// build client for the user
+	cli, err := c.buildClient(user)
+	if err != nil {
+		return err
+	}
+
+	// map to InternalWorkspace
+	iw, err := mapper.Default.WorkspaceToInternalWorkspace(workspace)
+	if err != nil {
+		return kerrors.NewBadRequest("malformed workspace")
+	}
+
+	// get the InternalWorkspace as user
+	ciw := workspacesv1alpha1.InternalWorkspace{}
+	key := clientinterface.SpaceKey{Owner: workspace.Namespace, Name: workspace.Name}
+	if err := c.workspacesReader.GetAsUser(ctx, user, key, &ciw); err != nil {
+		return kerrors.NewNotFound(gr, workspace.Name)
+	}
+
+	// check ResourceVersion matching
+	if err := checkResourceVersion(iw, &ciw, workspace.Name); err != nil {
+		return err
+	}
+
+	// check the user is allowed to manage the members
+	if err := authorizeOwnerOrAdmin(&ciw, user, workspace.Name, "manage the workspace's members"); err != nil {
+		return err
+	}
+
+	// validate the members
+	if err := c.validateMembers(ctx, &ciw, iw.Spec.Members); err != nil {
+		return kerrors.NewBadRequest(err.Error())
+	}
+
+	// update the InternalWorkspace
+	ciw.Spec.Members = iw.Spec.Members
+	log.FromContext(ctx).Debug("updating user workspace members", "workspace", iw, "user", user)
+	if err := cli.Update(ctx, &ciw, opts...); err != nil {
+		return err
+	}
+
+	ws, err := mapper.Default.InternalWorkspaceToWorkspace(&ciw)
+	if err != nil {
+		return kerrors.NewInternalError(err)
+	}
+
+	mutate.ApplyIsOwnerLabel(ws, user)
+	// If a user is managing the members of a workspace, they have direct access
+	// to the workspace.
+	ws.Labels[restworkspacesv1alpha1.LabelHasDirectAccess] = "true"
+
+	ws.DeepCopyInto(workspace)
+	return nil
+}
+
+func (c *WriteClient) validateMembers(ctx context.Context, w *workspacesv1alpha1.InternalWorkspace, members []workspacesv1alpha1.InternalWorkspaceMember) error {
+	seen := map[string]struct{}{}
+	for _, m := range members {
+		if errs := validation.IsDNS1123Label(m.Username); len(errs) > 0 {
+			return fmt.Errorf("invalid member username %q: %v", m.Username, errs)
+		}
+		if _, ok := seen[m.Username]; ok {
+			return fmt.Errorf("member %q is duplicated", m.Username)
+		}
+		seen[m.Username] = struct{}{}
+
+		if !slices.Contains(validMemberRoles, m.Role) {
+			return fmt.Errorf("invalid role %q for member %q", m.Role, m.Username)
+		}
+		if m.Username == w.Status.Owner.Username {
+			return fmt.Errorf("the owner can not be a member of the workspace")
+		}
+
+		// users already members have been validated when they were added
+		if slices.ContainsFunc(w.Spec.Members, func(cm workspacesv1alpha1.InternalWorkspaceMember) bool {
+			return cm.Username == m.Username
+		}) {
+			continue
+		}
+		if err := c.workspacesReader.GetUserSignupByComplaintName(ctx, m.Username, &toolchainv1alpha1.UserSignup{}); err != nil {
+			return fmt.Errorf("user %q not found", m.Username)
+		}
+	}
+	return nil
+}
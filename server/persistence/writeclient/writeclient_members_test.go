@@ -0,0 +1,177 @@
+package writeclient_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/konflux-workspaces/workspaces/server/persistence/internal/cache"
+	"github.com/konflux-workspaces/workspaces/server/persistence/iwclient"
+	"github.com/konflux-workspaces/workspaces/server/persistence/writeclient"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("WriteclientMembers", func() {
+	var ctx context.Context
+	var fakeClient client.WithWatch
+	var cli *writeclient.WriteClient
+	var internalWorkspace workspacesv1alpha1.InternalWorkspace
+
+	workspacesNamespace := "workspaces-system"
+	kubesawNamespace := "toolchain-host"
+
+	owner := "owner"
+	admin := "admin"
+	viewer := "viewer"
+	newMember := "new-member"
+	workspace := restworkspacesv1alpha1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: owner,
+			Name:      "workspace-foo",
+		},
+	}
+	userSignup := func(name string) *toolchainv1alpha1.UserSignup {
+		return &toolchainv1alpha1.UserSignup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: kubesawNamespace,
+			},
+			Status: toolchainv1alpha1.UserSignupStatus{
+				CompliantUsername: name,
+			},
+		}
+	}
+
+	initializeCli := func(objs ...client.Object) {
+		scheme := runtime.NewScheme()
+		Expect(toolchainv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(workspacesv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		fcb := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(objs...)
+		for key, indexer := range cache.UserSignupIndexers {
+			fcb.WithIndex(&toolchainv1alpha1.UserSignup{}, key, indexer)
+		}
+		for key, indexer := range cache.InternalWorkspacesIndexers {
+			fcb.WithIndex(&workspacesv1alpha1.InternalWorkspace{}, key, indexer)
+		}
+		fakeClient = fcb.Build()
+
+		clientFunc := func(string) (client.Client, error) {
+			return fakeClient, nil
+		}
+		iwcli := iwclient.New(fakeClient, workspacesNamespace, kubesawNamespace)
+		cli = writeclient.New(clientFunc, workspacesNamespace, iwcli)
+	}
+
+	withMembers := func(mm ...restworkspacesv1alpha1.WorkspaceMember) *restworkspacesv1alpha1.Workspace {
+		w := workspace.DeepCopy()
+		w.Spec.Members = mm
+		return w
+	}
+
+	expectMembers := func(mm ...workspacesv1alpha1.InternalWorkspaceMember) {
+		iw := workspacesv1alpha1.InternalWorkspace{}
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(&internalWorkspace), &iw)).To(Succeed())
+		Expect(iw.Spec.Members).To(Equal(mm))
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		internalWorkspace = workspacesv1alpha1.InternalWorkspace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      workspace.Name + "-fddjk",
+				Namespace: workspacesNamespace,
+			},
+			Spec: workspacesv1alpha1.InternalWorkspaceSpec{
+				Visibility:  workspacesv1alpha1.InternalWorkspaceVisibilityCommunity,
+				DisplayName: workspace.Name,
+				Members: []workspacesv1alpha1.InternalWorkspaceMember{
+					{Username: admin, Role: workspacesv1alpha1.InternalWorkspaceRoleAdmin},
+					{Username: viewer, Role: workspacesv1alpha1.InternalWorkspaceRoleViewer},
+				},
+			},
+			Status: workspacesv1alpha1.InternalWorkspaceStatus{
+				Space: workspacesv1alpha1.SpaceInfo{
+					Name: workspace.Name + "-fddjk",
+				},
+				Owner: workspacesv1alpha1.UserInfoStatus{
+					Username: owner,
+				},
+			},
+		}
+		initializeCli(userSignup(owner), userSignup(admin), userSignup(viewer), userSignup(newMember), &internalWorkspace)
+	})
+
+	adminMember := restworkspacesv1alpha1.WorkspaceMember{Username: admin, Role: restworkspacesv1alpha1.WorkspaceRoleAdmin}
+	viewerMember := restworkspacesv1alpha1.WorkspaceMember{Username: viewer, Role: restworkspacesv1alpha1.WorkspaceRoleViewer}
+
+	DescribeTable("owner and admins can manage members", func(user string) {
+		// given
+		w := withMembers(adminMember, viewerMember,
+			restworkspacesv1alpha1.WorkspaceMember{Username: newMember, Role: restworkspacesv1alpha1.WorkspaceRoleContributor})
+
+		// when
+		err := cli.UpdateUserWorkspaceMembers(ctx, user, w)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(w.Labels).To(HaveKeyWithValue(restworkspacesv1alpha1.LabelHasDirectAccess, "true"))
+		Expect(w.Spec.Members).To(HaveLen(3))
+		expectMembers(
+			workspacesv1alpha1.InternalWorkspaceMember{Username: admin, Role: workspacesv1alpha1.InternalWorkspaceRoleAdmin},
+			workspacesv1alpha1.InternalWorkspaceMember{Username: viewer, Role: workspacesv1alpha1.InternalWorkspaceRoleViewer},
+			workspacesv1alpha1.InternalWorkspaceMember{Username: newMember, Role: workspacesv1alpha1.InternalWorkspaceRoleContributor},
+		)
+	},
+		Entry("owner", owner),
+		Entry("admin", admin),
+	)
+
+	It("should forbid non-admin members from managing members", func() {
+		// when
+		err := cli.UpdateUserWorkspaceMembers(ctx, viewer, withMembers(adminMember))
+
+		// then
+		Expect(kerrors.IsForbidden(err)).To(BeTrue())
+		expectMembers(internalWorkspace.Spec.Members...)
+	})
+
+	It("should fail with 404 if the workspace does not exist", func() {
+		// given
+		w := withMembers(adminMember)
+		w.Name = "not-existing"
+
+		// when
+		err := cli.UpdateUserWorkspaceMembers(ctx, owner, w)
+
+		// then
+		Expect(kerrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	DescribeTable("invalid members are rejected", func(m restworkspacesv1alpha1.WorkspaceMember) {
+		// when
+		err := cli.UpdateUserWorkspaceMembers(ctx, owner, withMembers(adminMember, m))
+
+		// then
+		Expect(kerrors.IsBadRequest(err)).To(BeTrue())
+		expectMembers(internalWorkspace.Spec.Members...)
+	},
+		Entry("invalid role", restworkspacesv1alpha1.WorkspaceMember{Username: newMember, Role: "superuser"}),
+		Entry("invalid username", restworkspacesv1alpha1.WorkspaceMember{Username: "Not_Valid", Role: restworkspacesv1alpha1.WorkspaceRoleViewer}),
+		Entry("not existing user", restworkspacesv1alpha1.WorkspaceMember{Username: "not-existing", Role: restworkspacesv1alpha1.WorkspaceRoleViewer}),
+		Entry("owner", restworkspacesv1alpha1.WorkspaceMember{Username: owner, Role: restworkspacesv1alpha1.WorkspaceRoleViewer}),
+		Entry("duplicated member", adminMember),
+	)
+})
@@ -0,0 +1,79 @@
+package writeclient
+
+import (
+	"strings"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+// readOnlyLabels are the internal labels the server adds to the returned Workspaces.
+// They are ignored when sent back by clients, so that read-modify-write cycles keep working.
+var readOnlyLabels = map[string]struct{}{
+	restworkspacesv1alpha1.LabelIsOwner:         {},
+	restworkspacesv1alpha1.LabelHasDirectAccess: {},
+}
+
+// validateUserMetadata checks the labels and annotations set by the user on the workspace.
+// Keys in the internal domain are reserved, while the others must satisfy the Kubernetes syntax and size constraints.
+func validateUserMetadata(workspace *restworkspacesv1alpha1.Workspace) error {
+	errs := field.ErrorList{}
+	mp := field.NewPath("metadata")
+
+	ll := map[string]string{}
+	for k, v := range workspace.GetLabels() {
+		if _, ok := readOnlyLabels[k]; ok {
+			continue
+		}
+		if isInternal(k) {
+			errs = append(errs, field.Forbidden(mp.Child("labels").Key(k), "the domain "+workspacesv1alpha1.LabelInternalDomain+" is reserved"))
+			continue
+		}
+		ll[k] = v
+	}
+	errs = append(errs, metav1validation.ValidateLabels(ll, mp.Child("labels"))...)
+
+	aa := map[string]string{}
+	for k, v := range workspace.GetAnnotations() {
+		if isInternal(k) {
+			errs = append(errs, field.Forbidden(mp.Child("annotations").Key(k), "the domain "+workspacesv1alpha1.LabelInternalDomain+" is reserved"))
+			continue
+		}
+		aa[k] = v
+	}
+	errs = append(errs, apivalidation.ValidateAnnotations(aa, mp.Child("annotations"))...)
+
+	if len(errs) > 0 {
+		return kerrors.NewInvalid(restworkspacesv1alpha1.GroupVersion.WithKind("Workspace").GroupKind(), workspace.Name, errs)
+	}
+	return nil
+}
+
+// mergeUserMetadata replaces the user labels and annotations in `dst` with the ones in `src`,
+// preserving the internal ones in `dst`
+func mergeUserMetadata(dst map[string]string, src map[string]string) map[string]string {
+	m := map[string]string{}
+	for k, v := range dst {
+		if isInternal(k) {
+			m[k] = v
+		}
+	}
+	for k, v := range src {
+		if !isInternal(k) {
+			m[k] = v
+		}
+	}
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
+
+func isInternal(key string) bool {
+	return strings.HasPrefix(key, workspacesv1alpha1.LabelInternalDomain)
+}
@@ -0,0 +1,49 @@
+package writeclient
+
+import (
+	"fmt"
+	"slices"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+// The WriteClient writes the InternalWorkspaces with the privileges of the server,
+// as the users are granted no permission on them: every mutation must be
+// authorized with the policies below before being performed.
+// This is intended: requests are neither impersonated nor checked with a
+// SubjectAccessReview, so the Kubernetes RBAC of the users does not apply to
+// them and these policies are the only authorization of the writes.
+
+// authorizeOwner returns a Forbidden error if `user` is not the owner of the InternalWorkspace
+func authorizeOwner(w *workspacesv1alpha1.InternalWorkspace, user, name, action string) error {
+	if isOwner(w, user) {
+		return nil
+	}
+	return newForbidden(name, fmt.Errorf("only the owner can %s the workspace", action))
+}
+
+// authorizeOwnerOrAdmin returns a Forbidden error if `user` is neither the owner
+// nor an admin of the InternalWorkspace
+func authorizeOwnerOrAdmin(w *workspacesv1alpha1.InternalWorkspace, user, name, action string) error {
+	if isOwner(w, user) || isAdmin(w, user) {
+		return nil
+	}
+	return newForbidden(name, fmt.Errorf("only the owner and admins can %s", action))
+}
+
+func isOwner(w *workspacesv1alpha1.InternalWorkspace, user string) bool {
+	return w.Status.Owner.Username == user
+}
+
+func isAdmin(w *workspacesv1alpha1.InternalWorkspace, user string) bool {
+	return slices.ContainsFunc(w.Spec.Members, func(m workspacesv1alpha1.InternalWorkspaceMember) bool {
+		return m.Username == user && m.Role == workspacesv1alpha1.InternalWorkspaceRoleAdmin
+	})
+}
+
+func newForbidden(name string, err error) error {
+	return kerrors.NewForbidden(restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource(), name, err)
+}
@@ -0,0 +1,95 @@
+package writeclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/konflux-workspaces/workspaces/server/core/workspace"
+	"github.com/konflux-workspaces/workspaces/server/log"
+	"github.com/konflux-workspaces/workspaces/server/persistence/clientinterface"
+	"github.com/konflux-workspaces/workspaces/server/persistence/mapper"
+	"github.com/konflux-workspaces/workspaces/server/persistence/mutate"
+
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+	"github.com/konflux-workspaces/workspaces/server/telemetry"
+)
+
+var _ workspace.WorkspaceRenamer = &WriteClient{}
+
+// RenameUserWorkspace renames as `user` the InternalWorkspace representing the provided Workspace,
+// changing its DisplayName only: the InternalWorkspace and its Space keep their names.
+// Only the owner is allowed to rename a workspace, and the home workspace can not be renamed.
+func (c *WriteClient) RenameUserWorkspace(ctx context.Context, user string, workspace *restworkspacesv1alpha1.Workspace, name string, opts ...client.UpdateOption) error {
+	ctx, span := telemetry.StartSpan(ctx, "WriteClient.RenameUserWorkspace")
+	defer span.End()
+
+	gr := restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource()
+
+	// the new name must be a valid workspace name, and the home workspace's one is reserved
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return kerrors.NewBadRequest(fmt.Sprintf("invalid workspace name %q: %s", name, strings.Join(errs, ", ")))
+	}
+	if name == workspacesv1alpha1.DisplayNameDefaultWorkspace {
+		return kerrors.NewBadRequest(fmt.Sprintf("the name %q is reserved for the home workspace", name))
+	}
+
+	// build client for the user
+	cli, err := c.buildClient(user)
+	if err != nil {
+		return err
+	}
+
+	// get the InternalWorkspace as user
+	ciw := workspacesv1alpha1.InternalWorkspace{}
+	key := clientinterface.SpaceKey{Owner: workspace.Namespace, Name: workspace.Name}
+	if err := c.workspacesReader.GetAsUser(ctx, user, key, &ciw); err != nil {
+		return kerrors.NewNotFound(gr, workspace.Name)
+	}
+
+	// only the owner is allowed to rename the workspace
+	if err := authorizeOwner(&ciw, user, workspace.Name, "rename"); err != nil {
+		return err
+	}
+
+	// the home workspace can not be renamed
+	if ciw.Status.Space.IsHome {
+		return kerrors.NewForbidden(gr, workspace.Name, fmt.Errorf("the home workspace can not be renamed"))
+	}
+
+	// workspace names are unique per owner
+	if name != ciw.Spec.DisplayName {
+		inUse, err := c.workspacesReader.IsDisplayNameInUse(ctx, ciw.Status.Owner.Username, name)
+		if err != nil {
+			return kerrors.NewInternalError(err)
+		}
+		if inUse {
+			return kerrors.NewAlreadyExists(gr, name)
+		}
+
+		// update the InternalWorkspace
+		ciw.Spec.DisplayName = name
+		log.FromContext(ctx).Debug("renaming user workspace", "workspace", ciw, "name", name, "user", user)
+		if err := cli.Update(ctx, &ciw, opts...); err != nil {
+			return err
+		}
+	}
+
+	ws, err := mapper.Default.InternalWorkspaceToWorkspace(&ciw)
+	if err != nil {
+		return kerrors.NewInternalError(err)
+	}
+
+	mutate.ApplyIsOwnerLabel(ws, user)
+	// If a user is renaming a workspace, they have direct access
+	// to the workspace.
+	ws.Labels[restworkspacesv1alpha1.LabelHasDirectAccess] = "true"
+
+	ws.DeepCopyInto(workspace)
+	return nil
+}
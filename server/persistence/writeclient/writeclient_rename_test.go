@@ -0,0 +1,219 @@
+package writeclient_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/konflux-workspaces/workspaces/server/persistence/internal/cache"
+	"github.com/konflux-workspaces/workspaces/server/persistence/iwclient"
+	"github.com/konflux-workspaces/workspaces/server/persistence/writeclient"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("WriteclientRename", func() {
+	var ctx context.Context
+	var fakeClient client.WithWatch
+	var cli *writeclient.WriteClient
+	var internalWorkspace workspacesv1alpha1.InternalWorkspace
+
+	workspacesNamespace := "workspaces-system"
+	kubesawNamespace := "toolchain-host"
+
+	owner := "owner"
+	workspace := restworkspacesv1alpha1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: owner,
+			Name:      "workspace-foo",
+		},
+	}
+	userSignup := toolchainv1alpha1.UserSignup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      owner,
+			Namespace: kubesawNamespace,
+		},
+		Status: toolchainv1alpha1.UserSignupStatus{
+			CompliantUsername: owner,
+		},
+	}
+
+	initializeCli := func(objs ...client.Object) {
+		scheme := runtime.NewScheme()
+		Expect(toolchainv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(workspacesv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		fcb := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(objs...)
+		for key, indexer := range cache.UserSignupIndexers {
+			fcb.WithIndex(&toolchainv1alpha1.UserSignup{}, key, indexer)
+		}
+		for key, indexer := range cache.InternalWorkspacesIndexers {
+			fcb.WithIndex(&workspacesv1alpha1.InternalWorkspace{}, key, indexer)
+		}
+		fakeClient = fcb.Build()
+
+		clientFunc := func(string) (client.Client, error) {
+			return fakeClient, nil
+		}
+		iwcli := iwclient.New(fakeClient, workspacesNamespace, kubesawNamespace)
+		cli = writeclient.New(clientFunc, workspacesNamespace, iwcli)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		internalWorkspace = workspacesv1alpha1.InternalWorkspace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      workspace.Name + "-fddjk",
+				Namespace: workspacesNamespace,
+			},
+			Spec: workspacesv1alpha1.InternalWorkspaceSpec{
+				Visibility:  workspacesv1alpha1.InternalWorkspaceVisibilityCommunity,
+				DisplayName: workspace.Name,
+			},
+			Status: workspacesv1alpha1.InternalWorkspaceStatus{
+				Space: workspacesv1alpha1.SpaceInfo{
+					Name: workspace.Name + "-fddjk",
+				},
+				Owner: workspacesv1alpha1.UserInfoStatus{
+					Username: owner,
+				},
+			},
+		}
+	})
+
+	When("renaming a non existing workspace", func() {
+		BeforeEach(func() { initializeCli(userSignup.DeepCopy()) })
+
+		It("should fail with 404", func() {
+			// when
+			err := cli.RenameUserWorkspace(ctx, owner, workspace.DeepCopy(), "workspace-bar")
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(kerrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	When("renaming a non-owned workspace", func() {
+		BeforeEach(func() { initializeCli(userSignup.DeepCopy(), &internalWorkspace) })
+
+		It("should fail with 403", func() {
+			// when
+			err := cli.RenameUserWorkspace(ctx, "not-the-owner", workspace.DeepCopy(), "workspace-bar")
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(kerrors.IsForbidden(err)).To(BeTrue())
+
+			iw := workspacesv1alpha1.InternalWorkspace{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(&internalWorkspace), &iw)).To(Succeed())
+			Expect(iw.Spec.DisplayName).To(Equal(workspace.Name))
+		})
+	})
+
+	When("renaming the home workspace", func() {
+		BeforeEach(func() {
+			internalWorkspace.Status.Space.IsHome = true
+			initializeCli(userSignup.DeepCopy(), &internalWorkspace)
+		})
+
+		It("should fail with 403", func() {
+			// when
+			err := cli.RenameUserWorkspace(ctx, owner, workspace.DeepCopy(), "workspace-bar")
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(kerrors.IsForbidden(err)).To(BeTrue())
+		})
+	})
+
+	When("renaming an owned workspace", func() {
+		var otherInternalWorkspace workspacesv1alpha1.InternalWorkspace
+
+		BeforeEach(func() {
+			otherInternalWorkspace = workspacesv1alpha1.InternalWorkspace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "workspace-baz-kfjdd",
+					Namespace: workspacesNamespace,
+				},
+				Spec: workspacesv1alpha1.InternalWorkspaceSpec{
+					Visibility:  workspacesv1alpha1.InternalWorkspaceVisibilityPrivate,
+					DisplayName: "workspace-baz",
+				},
+				Status: workspacesv1alpha1.InternalWorkspaceStatus{
+					Owner: workspacesv1alpha1.UserInfoStatus{
+						Username: owner,
+					},
+				},
+			}
+			initializeCli(userSignup.DeepCopy(), &internalWorkspace, &otherInternalWorkspace)
+		})
+
+		It("should change the display name only", func() {
+			// given
+			w := workspace.DeepCopy()
+
+			// when
+			err := cli.RenameUserWorkspace(ctx, owner, w, "workspace-bar")
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(w.Name).To(Equal("workspace-bar"))
+			Expect(w.Namespace).To(Equal(owner))
+			Expect(w.Status.Space.Name).To(Equal(internalWorkspace.Status.Space.Name))
+			Expect(w.Labels).To(HaveKeyWithValue(restworkspacesv1alpha1.LabelIsOwner, "true"))
+
+			iw := workspacesv1alpha1.InternalWorkspace{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(&internalWorkspace), &iw)).To(Succeed())
+			Expect(iw.Spec.DisplayName).To(Equal("workspace-bar"))
+		})
+
+		It("should succeed if the name does not change", func() {
+			// given
+			w := workspace.DeepCopy()
+
+			// when
+			err := cli.RenameUserWorkspace(ctx, owner, w, workspace.Name)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(w.Name).To(Equal(workspace.Name))
+		})
+
+		It("should fail with 409 if the name is in use", func() {
+			// when
+			err := cli.RenameUserWorkspace(ctx, owner, workspace.DeepCopy(), otherInternalWorkspace.Spec.DisplayName)
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(kerrors.IsAlreadyExists(err)).To(BeTrue())
+
+			iw := workspacesv1alpha1.InternalWorkspace{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(&internalWorkspace), &iw)).To(Succeed())
+			Expect(iw.Spec.DisplayName).To(Equal(workspace.Name))
+		})
+
+		DescribeTable("should fail with 400 if the name is not valid", func(name string) {
+			// when
+			err := cli.RenameUserWorkspace(ctx, owner, workspace.DeepCopy(), name)
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(kerrors.IsBadRequest(err)).To(BeTrue())
+		},
+			Entry("invalid DNS-1123 label", "Not_A_Valid_Name"),
+			Entry("home workspace name", workspacesv1alpha1.DisplayNameDefaultWorkspace),
+		)
+	})
+})
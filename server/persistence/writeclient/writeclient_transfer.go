@@ -0,0 +1,227 @@
+package writeclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/konflux-workspaces/workspaces/server/core/workspace"
+	"github.com/konflux-workspaces/workspaces/server/log"
+	"github.com/konflux-workspaces/workspaces/server/persistence/clientinterface"
+	"github.com/konflux-workspaces/workspaces/server/persistence/mapper"
+	"github.com/konflux-workspaces/workspaces/server/persistence/mutate"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+	"github.com/konflux-workspaces/workspaces/server/telemetry"
+)
+
+var _ workspace.WorkspaceOwnershipTransferrer = &WriteClient{}
+
+// The ownership of a workspace is transferred in two steps: the owner proposes
+// the transfer to another user, who is then allowed to read the workspace and
+// to accept the transfer. Until accepted, the transfer can be cancelled by
+// the owner or declined by the proposed owner.
+// Accepting the transfer replaces the owner's information in the InternalWorkspace's
+// spec: the operator then moves the workspace and its Space to the new owner.
+
+// ProposeUserWorkspaceOwnershipTransfer proposes as `user` the transfer of the ownership of
+// the InternalWorkspace representing the provided Workspace to `newOwner`.
+// Only the owner is allowed to propose a transfer, and the home workspace can not be transferred.
+// A pending proposal is replaced.
+func (c *WriteClient) ProposeUserWorkspaceOwnershipTransfer(ctx context.Context, user string, workspace *restworkspacesv1alpha1.Workspace, newOwner string, opts ...client.UpdateOption) error {
+	ctx, span := telemetry.StartSpan(ctx, "WriteClient.ProposeUserWorkspaceOwnershipTransfer")
+	defer span.End()
+
+	gr := restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource()
+
+	// the new owner must be a valid username
+	if errs := validation.IsDNS1123Label(newOwner); len(errs) > 0 {
+		return kerrors.NewBadRequest(fmt.Sprintf("invalid username %q: %s", newOwner, strings.Join(errs, ", ")))
+	}
+
+	// build client for the user
+	cli, err := c.buildClient(user)
+	if err != nil {
+		return err
+	}
+
+	// get the InternalWorkspace as user
+	ciw := workspacesv1alpha1.InternalWorkspace{}
+	key := clientinterface.SpaceKey{Owner: workspace.Namespace, Name: workspace.Name}
+	if err := c.workspacesReader.GetAsUser(ctx, user, key, &ciw); err != nil {
+		return kerrors.NewNotFound(gr, workspace.Name)
+	}
+
+	// only the owner is allowed to transfer the workspace
+	if err := authorizeOwner(&ciw, user, workspace.Name, "transfer"); err != nil {
+		return err
+	}
+
+	// the home workspace can not be transferred
+	if ciw.Status.Space.IsHome {
+		return kerrors.NewForbidden(gr, workspace.Name, fmt.Errorf("the home workspace can not be transferred"))
+	}
+
+	// the new owner must be another existing user
+	if newOwner == ciw.Status.Owner.Username {
+		return kerrors.NewBadRequest(fmt.Sprintf("user %q already owns the workspace", newOwner))
+	}
+	u := toolchainv1alpha1.UserSignup{}
+	if err := c.workspacesReader.GetUserSignupByComplaintName(ctx, newOwner, &u); err != nil {
+		return kerrors.NewBadRequest(fmt.Sprintf("user %q not found", newOwner))
+	}
+
+	// update the InternalWorkspace
+	ciw.Spec.OwnershipTransfer = &workspacesv1alpha1.InternalWorkspaceOwnershipTransfer{NewOwner: newOwner}
+	log.FromContext(ctx).Debug("proposing user workspace ownership transfer", "workspace", ciw, "newOwner", newOwner, "user", user)
+	if err := cli.Update(ctx, &ciw, opts...); err != nil {
+		return err
+	}
+
+	return fillTransferredWorkspace(&ciw, workspace, user, true)
+}
+
+// CancelUserWorkspaceOwnershipTransfer cancels as `user` the pending transfer of the ownership of
+// the InternalWorkspace representing the provided Workspace.
+// Only the owner and the proposed owner are allowed to cancel a transfer.
+func (c *WriteClient) CancelUserWorkspaceOwnershipTransfer(ctx context.Context, user string, workspace *restworkspacesv1alpha1.Workspace, opts ...client.UpdateOption) error {
+	ctx, span := telemetry.StartSpan(ctx, "WriteClient.CancelUserWorkspaceOwnershipTransfer")
+	defer span.End()
+
+	gr := restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource()
+
+	// build client for the user
+	cli, err := c.buildClient(user)
+	if err != nil {
+		return err
+	}
+
+	// get the InternalWorkspace as user
+	ciw := workspacesv1alpha1.InternalWorkspace{}
+	key := clientinterface.SpaceKey{Owner: workspace.Namespace, Name: workspace.Name}
+	if err := c.workspacesReader.GetAsUser(ctx, user, key, &ciw); err != nil {
+		return kerrors.NewNotFound(gr, workspace.Name)
+	}
+
+	// only the owner and the proposed owner are allowed to cancel the transfer
+	if !isOwner(&ciw, user) && !isProposedOwner(&ciw, user) {
+		return newForbidden(workspace.Name, fmt.Errorf("only the owner and the proposed owner can cancel the ownership transfer"))
+	}
+	if ciw.Spec.OwnershipTransfer == nil {
+		return newNoPendingTransfer(workspace.Name)
+	}
+
+	// update the InternalWorkspace
+	ciw.Spec.OwnershipTransfer = nil
+	log.FromContext(ctx).Debug("cancelling user workspace ownership transfer", "workspace", ciw, "user", user)
+	if err := cli.Update(ctx, &ciw, opts...); err != nil {
+		return err
+	}
+
+	// the proposed owner has direct access only if they are a member
+	hasDirectAccess := isOwner(&ciw, user) || slices.ContainsFunc(ciw.Spec.Members, func(m workspacesv1alpha1.InternalWorkspaceMember) bool {
+		return m.Username == user
+	})
+	return fillTransferredWorkspace(&ciw, workspace, user, hasDirectAccess)
+}
+
+// AcceptUserWorkspaceOwnershipTransfer accepts as `user` the pending transfer of the ownership of
+// the InternalWorkspace representing the provided Workspace.
+// Only the proposed owner is allowed to accept a transfer, and only if they do not already own
+// a workspace with the same name.
+func (c *WriteClient) AcceptUserWorkspaceOwnershipTransfer(ctx context.Context, user string, workspace *restworkspacesv1alpha1.Workspace, opts ...client.UpdateOption) error {
+	ctx, span := telemetry.StartSpan(ctx, "WriteClient.AcceptUserWorkspaceOwnershipTransfer")
+	defer span.End()
+
+	gr := restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource()
+
+	// build client for the user
+	cli, err := c.buildClient(user)
+	if err != nil {
+		return err
+	}
+
+	// get the InternalWorkspace as user
+	ciw := workspacesv1alpha1.InternalWorkspace{}
+	key := clientinterface.SpaceKey{Owner: workspace.Namespace, Name: workspace.Name}
+	if err := c.workspacesReader.GetAsUser(ctx, user, key, &ciw); err != nil {
+		return kerrors.NewNotFound(gr, workspace.Name)
+	}
+
+	// only the proposed owner is allowed to accept the transfer
+	if ciw.Spec.OwnershipTransfer == nil {
+		return newNoPendingTransfer(workspace.Name)
+	}
+	if !isProposedOwner(&ciw, user) {
+		return newForbidden(workspace.Name, fmt.Errorf("only the proposed owner can accept the ownership transfer"))
+	}
+
+	// workspace names are unique per owner
+	inUse, err := c.workspacesReader.IsDisplayNameInUse(ctx, user, ciw.Spec.DisplayName)
+	if err != nil {
+		return kerrors.NewInternalError(err)
+	}
+	if inUse {
+		return kerrors.NewAlreadyExists(gr, ciw.Spec.DisplayName)
+	}
+
+	// retrieve new owner's information
+	u := toolchainv1alpha1.UserSignup{}
+	if err := c.workspacesReader.GetUserSignupByComplaintName(ctx, user, &u); err != nil {
+		return kerrors.NewInternalError(fmt.Errorf("error retrieving UserSignup for user %s: %w", user, err))
+	}
+
+	// update the InternalWorkspace: the new owner is no longer a member
+	ciw.Spec.Owner.JwtInfo.Sub = u.Spec.IdentityClaims.Sub
+	ciw.Spec.Owner.JwtInfo.Email = u.Spec.IdentityClaims.Email
+	ciw.Spec.Owner.JwtInfo.UserId = u.Spec.IdentityClaims.UserID
+	ciw.Spec.Members = slices.DeleteFunc(ciw.Spec.Members, func(m workspacesv1alpha1.InternalWorkspaceMember) bool {
+		return m.Username == user
+	})
+	ciw.Spec.OwnershipTransfer = nil
+	log.FromContext(ctx).Debug("accepting user workspace ownership transfer", "workspace", ciw, "user", user)
+	if err := cli.Update(ctx, &ciw, opts...); err != nil {
+		return err
+	}
+
+	// the status is not yet updated by the operator, so set the new owner
+	ciw.Status.Owner.Username = user
+	return fillTransferredWorkspace(&ciw, workspace, user, true)
+}
+
+func isProposedOwner(w *workspacesv1alpha1.InternalWorkspace, user string) bool {
+	return w.Spec.OwnershipTransfer != nil && w.Spec.OwnershipTransfer.NewOwner == user
+}
+
+func newNoPendingTransfer(name string) error {
+	return &kerrors.StatusError{ErrStatus: metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusNotFound,
+		Reason:  metav1.StatusReasonNotFound,
+		Message: fmt.Sprintf("no ownership transfer is pending for workspace %q", name),
+	}}
+}
+
+// fillTransferredWorkspace maps the InternalWorkspace into the workspace returned to `user`
+func fillTransferredWorkspace(ciw *workspacesv1alpha1.InternalWorkspace, workspace *restworkspacesv1alpha1.Workspace, user string, hasDirectAccess bool) error {
+	ws, err := mapper.Default.InternalWorkspaceToWorkspace(ciw)
+	if err != nil {
+		return kerrors.NewInternalError(err)
+	}
+
+	mutate.ApplyIsOwnerLabel(ws, user)
+	ws.Labels[restworkspacesv1alpha1.LabelHasDirectAccess] = strconv.FormatBool(hasDirectAccess)
+
+	ws.DeepCopyInto(workspace)
+	return nil
+}
@@ -0,0 +1,352 @@
+package writeclient_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/konflux-workspaces/workspaces/server/persistence/internal/cache"
+	"github.com/konflux-workspaces/workspaces/server/persistence/iwclient"
+	"github.com/konflux-workspaces/workspaces/server/persistence/writeclient"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("WriteclientTransfer", func() {
+	var ctx context.Context
+	var fakeClient client.WithWatch
+	var cli *writeclient.WriteClient
+	var internalWorkspace workspacesv1alpha1.InternalWorkspace
+
+	workspacesNamespace := "workspaces-system"
+	kubesawNamespace := "toolchain-host"
+
+	owner := "owner"
+	workspace := restworkspacesv1alpha1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: owner,
+			Name:      "workspace-foo",
+		},
+	}
+	newOwner := "new-owner"
+	userSignup := toolchainv1alpha1.UserSignup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      owner,
+			Namespace: kubesawNamespace,
+		},
+		Status: toolchainv1alpha1.UserSignupStatus{
+			CompliantUsername: owner,
+		},
+	}
+	newOwnerUserSignup := toolchainv1alpha1.UserSignup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      newOwner,
+			Namespace: kubesawNamespace,
+		},
+		Spec: toolchainv1alpha1.UserSignupSpec{
+			IdentityClaims: toolchainv1alpha1.IdentityClaimsEmbedded{
+				PropagatedClaims: toolchainv1alpha1.PropagatedClaims{
+					Sub:    "new-owner-sub",
+					UserID: "new-owner-id",
+					Email:  "new-owner@example.com",
+				},
+			},
+		},
+		Status: toolchainv1alpha1.UserSignupStatus{
+			CompliantUsername: newOwner,
+		},
+	}
+
+	initializeCli := func(objs ...client.Object) {
+		scheme := runtime.NewScheme()
+		Expect(toolchainv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(workspacesv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		fcb := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(objs...)
+		for key, indexer := range cache.UserSignupIndexers {
+			fcb.WithIndex(&toolchainv1alpha1.UserSignup{}, key, indexer)
+		}
+		for key, indexer := range cache.InternalWorkspacesIndexers {
+			fcb.WithIndex(&workspacesv1alpha1.InternalWorkspace{}, key, indexer)
+		}
+		fakeClient = fcb.Build()
+
+		clientFunc := func(string) (client.Client, error) {
+			return fakeClient, nil
+		}
+		iwcli := iwclient.New(fakeClient, workspacesNamespace, kubesawNamespace)
+		cli = writeclient.New(clientFunc, workspacesNamespace, iwcli)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		internalWorkspace = workspacesv1alpha1.InternalWorkspace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      workspace.Name + "-fddjk",
+				Namespace: workspacesNamespace,
+			},
+			Spec: workspacesv1alpha1.InternalWorkspaceSpec{
+				Visibility:  workspacesv1alpha1.InternalWorkspaceVisibilityCommunity,
+				DisplayName: workspace.Name,
+				Owner: workspacesv1alpha1.UserInfo{
+					JwtInfo: workspacesv1alpha1.JwtInfo{Sub: "owner-sub", Email: "owner@example.com"},
+				},
+			},
+			Status: workspacesv1alpha1.InternalWorkspaceStatus{
+				Space: workspacesv1alpha1.SpaceInfo{
+					Name: workspace.Name + "-fddjk",
+				},
+				Owner: workspacesv1alpha1.UserInfoStatus{
+					Username: owner,
+				},
+			},
+		}
+	})
+
+	getInternalWorkspace := func() workspacesv1alpha1.InternalWorkspace {
+		iw := workspacesv1alpha1.InternalWorkspace{}
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(&internalWorkspace), &iw)).To(Succeed())
+		return iw
+	}
+
+	Describe("Propose", func() {
+		When("proposing the transfer of a non existing workspace", func() {
+			BeforeEach(func() { initializeCli(userSignup.DeepCopy(), newOwnerUserSignup.DeepCopy()) })
+
+			It("should fail with 404", func() {
+				// when
+				err := cli.ProposeUserWorkspaceOwnershipTransfer(ctx, owner, workspace.DeepCopy(), newOwner)
+
+				// then
+				Expect(kerrors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
+		When("proposing the transfer of a non-owned workspace", func() {
+			BeforeEach(func() {
+				initializeCli(userSignup.DeepCopy(), newOwnerUserSignup.DeepCopy(), &internalWorkspace)
+			})
+
+			It("should fail with 403", func() {
+				// when
+				err := cli.ProposeUserWorkspaceOwnershipTransfer(ctx, newOwner, workspace.DeepCopy(), newOwner)
+
+				// then
+				Expect(kerrors.IsForbidden(err)).To(BeTrue())
+				Expect(getInternalWorkspace().Spec.OwnershipTransfer).To(BeNil())
+			})
+		})
+
+		When("proposing the transfer of the home workspace", func() {
+			BeforeEach(func() {
+				internalWorkspace.Status.Space.IsHome = true
+				initializeCli(userSignup.DeepCopy(), newOwnerUserSignup.DeepCopy(), &internalWorkspace)
+			})
+
+			It("should fail with 403", func() {
+				// when
+				err := cli.ProposeUserWorkspaceOwnershipTransfer(ctx, owner, workspace.DeepCopy(), newOwner)
+
+				// then
+				Expect(kerrors.IsForbidden(err)).To(BeTrue())
+			})
+		})
+
+		When("proposing the transfer of an owned workspace", func() {
+			BeforeEach(func() { initializeCli(userSignup.DeepCopy(), newOwnerUserSignup.DeepCopy(), &internalWorkspace) })
+
+			It("should set the pending transfer", func() {
+				// given
+				w := workspace.DeepCopy()
+
+				// when
+				err := cli.ProposeUserWorkspaceOwnershipTransfer(ctx, owner, w, newOwner)
+
+				// then
+				Expect(err).NotTo(HaveOccurred())
+				Expect(w.Namespace).To(Equal(owner))
+				Expect(w.Spec.OwnershipTransfer).To(Equal(&restworkspacesv1alpha1.WorkspaceOwnershipTransfer{NewOwner: newOwner}))
+				Expect(w.Labels).To(HaveKeyWithValue(restworkspacesv1alpha1.LabelIsOwner, "true"))
+				Expect(getInternalWorkspace().Spec.OwnershipTransfer).To(Equal(&workspacesv1alpha1.InternalWorkspaceOwnershipTransfer{NewOwner: newOwner}))
+			})
+
+			DescribeTable("should fail with 400 if the new owner is not valid", func(newOwner string) {
+				// when
+				err := cli.ProposeUserWorkspaceOwnershipTransfer(ctx, owner, workspace.DeepCopy(), newOwner)
+
+				// then
+				Expect(kerrors.IsBadRequest(err)).To(BeTrue())
+				Expect(getInternalWorkspace().Spec.OwnershipTransfer).To(BeNil())
+			},
+				Entry("invalid username", "Not_A_Valid_Username"),
+				Entry("current owner", owner),
+				Entry("not existing user", "not-existing"),
+			)
+		})
+	})
+
+	Describe("Cancel", func() {
+		When("no transfer is pending", func() {
+			BeforeEach(func() { initializeCli(userSignup.DeepCopy(), newOwnerUserSignup.DeepCopy(), &internalWorkspace) })
+
+			It("should fail with 404", func() {
+				// when
+				err := cli.CancelUserWorkspaceOwnershipTransfer(ctx, owner, workspace.DeepCopy())
+
+				// then
+				Expect(kerrors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
+		When("a transfer is pending", func() {
+			BeforeEach(func() {
+				internalWorkspace.Spec.OwnershipTransfer = &workspacesv1alpha1.InternalWorkspaceOwnershipTransfer{NewOwner: newOwner}
+				initializeCli(userSignup.DeepCopy(), newOwnerUserSignup.DeepCopy(), &internalWorkspace)
+			})
+
+			It("should be cancelled by the owner", func() {
+				// given
+				w := workspace.DeepCopy()
+
+				// when
+				err := cli.CancelUserWorkspaceOwnershipTransfer(ctx, owner, w)
+
+				// then
+				Expect(err).NotTo(HaveOccurred())
+				Expect(w.Spec.OwnershipTransfer).To(BeNil())
+				Expect(w.Labels).To(HaveKeyWithValue(restworkspacesv1alpha1.LabelHasDirectAccess, "true"))
+				Expect(getInternalWorkspace().Spec.OwnershipTransfer).To(BeNil())
+			})
+
+			It("should be declined by the proposed owner", func() {
+				// given
+				w := workspace.DeepCopy()
+
+				// when
+				err := cli.CancelUserWorkspaceOwnershipTransfer(ctx, newOwner, w)
+
+				// then
+				Expect(err).NotTo(HaveOccurred())
+				Expect(w.Spec.OwnershipTransfer).To(BeNil())
+				Expect(w.Labels).To(HaveKeyWithValue(restworkspacesv1alpha1.LabelIsOwner, "false"))
+				Expect(w.Labels).To(HaveKeyWithValue(restworkspacesv1alpha1.LabelHasDirectAccess, "false"))
+				Expect(getInternalWorkspace().Spec.OwnershipTransfer).To(BeNil())
+			})
+
+			It("should fail with 403 for other users", func() {
+				// when
+				err := cli.CancelUserWorkspaceOwnershipTransfer(ctx, "other-user", workspace.DeepCopy())
+
+				// then
+				Expect(kerrors.IsForbidden(err)).To(BeTrue())
+				Expect(getInternalWorkspace().Spec.OwnershipTransfer).NotTo(BeNil())
+			})
+		})
+	})
+
+	Describe("Accept", func() {
+		When("no transfer is pending", func() {
+			BeforeEach(func() {
+				initializeCli(userSignup.DeepCopy(), newOwnerUserSignup.DeepCopy(), &internalWorkspace)
+			})
+
+			It("should fail with 404", func() {
+				// when
+				err := cli.AcceptUserWorkspaceOwnershipTransfer(ctx, newOwner, workspace.DeepCopy())
+
+				// then
+				Expect(kerrors.IsNotFound(err)).To(BeTrue())
+				Expect(getInternalWorkspace().Spec.Owner.JwtInfo.Sub).To(Equal("owner-sub"))
+			})
+		})
+
+		When("a transfer is pending", func() {
+			BeforeEach(func() {
+				internalWorkspace.Spec.OwnershipTransfer = &workspacesv1alpha1.InternalWorkspaceOwnershipTransfer{NewOwner: newOwner}
+				internalWorkspace.Spec.Members = []workspacesv1alpha1.InternalWorkspaceMember{
+					{Username: newOwner, Role: workspacesv1alpha1.InternalWorkspaceRoleContributor},
+					{Username: "other-user", Role: workspacesv1alpha1.InternalWorkspaceRoleViewer},
+				}
+			})
+
+			When("the proposed owner does not own a workspace with the same name", func() {
+				BeforeEach(func() { initializeCli(userSignup.DeepCopy(), newOwnerUserSignup.DeepCopy(), &internalWorkspace) })
+
+				It("should move the workspace to the proposed owner", func() {
+					// given
+					w := workspace.DeepCopy()
+
+					// when
+					err := cli.AcceptUserWorkspaceOwnershipTransfer(ctx, newOwner, w)
+
+					// then
+					Expect(err).NotTo(HaveOccurred())
+					Expect(w.Namespace).To(Equal(newOwner))
+					Expect(w.Name).To(Equal(workspace.Name))
+					Expect(w.Spec.OwnershipTransfer).To(BeNil())
+					Expect(w.Labels).To(HaveKeyWithValue(restworkspacesv1alpha1.LabelIsOwner, "true"))
+
+					iw := getInternalWorkspace()
+					Expect(iw.Spec.OwnershipTransfer).To(BeNil())
+					Expect(iw.Spec.Owner.JwtInfo).To(Equal(workspacesv1alpha1.JwtInfo{
+						Sub:    "new-owner-sub",
+						UserId: "new-owner-id",
+						Email:  "new-owner@example.com",
+					}))
+					Expect(iw.Spec.Members).To(Equal([]workspacesv1alpha1.InternalWorkspaceMember{
+						{Username: "other-user", Role: workspacesv1alpha1.InternalWorkspaceRoleViewer},
+					}))
+				})
+
+				It("should fail with 403 for other users", func() {
+					// when
+					err := cli.AcceptUserWorkspaceOwnershipTransfer(ctx, owner, workspace.DeepCopy())
+
+					// then
+					Expect(kerrors.IsForbidden(err)).To(BeTrue())
+					Expect(getInternalWorkspace().Spec.OwnershipTransfer).NotTo(BeNil())
+				})
+			})
+
+			When("the proposed owner already owns a workspace with the same name", func() {
+				BeforeEach(func() {
+					initializeCli(userSignup.DeepCopy(), newOwnerUserSignup.DeepCopy(), &internalWorkspace,
+						&workspacesv1alpha1.InternalWorkspace{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      workspace.Name + "-kfjdd",
+								Namespace: workspacesNamespace,
+							},
+							Spec: workspacesv1alpha1.InternalWorkspaceSpec{
+								DisplayName: workspace.Name,
+							},
+							Status: workspacesv1alpha1.InternalWorkspaceStatus{
+								Owner: workspacesv1alpha1.UserInfoStatus{
+									Username: newOwner,
+								},
+							},
+						})
+				})
+
+				It("should fail with 409", func() {
+					// when
+					err := cli.AcceptUserWorkspaceOwnershipTransfer(ctx, newOwner, workspace.DeepCopy())
+
+					// then
+					Expect(kerrors.IsAlreadyExists(err)).To(BeTrue())
+					Expect(getInternalWorkspace().Spec.OwnershipTransfer).NotTo(BeNil())
+				})
+			})
+		})
+	})
+})
@@ -14,18 +14,27 @@ import (
 
 	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
 	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+	"github.com/konflux-workspaces/workspaces/server/telemetry"
 )
 
 var _ workspace.WorkspaceUpdater = &WriteClient{}
 
 // UpdateUserWorkspace updates as `user` the InternalWorkspace representing the provided Workspace
 func (c *WriteClient) UpdateUserWorkspace(ctx context.Context, user string, workspace *restworkspacesv1alpha1.Workspace, opts ...client.UpdateOption) error {
-	// build client impersonating the user
+	ctx, span := telemetry.StartSpan(ctx, "WriteClient.UpdateUserWorkspace")
+	defer span.End()
+
+	// build client for the user
 	cli, err := c.buildClient(user)
 	if err != nil {
 		return err
 	}
 
+	// validate user labels and annotations
+	if err := validateUserMetadata(workspace); err != nil {
+		return err
+	}
+
 	// map to InternalWorkspace
 	iw, err := mapper.Default.WorkspaceToInternalWorkspace(workspace)
 	if err != nil {
@@ -41,16 +50,29 @@ func (c *WriteClient) UpdateUserWorkspace(ctx context.Context, user string, work
 			workspace.Name)
 	}
 
-	// check Generation matching
-	if iw.Generation != ciw.Generation {
-		return kerrors.NewResourceExpired("workspace version changed")
+	// only the owner is allowed to update the workspace
+	if err := authorizeOwner(&ciw, user, workspace.Name, "update"); err != nil {
+		return err
+	}
+
+	// check ResourceVersion matching
+	if err := checkResourceVersion(iw, &ciw, workspace.Name); err != nil {
+		return err
 	}
 
 	// update the InternalWorkspace
 	ciw.Spec.Visibility = iw.Spec.Visibility
-	log.FromContext(ctx).Debug("updating user workspace", "workspace", iw, "user", user)
-	err = cli.Update(ctx, &ciw, opts...)
-	if err != nil {
+	ciw.Labels = mergeUserMetadata(ciw.Labels, iw.Labels)
+	ciw.Annotations = mergeUserMetadata(ciw.Annotations, iw.Annotations)
+	if mff, ok := iw.Annotations[mapper.AnnotationManagedFields]; ok {
+		if ciw.Annotations == nil {
+			ciw.Annotations = map[string]string{}
+		}
+		ciw.Annotations[mapper.AnnotationManagedFields] = mff
+	}
+	// dry runs are forwarded to the API Server too, so that the InternalWorkspace is validated and admitted
+	log.FromContext(ctx).Debug("updating user workspace", "workspace", iw, "user", user, "dryRun", (&client.UpdateOptions{}).ApplyOptions(opts).DryRun)
+	if err := cli.Update(ctx, &ciw, opts...); err != nil {
 		return err
 	}
 
@@ -2,6 +2,7 @@ package writeclient_test
 
 import (
 	"context"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -11,9 +12,11 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	"github.com/konflux-workspaces/workspaces/server/persistence/internal/cache"
 	"github.com/konflux-workspaces/workspaces/server/persistence/iwclient"
+	"github.com/konflux-workspaces/workspaces/server/persistence/mapper"
 	"github.com/konflux-workspaces/workspaces/server/persistence/writeclient"
 
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
@@ -137,6 +140,42 @@ var _ = Describe("WriteclientUpdate", func() {
 			})
 		})
 
+		When("updating a community workspace owned by another user", func() {
+			other := "other"
+
+			BeforeEach(func() {
+				internalWorkspace.Spec.Visibility = workspacesv1alpha1.InternalWorkspaceVisibilityCommunity
+				userSignup := toolchainv1alpha1.UserSignup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      user,
+						Namespace: kubesawNamespace,
+					},
+					Status: toolchainv1alpha1.UserSignupStatus{
+						CompliantUsername: workspace.Namespace,
+					},
+				}
+
+				beforeInitializeCli(&internalWorkspace, &userSignup)
+			})
+
+			It("should fail with 403", func() {
+				// given
+				w := workspace.DeepCopy()
+				w.Spec.Visibility = restworkspacesv1alpha1.WorkspaceVisibilityPrivate
+
+				// when
+				err := cli.UpdateUserWorkspace(ctx, other, w)
+
+				// then
+				Expect(err).To(HaveOccurred())
+				Expect(kerrors.IsForbidden(err)).To(BeTrue())
+
+				iw := workspacesv1alpha1.InternalWorkspace{}
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(&internalWorkspace), &iw)).To(Succeed())
+				Expect(iw.Spec.Visibility).To(Equal(workspacesv1alpha1.InternalWorkspaceVisibilityCommunity))
+			})
+		})
+
 		When("updating an owned workspace", func() {
 			BeforeEach(func() {
 				spaceBinding := toolchainv1alpha1.SpaceBinding{
@@ -180,6 +219,153 @@ var _ = Describe("WriteclientUpdate", func() {
 				Expect(w.Labels).To(HaveKeyWithValue(restworkspacesv1alpha1.LabelIsOwner, "true"))
 				Expect(w.Labels).To(HaveKeyWithValue(restworkspacesv1alpha1.LabelHasDirectAccess, "true"))
 			})
+
+			It("should update if the resourceVersion matches", func() {
+				// given
+				iw := workspacesv1alpha1.InternalWorkspace{}
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(&internalWorkspace), &iw)).To(Succeed())
+				w := workspace.DeepCopy()
+				w.ResourceVersion = iw.ResourceVersion
+
+				// when
+				err := cli.UpdateUserWorkspace(ctx, user, w)
+
+				// then
+				Expect(err).NotTo(HaveOccurred())
+				Expect(w.ResourceVersion).NotTo(Equal(iw.ResourceVersion))
+			})
+
+			It("should fail with 409 if the resourceVersion changed", func() {
+				// given
+				w := workspace.DeepCopy()
+				w.ResourceVersion = "1"
+				w.Spec.Visibility = restworkspacesv1alpha1.WorkspaceVisibilityCommunity
+
+				// when
+				err := cli.UpdateUserWorkspace(ctx, user, w)
+
+				// then
+				Expect(err).To(HaveOccurred())
+				Expect(kerrors.IsConflict(err)).To(BeTrue())
+
+				iw := workspacesv1alpha1.InternalWorkspace{}
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(&internalWorkspace), &iw)).To(Succeed())
+				Expect(iw.Spec.Visibility).To(Equal(workspacesv1alpha1.InternalWorkspaceVisibilityPrivate))
+			})
+
+			It("should not persist the changes in dry run", func() {
+				// given
+				w := workspace.DeepCopy()
+				w.Spec.Visibility = restworkspacesv1alpha1.WorkspaceVisibilityCommunity
+
+				// when
+				err := cli.UpdateUserWorkspace(ctx, user, w, client.DryRunAll)
+
+				// then
+				Expect(err).NotTo(HaveOccurred())
+				Expect(w.Spec.Visibility).To(Equal(restworkspacesv1alpha1.WorkspaceVisibilityCommunity))
+
+				iw := workspacesv1alpha1.InternalWorkspace{}
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(&internalWorkspace), &iw)).To(Succeed())
+				Expect(iw.Spec.Visibility).To(Equal(workspacesv1alpha1.InternalWorkspaceVisibilityPrivate))
+			})
+
+			It("should be rejected in dry run if the API Server rejects the workspace", func() {
+				// given
+				cli = writeclient.New(func(string) (client.Client, error) {
+					return interceptor.NewClient(fakeClient, visibilityValidatingFuncs()), nil
+				}, namespace, iwclient.New(fakeClient, workspacesNamespace, kubesawNamespace))
+				w := workspace.DeepCopy()
+				w.Spec.Visibility = "not-a-visibility"
+
+				// when
+				err := cli.UpdateUserWorkspace(ctx, user, w, client.DryRunAll)
+
+				// then
+				Expect(err).To(HaveOccurred())
+				Expect(kerrors.IsInvalid(err)).To(BeTrue())
+			})
+
+			It("should persist the managed fields", func() {
+				// given
+				w := workspace.DeepCopy()
+				w.ManagedFields = []metav1.ManagedFieldsEntry{{
+					Manager:    "kubectl",
+					Operation:  metav1.ManagedFieldsOperationApply,
+					APIVersion: restworkspacesv1alpha1.GroupVersion.String(),
+					FieldsType: "FieldsV1",
+					FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:visibility":{}}}`)},
+				}}
+
+				// when
+				err := cli.UpdateUserWorkspace(ctx, user, w)
+
+				// then
+				Expect(err).NotTo(HaveOccurred())
+				Expect(w.ManagedFields).To(HaveLen(1))
+				Expect(w.ManagedFields[0].Manager).To(Equal("kubectl"))
+
+				iw := workspacesv1alpha1.InternalWorkspace{}
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(&internalWorkspace), &iw)).To(Succeed())
+				Expect(iw.Annotations).To(HaveKey(mapper.AnnotationManagedFields))
+			})
+
+			It("should persist the user labels and annotations", func() {
+				// given
+				iw := workspacesv1alpha1.InternalWorkspace{}
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(&internalWorkspace), &iw)).To(Succeed())
+				iw.Labels = map[string]string{
+					workspacesv1alpha1.LabelInternalDomain + "internal": "true",
+					"stale": "true",
+				}
+				Expect(fakeClient.Update(ctx, &iw)).To(Succeed())
+
+				w := workspace.DeepCopy()
+				w.Labels = map[string]string{
+					"team":                              "platform",
+					restworkspacesv1alpha1.LabelIsOwner: "true",
+					restworkspacesv1alpha1.LabelHasDirectAccess: "true",
+				}
+				w.Annotations = map[string]string{"cost-center": "42"}
+				w.ResourceVersion = iw.ResourceVersion
+
+				// when
+				err := cli.UpdateUserWorkspace(ctx, user, w)
+
+				// then
+				Expect(err).NotTo(HaveOccurred())
+				Expect(w.Labels).To(HaveKeyWithValue("team", "platform"))
+				Expect(w.Labels).NotTo(HaveKey("stale"))
+				Expect(w.Annotations).To(Equal(map[string]string{"cost-center": "42"}))
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(&internalWorkspace), &iw)).To(Succeed())
+				Expect(iw.Labels).To(Equal(map[string]string{
+					workspacesv1alpha1.LabelInternalDomain + "internal": "true",
+					"team": "platform",
+				}))
+				Expect(iw.Annotations).To(Equal(map[string]string{"cost-center": "42"}))
+			})
+
+			DescribeTable("should fail with 422 if the labels or annotations are invalid", func(labels, annotations map[string]string) {
+				// given
+				w := workspace.DeepCopy()
+				w.Labels = labels
+				w.Annotations = annotations
+
+				// when
+				err := cli.UpdateUserWorkspace(ctx, user, w)
+
+				// then
+				Expect(err).To(HaveOccurred())
+				Expect(kerrors.IsInvalid(err)).To(BeTrue())
+			},
+				Entry("reserved label", map[string]string{workspacesv1alpha1.LabelInternalDomain + "member": "foo"}, nil),
+				Entry("reserved annotation", nil, map[string]string{mapper.AnnotationManagedFields: "[]"}),
+				Entry("invalid label key", map[string]string{"not a key": "foo"}, nil),
+				Entry("invalid label value", map[string]string{"team": "not a value"}, nil),
+				Entry("invalid annotation key", nil, map[string]string{"not a key": "foo"}),
+				Entry("too large annotations", nil, map[string]string{"description": strings.Repeat("a", 256*1024+1)}),
+			)
 		})
 	})
 })
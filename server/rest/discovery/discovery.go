@@ -0,0 +1,110 @@
+package discovery
+
+import (
+	"net/http"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/konflux-workspaces/workspaces/server/log"
+	"github.com/konflux-workspaces/workspaces/server/rest/header"
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+	"github.com/konflux-workspaces/workspaces/server/rest/status"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ http.Handler = &Handler{}
+
+// WorkspacesResource describes the workspaces resource served by the REST API
+var WorkspacesResource = metav1.APIResource{
+	Name:         "workspaces",
+	SingularName: "workspace",
+	Namespaced:   true,
+	Kind:         "Workspace",
+	Verbs:        metav1.Verbs{"create", "delete", "get", "list", "patch", "update", "watch"},
+	ShortNames:   []string{"ws"},
+}
+
+// APIGroupList returns the list of API groups served by the REST API
+func APIGroupList() *metav1.APIGroupList {
+	return &metav1.APIGroupList{
+		TypeMeta: metav1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"},
+		Groups:   []metav1.APIGroup{*APIGroup()},
+	}
+}
+
+// APIGroup returns the workspaces API group with its versions
+func APIGroup() *metav1.APIGroup {
+	v := metav1.GroupVersionForDiscovery{
+		GroupVersion: restworkspacesv1alpha1.GroupVersion.String(),
+		Version:      restworkspacesv1alpha1.GroupVersion.Version,
+	}
+	return &metav1.APIGroup{
+		TypeMeta:         metav1.TypeMeta{Kind: "APIGroup", APIVersion: "v1"},
+		Name:             restworkspacesv1alpha1.GroupVersion.Group,
+		Versions:         []metav1.GroupVersionForDiscovery{v},
+		PreferredVersion: v,
+	}
+}
+
+// APIResourceList returns the resources served by the REST API in the workspaces group version
+func APIResourceList() *metav1.APIResourceList {
+	return &metav1.APIResourceList{
+		TypeMeta:     metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"},
+		GroupVersion: restworkspacesv1alpha1.GroupVersion.String(),
+		APIResources: []metav1.APIResource{WorkspacesResource},
+	}
+}
+
+// Handler the http.Request handler for the discovery endpoints.
+// It replies to every request with the same Document.
+type Handler struct {
+	Document any
+
+	MarshalerProvider marshal.MarshalerProvider
+}
+
+// NewDefaultHandler creates a Handler serving the given document
+func NewDefaultHandler(document any) *Handler {
+	return NewHandler(document, marshal.DefaultMarshalerProvider)
+}
+
+// NewHandler creates a Handler serving the given document
+func NewHandler(document any, marshalerProvider marshal.MarshalerProvider) *Handler {
+	return &Handler{
+		Document:          document,
+		MarshalerProvider: marshalerProvider,
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l := log.FromContext(r.Context())
+	l.Debug("executing discovery")
+
+	// build marshaler for the given request
+	l.Debug("building marshaler for request")
+	m, err := h.MarshalerProvider(r)
+	if err != nil {
+		l.Debug("error building marshaler for request", "error", err)
+		status.WriteBadRequest(w, err)
+		return
+	}
+
+	// marshal response
+	l.Debug("marshaling response", "response", h.Document)
+	d, err := m.Marshal(h.Document)
+	if err != nil {
+		l.Error("unexpected error marshaling response", "error", err)
+		status.WriteError(w, err)
+		return
+	}
+
+	// reply
+	l.Debug("writing response", "response", d)
+	w.Header().Add(header.ContentType, m.ContentType())
+	if _, err := w.Write(d); err != nil {
+		l.Error("unexpected error writing response", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+}
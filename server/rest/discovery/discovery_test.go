@@ -0,0 +1,78 @@
+package discovery_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/konflux-workspaces/workspaces/server/rest/discovery"
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+)
+
+var _ = Describe("Discovery", func() {
+	It("should list the workspaces group", func() {
+		// when
+		l := discovery.APIGroupList()
+
+		// then
+		Expect(l.Kind).To(Equal("APIGroupList"))
+		Expect(l.Groups).To(HaveLen(1))
+		Expect(l.Groups[0].Name).To(Equal("workspaces.konflux-ci.dev"))
+		Expect(l.Groups[0].PreferredVersion.GroupVersion).To(Equal("workspaces.konflux-ci.dev/v1alpha1"))
+	})
+
+	It("should list the workspaces resource", func() {
+		// when
+		l := discovery.APIResourceList()
+
+		// then
+		Expect(l.Kind).To(Equal("APIResourceList"))
+		Expect(l.GroupVersion).To(Equal("workspaces.konflux-ci.dev/v1alpha1"))
+		Expect(l.APIResources).To(ConsistOf(metav1.APIResource{
+			Name:         "workspaces",
+			SingularName: "workspace",
+			Namespaced:   true,
+			Kind:         "Workspace",
+			Verbs:        metav1.Verbs{"create", "delete", "get", "list", "patch", "update", "watch"},
+			ShortNames:   []string{"ws"},
+		}))
+	})
+
+	Describe("Handler", func() {
+		It("should reply with the document", func() {
+			// given
+			h := discovery.NewDefaultHandler(discovery.APIGroup())
+			w := httptest.NewRecorder()
+
+			// when
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/apis/workspaces.konflux-ci.dev", nil))
+
+			// then
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Header().Get("Content-Type")).To(Equal(marshal.ContentTypeJson))
+			g := metav1.APIGroup{}
+			Expect(json.Unmarshal(w.Body.Bytes(), &g)).To(Succeed())
+			Expect(g).To(Equal(*discovery.APIGroup()))
+		})
+
+		It("should reply bad request if the marshaler can not be built", func() {
+			// given
+			h := discovery.NewHandler(discovery.APIGroup(), func(*http.Request) (marshal.Marshaler, error) {
+				return nil, fmt.Errorf("not acceptable")
+			})
+			w := httptest.NewRecorder()
+
+			// when
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/apis/workspaces.konflux-ci.dev", nil))
+
+			// then
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
@@ -2,4 +2,7 @@ package header
 
 const (
 	ContentType string = "Content-Type"
+	ETag        string = "ETag"
+	IfMatch     string = "If-Match"
+	IfNoneMatch string = "If-None-Match"
 )
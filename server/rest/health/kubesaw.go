@@ -0,0 +1,106 @@
+package health
+
+import (
+	"errors"
+	"net/http"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/konflux-workspaces/workspaces/operator/pkg/toolchainstatus"
+	"github.com/konflux-workspaces/workspaces/server/log"
+	"github.com/konflux-workspaces/workspaces/server/rest/header"
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+	"github.com/konflux-workspaces/workspaces/server/rest/status"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+)
+
+var _ http.Handler = &KubesawHandler{}
+
+// KubesawHealth reports the health of the KubeSaw instance the REST API relies on
+type KubesawHealth struct {
+	Ready bool `json:"ready"`
+}
+
+// KubesawHandler the http.Request handler for the KubeSaw health endpoint.
+// It replies with 200 OK if KubeSaw is ready, and 503 Service Unavailable otherwise.
+// The endpoint is not authenticated, so the components that are not ready are logged
+// rather than returned to the client.
+type KubesawHandler struct {
+	Reader           client.Reader
+	KubesawNamespace string
+
+	MarshalerProvider marshal.MarshalerProvider
+}
+
+// NewDefaultKubesawHandler creates a KubesawHandler reading the ToolchainStatus
+// from the given KubeSaw namespace.
+// KubesawHealth has no protobuf encoding, so only JSON and YAML are negotiated.
+func NewDefaultKubesawHandler(reader client.Reader, kubesawNamespace string) *KubesawHandler {
+	return NewKubesawHandler(reader, kubesawNamespace, marshal.NewNegotiatingMarshalerProvider(marshal.DefaultMarshal, marshal.YamlMarshal))
+}
+
+// NewKubesawHandler creates a KubesawHandler reading the ToolchainStatus
+// from the given KubeSaw namespace
+func NewKubesawHandler(reader client.Reader, kubesawNamespace string, marshalerProvider marshal.MarshalerProvider) *KubesawHandler {
+	return &KubesawHandler{
+		Reader:            reader,
+		KubesawNamespace:  kubesawNamespace,
+		MarshalerProvider: marshalerProvider,
+	}
+}
+
+func (h *KubesawHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l := log.FromContext(r.Context())
+	l.Debug("checking kubesaw health")
+
+	// build marshaler for the given request
+	l.Debug("building marshaler for request")
+	m, err := h.MarshalerProvider(r)
+	if err != nil {
+		l.Debug("error building marshaler for request", "error", err)
+		status.WriteBadRequest(w, err)
+		return
+	}
+
+	// retrieve the health of the components
+	ts := toolchainv1alpha1.ToolchainStatus{}
+	k := client.ObjectKey{Namespace: h.KubesawNamespace, Name: toolchainstatus.Name}
+	hh := KubesawHealth{}
+	switch err := h.Reader.Get(r.Context(), k, &ts); {
+	case kerrors.IsNotFound(err):
+		l.Debug("ToolchainStatus not found", "name", toolchainstatus.Name)
+	case err != nil:
+		// the cause is not returned to the unauthenticated client
+		l.Error("unexpected error retrieving ToolchainStatus", "error", err)
+		status.WriteError(w, errors.New("error retrieving ToolchainStatus"))
+		return
+	default:
+		cc := toolchainstatus.Components(&ts)
+		hh.Ready = toolchainstatus.Ready(cc)
+		for _, c := range toolchainstatus.NotReady(cc) {
+			l.Info("KubeSaw component is not ready", "component", c.Name, "cluster", c.Cluster, "conditions", c.NotReadyConditions())
+		}
+	}
+
+	// marshal response
+	l.Debug("marshaling response", "response", hh)
+	d, err := m.Marshal(hh)
+	if err != nil {
+		l.Error("unexpected error marshaling response", "error", err)
+		status.WriteError(w, err)
+		return
+	}
+
+	// reply
+	l.Debug("writing response", "response", d)
+	w.Header().Add(header.ContentType, m.ContentType())
+	if !hh.Ready {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if _, err := w.Write(d); err != nil {
+		l.Error("unexpected error writing response", "error", err)
+		return
+	}
+}
@@ -0,0 +1,128 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/konflux-workspaces/workspaces/operator/pkg/toolchainstatus"
+	"github.com/konflux-workspaces/workspaces/server/rest/health"
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+)
+
+var _ = Describe("Kubesaw", func() {
+	kubesawNamespace := "toolchain-host-operator"
+	ready := toolchainv1alpha1.Condition{Type: toolchainv1alpha1.ConditionReady, Status: corev1.ConditionTrue}
+	notReady := toolchainv1alpha1.Condition{Type: toolchainv1alpha1.ConditionReady, Status: corev1.ConditionFalse, Reason: "failure"}
+
+	var clientBuilder *fake.ClientBuilder
+	var status toolchainv1alpha1.ToolchainStatus
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(toolchainv1alpha1.AddToScheme(scheme)).To(Succeed())
+		clientBuilder = fake.NewClientBuilder().WithScheme(scheme)
+
+		status = toolchainv1alpha1.ToolchainStatus{
+			ObjectMeta: metav1.ObjectMeta{Name: toolchainstatus.Name, Namespace: kubesawNamespace},
+			Status: toolchainv1alpha1.ToolchainStatusStatus{
+				Conditions: []toolchainv1alpha1.Condition{ready},
+				HostOperator: &toolchainv1alpha1.HostOperatorStatus{
+					Conditions: []toolchainv1alpha1.Condition{ready},
+				},
+				Members: []toolchainv1alpha1.Member{
+					{
+						ClusterName:  "member-1",
+						MemberStatus: toolchainv1alpha1.MemberStatusStatus{Conditions: []toolchainv1alpha1.Condition{ready}},
+					},
+				},
+			},
+		}
+	})
+
+	serve := func() (*httptest.ResponseRecorder, health.KubesawHealth) {
+		GinkgoHelper()
+
+		h := health.NewDefaultKubesawHandler(clientBuilder.Build(), kubesawNamespace)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/healthz/kubesaw", nil))
+
+		hh := health.KubesawHealth{}
+		if w.Code != http.StatusInternalServerError {
+			Expect(w.Header().Get("Content-Type")).To(Equal(marshal.ContentTypeJson))
+			Expect(json.Unmarshal(w.Body.Bytes(), &hh)).To(Succeed())
+		}
+		return w, hh
+	}
+
+	It("should reply OK if all components are ready", func() {
+		// given
+		clientBuilder.WithObjects(&status)
+
+		// when
+		w, hh := serve()
+
+		// then
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(hh).To(Equal(health.KubesawHealth{Ready: true}))
+	})
+
+	It("should not report the unhealthy components", func() {
+		// given
+		status.Status.Members = append(status.Status.Members, toolchainv1alpha1.Member{
+			ClusterName: "member-2",
+			MemberStatus: toolchainv1alpha1.MemberStatusStatus{
+				Conditions: []toolchainv1alpha1.Condition{ready},
+				Routes:     &toolchainv1alpha1.Routes{Conditions: []toolchainv1alpha1.Condition{notReady}},
+			},
+		})
+		clientBuilder.WithObjects(&status)
+
+		// when
+		w, hh := serve()
+
+		// then
+		Expect(w.Code).To(Equal(http.StatusServiceUnavailable))
+		Expect(hh).To(Equal(health.KubesawHealth{Ready: false}))
+		Expect(w.Body.String()).NotTo(ContainSubstring("member-2"))
+	})
+
+	It("should reply Service Unavailable if the ToolchainStatus does not exist", func() {
+		// when
+		w, hh := serve()
+
+		// then
+		Expect(w.Code).To(Equal(http.StatusServiceUnavailable))
+		Expect(hh).To(Equal(health.KubesawHealth{Ready: false}))
+	})
+
+	It("should reply Internal Server Error if the ToolchainStatus can not be read", func() {
+		// given
+		clientBuilder.WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(context.Context, client.WithWatch, client.ObjectKey, client.Object, ...client.GetOption) error {
+				return fmt.Errorf("an error")
+			},
+		})
+
+		// when
+		w, _ := serve()
+
+		// then
+		Expect(w.Code).To(Equal(http.StatusInternalServerError))
+		Expect(w.Body.String()).NotTo(ContainSubstring("an error"))
+	})
+})
@@ -19,6 +19,17 @@ var (
 	DefaultMarshal   Marshaler   = &JsonMarshaler{}
 	DefaultUnmarshal Unmarshaler = &JsonUnmarshaler{}
 
-	DefaultMarshalerProvider   MarshalerProvider   = func(r *http.Request) (Marshaler, error) { return DefaultMarshal, nil }
-	DefaultUnmarshalerProvider UnmarshalerProvider = func(r *http.Request) (Unmarshaler, error) { return DefaultUnmarshal, nil }
+	YamlMarshal       Marshaler   = &YamlMarshaler{}
+	YamlUnmarshal     Unmarshaler = &YamlUnmarshaler{}
+	ProtobufMarshal   Marshaler   = &ProtobufMarshaler{}
+	ProtobufUnmarshal Unmarshaler = &ProtobufUnmarshaler{}
+
+	// DefaultMarshalerProvider negotiates JSON, YAML, or protobuf from the Accept header, defaulting to JSON
+	DefaultMarshalerProvider MarshalerProvider = NewNegotiatingMarshalerProvider(DefaultMarshal, YamlMarshal, ProtobufMarshal)
+	// DefaultUnmarshalerProvider picks JSON, YAML, or protobuf from the Content-Type header, defaulting to JSON
+	DefaultUnmarshalerProvider UnmarshalerProvider = NewNegotiatingUnmarshalerProvider(DefaultUnmarshal, YamlUnmarshal, ProtobufUnmarshal)
+
+	// StreamMarshalerProvider negotiates the content type of streamed responses, like watches.
+	// Only JSON is supported, as events are newline delimited.
+	StreamMarshalerProvider MarshalerProvider = NewNegotiatingMarshalerProvider(DefaultMarshal)
 )
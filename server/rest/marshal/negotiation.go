@@ -0,0 +1,140 @@
+package marshal
+
+import (
+	"cmp"
+	"fmt"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/konflux-workspaces/workspaces/server/rest/header"
+)
+
+// NewNegotiatingMarshalerProvider returns a MarshalerProvider choosing, among the given marshalers,
+// the one preferred by the client in the request's Accept header.
+// The first marshaler is used if the request has no Accept header.
+// If none of the marshalers is accepted, the provider returns a NotAcceptable error.
+func NewNegotiatingMarshalerProvider(marshalers ...Marshaler) MarshalerProvider {
+	return func(r *http.Request) (Marshaler, error) {
+		if strings.TrimSpace(strings.Join(r.Header.Values("Accept"), "")) == "" {
+			return marshalers[0], nil
+		}
+
+		for _, a := range parseAccept(r) {
+			// media types with an `as` parameter, like Tables, need a dedicated marshaler
+			if a.params["as"] != "" {
+				continue
+			}
+			for _, m := range marshalers {
+				if a.matches(m.ContentType()) {
+					return m, nil
+				}
+			}
+		}
+		return nil, newNotAcceptableError(marshalers)
+	}
+}
+
+// NewNegotiatingUnmarshalerProvider returns an UnmarshalerProvider choosing, among the given unmarshalers,
+// the one matching the request's Content-Type header.
+// The first unmarshaler is used if the request has no Content-Type header.
+// If none of the unmarshalers matches, the provider returns an UnsupportedMediaType error.
+func NewNegotiatingUnmarshalerProvider(unmarshalers ...Unmarshaler) UnmarshalerProvider {
+	return func(r *http.Request) (Unmarshaler, error) {
+		ct := r.Header.Get(header.ContentType)
+		if ct == "" {
+			return unmarshalers[0], nil
+		}
+
+		mt, _, err := mime.ParseMediaType(ct)
+		if err != nil {
+			return nil, newUnsupportedMediaTypeError(unmarshalers)
+		}
+		for _, u := range unmarshalers {
+			if mt == u.ContentType() {
+				return u, nil
+			}
+		}
+		return nil, newUnsupportedMediaTypeError(unmarshalers)
+	}
+}
+
+// mediaRange is a clause of the Accept header
+type mediaRange struct {
+	mediaType string
+	params    map[string]string
+	q         float64
+}
+
+// matches returns true if the media range includes the given content type
+func (m mediaRange) matches(contentType string) bool {
+	switch {
+	case m.mediaType == "*/*":
+		return true
+	case strings.HasSuffix(m.mediaType, "/*"):
+		return strings.HasPrefix(contentType, strings.TrimSuffix(m.mediaType, "*"))
+	default:
+		return m.mediaType == contentType
+	}
+}
+
+// parseAccept returns the media ranges of the request's Accept header, ordered by the client's preference.
+// Invalid and refused (q=0) media ranges are discarded.
+func parseAccept(r *http.Request) []mediaRange {
+	var mm []mediaRange
+	for _, a := range strings.Split(strings.Join(r.Header.Values("Accept"), ","), ",") {
+		if strings.TrimSpace(a) == "" {
+			continue
+		}
+		mt, pp, err := mime.ParseMediaType(strings.TrimSpace(a))
+		if err != nil {
+			continue
+		}
+
+		q := 1.0
+		if v, ok := pp["q"]; ok {
+			if q, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+			delete(pp, "q")
+		}
+		if q <= 0 {
+			continue
+		}
+		mm = append(mm, mediaRange{mediaType: mt, params: pp, q: q})
+	}
+
+	slices.SortStableFunc(mm, func(a, b mediaRange) int { return cmp.Compare(b.q, a.q) })
+	return mm
+}
+
+func newNotAcceptableError(marshalers []Marshaler) error {
+	cc := make([]string, 0, len(marshalers))
+	for _, m := range marshalers {
+		cc = append(cc, m.ContentType())
+	}
+	return &kerrors.StatusError{ErrStatus: metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusNotAcceptable,
+		Reason:  metav1.StatusReasonNotAcceptable,
+		Message: fmt.Sprintf("only the following media types are accepted: %s", strings.Join(cc, ", ")),
+	}}
+}
+
+func newUnsupportedMediaTypeError(unmarshalers []Unmarshaler) error {
+	cc := make([]string, 0, len(unmarshalers))
+	for _, u := range unmarshalers {
+		cc = append(cc, u.ContentType())
+	}
+	return &kerrors.StatusError{ErrStatus: metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusUnsupportedMediaType,
+		Reason:  metav1.StatusReasonUnsupportedMediaType,
+		Message: fmt.Sprintf("the body of the request was in an unknown format - accepted media types include: %s", strings.Join(cc, ", ")),
+	}}
+}
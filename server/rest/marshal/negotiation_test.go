@@ -0,0 +1,115 @@
+package marshal_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+)
+
+var _ = Describe("Negotiation", func() {
+	Describe("DefaultMarshalerProvider", func() {
+		DescribeTable("negotiates the content type from the Accept header", func(accept string, expected string) {
+			// given
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			if accept != "" {
+				r.Header.Set("Accept", accept)
+			}
+
+			// when
+			m, err := marshal.DefaultMarshalerProvider(r)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(m.ContentType()).To(Equal(expected))
+		},
+			Entry("no Accept header", "", marshal.ContentTypeJson),
+			Entry("any", "*/*", marshal.ContentTypeJson),
+			Entry("any application", "application/*", marshal.ContentTypeJson),
+			Entry("JSON", "application/json", marshal.ContentTypeJson),
+			Entry("YAML", "application/yaml", marshal.ContentTypeYaml),
+			Entry("protobuf", "application/vnd.kubernetes.protobuf", marshal.ContentTypeProtobuf),
+			Entry("first supported", "text/html, application/yaml, application/json", marshal.ContentTypeYaml),
+			Entry("highest quality", "application/json;q=0.5, application/yaml;q=0.9", marshal.ContentTypeYaml),
+			Entry("refused", "application/yaml;q=0, */*", marshal.ContentTypeJson),
+			Entry("client-go protobuf", "application/vnd.kubernetes.protobuf, */*", marshal.ContentTypeProtobuf),
+			Entry("Table with fallback", "application/json;as=Table;v=v1;g=meta.k8s.io, application/yaml", marshal.ContentTypeYaml),
+		)
+
+		DescribeTable("refuses unsupported media types", func(accept string) {
+			// given
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set("Accept", accept)
+
+			// when
+			_, err := marshal.DefaultMarshalerProvider(r)
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(kerrors.IsNotAcceptable(err)).To(BeTrue())
+			Expect(err.(kerrors.APIStatus).Status().Code).To(BeEquivalentTo(http.StatusNotAcceptable))
+		},
+			Entry("unsupported", "text/html"),
+			Entry("only refused", "application/json;q=0"),
+			Entry("only Table", "application/json;as=Table;v=v1;g=meta.k8s.io"),
+		)
+	})
+
+	Describe("StreamMarshalerProvider", func() {
+		It("should only negotiate JSON", func() {
+			// given
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set("Accept", "application/yaml")
+
+			// when
+			_, err := marshal.StreamMarshalerProvider(r)
+
+			// then
+			Expect(kerrors.IsNotAcceptable(err)).To(BeTrue())
+		})
+	})
+
+	Describe("DefaultUnmarshalerProvider", func() {
+		DescribeTable("picks the unmarshaler from the Content-Type header", func(contentType string, expected string) {
+			// given
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			if contentType != "" {
+				r.Header.Set("Content-Type", contentType)
+			}
+
+			// when
+			u, err := marshal.DefaultUnmarshalerProvider(r)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(u.ContentType()).To(Equal(expected))
+		},
+			Entry("no Content-Type header", "", marshal.ContentTypeJson),
+			Entry("JSON", "application/json", marshal.ContentTypeJson),
+			Entry("JSON with charset", "application/json; charset=utf-8", marshal.ContentTypeJson),
+			Entry("YAML", "application/yaml", marshal.ContentTypeYaml),
+			Entry("protobuf", "application/vnd.kubernetes.protobuf", marshal.ContentTypeProtobuf),
+		)
+
+		DescribeTable("refuses unsupported media types", func(contentType string) {
+			// given
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			r.Header.Set("Content-Type", contentType)
+
+			// when
+			_, err := marshal.DefaultUnmarshalerProvider(r)
+
+			// then
+			Expect(kerrors.IsUnsupportedMediaType(err)).To(BeTrue())
+			Expect(err.(kerrors.APIStatus).Status().Code).To(BeEquivalentTo(http.StatusUnsupportedMediaType))
+		},
+			Entry("unsupported", "text/plain"),
+			Entry("invalid", "application/"),
+		)
+	})
+})
@@ -0,0 +1,103 @@
+package marshal
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const ContentTypeProtobuf string = runtime.ContentTypeProtobuf
+
+// protobufPrefix is the magic number prefixing the Kubernetes protobuf envelope
+var protobufPrefix = []byte{0x6b, 0x38, 0x73, 0x00}
+
+type protoMarshaler interface {
+	Marshal() ([]byte, error)
+}
+
+type protoUnmarshaler interface {
+	Unmarshal([]byte) error
+}
+
+// objectKindAccessor is implemented by the types embedding metav1.TypeMeta,
+// including the ones that are not runtime.Objects, like WorkspaceEventList
+type objectKindAccessor interface {
+	GetObjectKind() schema.ObjectKind
+}
+
+// ProtobufMarshaler marshals objects in the protobuf envelope used by the Kubernetes API Server.
+// Objects must provide their protobuf encoding through a `Marshal() ([]byte, error)` method,
+// as generated by go-to-protobuf.
+type ProtobufMarshaler struct{}
+
+func (m *ProtobufMarshaler) Marshal(v any) ([]byte, error) {
+	pv := addressable(v)
+	pm, ok := pv.(protoMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("can not marshal %T to protobuf", v)
+	}
+	raw, err := pm.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	u := runtime.Unknown{Raw: raw}
+	if o, ok := pv.(objectKindAccessor); ok {
+		gvk := o.GetObjectKind().GroupVersionKind()
+		u.APIVersion, u.Kind = gvk.GroupVersion().String(), gvk.Kind
+	}
+	d, err := u.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return append(bytes.Clone(protobufPrefix), d...), nil
+}
+
+func (m *ProtobufMarshaler) ContentType() string {
+	return ContentTypeProtobuf
+}
+
+// ProtobufUnmarshaler unmarshals objects from the protobuf envelope used by the Kubernetes API Server.
+// Objects must provide their own protobuf decoding through an `Unmarshal([]byte) error` method.
+type ProtobufUnmarshaler struct{}
+
+func (m *ProtobufUnmarshaler) Unmarshal(d []byte, r any) error {
+	pu, ok := r.(protoUnmarshaler)
+	if !ok {
+		return fmt.Errorf("can not unmarshal protobuf to %T", r)
+	}
+	if !bytes.HasPrefix(d, protobufPrefix) {
+		return fmt.Errorf("provided data does not appear to be a protobuf message, expected prefix %v", protobufPrefix)
+	}
+
+	u := runtime.Unknown{}
+	if err := u.Unmarshal(d[len(protobufPrefix):]); err != nil {
+		return err
+	}
+	if err := pu.Unmarshal(u.Raw); err != nil {
+		return err
+	}
+	if o, ok := r.(objectKindAccessor); ok && u.Kind != "" {
+		o.GetObjectKind().SetGroupVersionKind(u.GroupVersionKind())
+	}
+	return nil
+}
+
+func (m *ProtobufUnmarshaler) ContentType() string {
+	return ContentTypeProtobuf
+}
+
+// addressable returns a pointer to a copy of v if v is not a pointer,
+// as protobuf methods are usually defined on the pointer receiver
+func addressable(v any) any {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() || rv.Kind() == reflect.Pointer {
+		return v
+	}
+	p := reflect.New(rv.Type())
+	p.Elem().Set(rv)
+	return p.Interface()
+}
@@ -0,0 +1,141 @@
+package marshal_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer/protobuf"
+
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("Protobuf", func() {
+	w := restworkspacesv1alpha1.Workspace{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Workspace",
+			APIVersion: restworkspacesv1alpha1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "foo",
+			Namespace:       "bar",
+			ResourceVersion: "42",
+			Labels:          map[string]string{restworkspacesv1alpha1.LabelIsOwner: "true"},
+		},
+		Spec: restworkspacesv1alpha1.WorkspaceSpec{
+			Visibility: restworkspacesv1alpha1.WorkspaceVisibilityPrivate,
+			Members: []restworkspacesv1alpha1.WorkspaceMember{
+				{Username: "alice", Role: restworkspacesv1alpha1.WorkspaceRoleAdmin},
+				{Username: "bob", Role: restworkspacesv1alpha1.WorkspaceRoleViewer},
+			},
+		},
+		Status: restworkspacesv1alpha1.WorkspaceStatus{
+			Space: &restworkspacesv1alpha1.SpaceInfo{Name: "foo-space", TargetCluster: "https://member.cluster"},
+			Owner: &restworkspacesv1alpha1.UserInfoStatus{Email: "bar@example.com"},
+			Conditions: []metav1.Condition{{
+				Type:               "Ready",
+				Status:             metav1.ConditionTrue,
+				Reason:             "Ready",
+				LastTransitionTime: metav1.NewTime(time.Now().Truncate(time.Second)),
+			}},
+			ObservedGeneration: 3,
+		},
+	}
+
+	It("should round trip a Workspace", func() {
+		// when
+		d, err := marshal.ProtobufMarshal.Marshal(&w)
+		Expect(err).NotTo(HaveOccurred())
+
+		r := restworkspacesv1alpha1.Workspace{}
+		err = marshal.ProtobufUnmarshal.Unmarshal(d, &r)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(d[:4]).To(Equal([]byte("k8s\x00")))
+		Expect(r).To(Equal(w))
+	})
+
+	It("should round trip a WorkspaceList passed by value", func() {
+		// given
+		i := *w.DeepCopy()
+		i.TypeMeta = metav1.TypeMeta{}
+		l := restworkspacesv1alpha1.WorkspaceList{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "WorkspaceList",
+				APIVersion: restworkspacesv1alpha1.GroupVersion.String(),
+			},
+			ListMeta: metav1.ListMeta{Continue: "next"},
+			Items:    []restworkspacesv1alpha1.Workspace{i, i},
+		}
+
+		// when
+		d, err := marshal.ProtobufMarshal.Marshal(l)
+		Expect(err).NotTo(HaveOccurred())
+
+		r := restworkspacesv1alpha1.WorkspaceList{}
+		err = marshal.ProtobufUnmarshal.Unmarshal(d, &r)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r).To(Equal(l))
+	})
+
+	It("should round trip a WorkspaceEventList", func() {
+		// given
+		l := restworkspacesv1alpha1.WorkspaceEventList{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "WorkspaceEventList",
+				APIVersion: restworkspacesv1alpha1.GroupVersion.String(),
+			},
+			Items: []restworkspacesv1alpha1.WorkspaceEvent{{
+				ObjectMeta:    metav1.ObjectMeta{Name: "foo.1", Namespace: "bar"},
+				Type:          "Normal",
+				Reason:        "SpaceCreated",
+				Count:         2,
+				LastTimestamp: metav1.NewTime(time.Now().Truncate(time.Second)),
+			}},
+		}
+
+		// when
+		d, err := marshal.ProtobufMarshal.Marshal(&l)
+		Expect(err).NotTo(HaveOccurred())
+
+		r := restworkspacesv1alpha1.WorkspaceEventList{}
+		err = marshal.ProtobufUnmarshal.Unmarshal(d, &r)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r).To(Equal(l))
+	})
+
+	It("should be decoded by the Kubernetes protobuf serializer", func() {
+		// given
+		s := runtime.NewScheme()
+		Expect(restworkspacesv1alpha1.AddToScheme(s)).To(Succeed())
+		d, err := marshal.ProtobufMarshal.Marshal(&w)
+		Expect(err).NotTo(HaveOccurred())
+
+		// when
+		o, _, err := protobuf.NewSerializer(s, s).Decode(d, nil, nil)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(o).To(Equal(&w))
+	})
+
+	It("should refuse objects without a protobuf encoding", func() {
+		_, err := marshal.ProtobufMarshal.Marshal(map[string]string{"foo": "bar"})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should refuse data without the protobuf envelope", func() {
+		err := marshal.ProtobufUnmarshal.Unmarshal([]byte(`{"kind":"Workspace"}`), &restworkspacesv1alpha1.Workspace{})
+		Expect(err).To(HaveOccurred())
+	})
+})
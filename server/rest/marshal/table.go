@@ -0,0 +1,94 @@
+package marshal
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const ContentTypeTable string = "application/json;as=Table;v=v1;g=meta.k8s.io"
+
+// TableConvertor converts the object to marshal into a metav1.Table.
+// The Object of each row must contain the object the row refers to.
+type TableConvertor func(any) (*metav1.Table, error)
+
+// TableMarshaler marshals objects as a JSON metav1.Table, as the Kubernetes API Server does
+// when the client requests the server-side rendering of the objects, like `kubectl get` does
+type TableMarshaler struct {
+	Convertor     TableConvertor
+	IncludeObject metav1.IncludeObjectPolicy
+}
+
+func (m *TableMarshaler) Marshal(v any) ([]byte, error) {
+	t, err := m.Convertor(v)
+	if err != nil {
+		return nil, err
+	}
+
+	t.Kind = "Table"
+	t.APIVersion = metav1.SchemeGroupVersion.String()
+	for i, r := range t.Rows {
+		switch m.IncludeObject {
+		case metav1.IncludeObject:
+		case metav1.IncludeNone:
+			t.Rows[i].Object = runtime.RawExtension{}
+		default:
+			a, err := meta.Accessor(r.Object.Object)
+			if err != nil {
+				return nil, err
+			}
+			pom := meta.AsPartialObjectMetadata(a)
+			pom.Kind = "PartialObjectMetadata"
+			pom.APIVersion = metav1.SchemeGroupVersion.String()
+			t.Rows[i].Object = runtime.RawExtension{Object: pom}
+		}
+	}
+	return json.Marshal(t)
+}
+
+func (m *TableMarshaler) ContentType() string {
+	return ContentTypeTable
+}
+
+// NewTableMarshalerProvider returns a MarshalerProvider that builds a TableMarshaler using the given convertor
+// if the request accepts a meta.k8s.io/v1 Table, and falls back to the DefaultMarshalerProvider otherwise.
+// The `includeObject` query parameter controls which portion of the objects is included in the Table's rows.
+func NewTableMarshalerProvider(convertor TableConvertor) MarshalerProvider {
+	return func(r *http.Request) (Marshaler, error) {
+		if !acceptsTable(r) {
+			return DefaultMarshalerProvider(r)
+		}
+
+		io := metav1.IncludeObjectPolicy(r.URL.Query().Get("includeObject"))
+		switch io {
+		case "", metav1.IncludeNone, metav1.IncludeMetadata, metav1.IncludeObject:
+		default:
+			return nil, fmt.Errorf("invalid includeObject %q: must be one of %s, %s, or %s",
+				io, metav1.IncludeNone, metav1.IncludeMetadata, metav1.IncludeObject)
+		}
+
+		return &TableMarshaler{
+			Convertor:     convertor,
+			IncludeObject: io,
+		}, nil
+	}
+}
+
+// acceptsTable returns true if the first media type in the Accept header supported by the server is a meta.k8s.io/v1 Table
+func acceptsTable(r *http.Request) bool {
+	for _, a := range parseAccept(r) {
+		switch {
+		case a.mediaType == ContentTypeJson && a.params["as"] == "Table":
+			if a.params["g"] == metav1.GroupName && a.params["v"] == metav1.SchemeGroupVersion.Version {
+				return true
+			}
+		case a.params["as"] == "" && (a.matches(ContentTypeJson) || a.matches(ContentTypeYaml) || a.matches(ContentTypeProtobuf)):
+			return false
+		}
+	}
+	return false
+}
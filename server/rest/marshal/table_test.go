@@ -0,0 +1,123 @@
+package marshal_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+)
+
+var _ = Describe("Table", func() {
+	convertor := func(v any) (*metav1.Table, error) {
+		o, ok := v.(*metav1.PartialObjectMetadata)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type %T", v)
+		}
+		return &metav1.Table{
+			ColumnDefinitions: []metav1.TableColumnDefinition{{Name: "Name", Type: "string"}},
+			Rows: []metav1.TableRow{{
+				Cells:  []any{o.Name},
+				Object: runtime.RawExtension{Object: o},
+			}},
+		}, nil
+	}
+
+	Describe("NewTableMarshalerProvider", func() {
+		provider := marshal.NewTableMarshalerProvider(convertor)
+
+		DescribeTable("negotiates the content type from the Accept header", func(accept string, expected string) {
+			// given
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			if accept != "" {
+				r.Header.Set("Accept", accept)
+			}
+
+			// when
+			m, err := provider(r)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(m.ContentType()).To(Equal(expected))
+		},
+			Entry("no Accept header", "", marshal.ContentTypeJson),
+			Entry("JSON", "application/json", marshal.ContentTypeJson),
+			Entry("any", "*/*", marshal.ContentTypeJson),
+			Entry("Table", "application/json;as=Table;g=meta.k8s.io;v=v1", marshal.ContentTypeTable),
+			Entry("kubectl get",
+				"application/json;as=Table;v=v1;g=meta.k8s.io,application/json;as=Table;v=v1beta1;g=meta.k8s.io,application/json",
+				marshal.ContentTypeTable),
+			Entry("unsupported Table version", "application/json;as=Table;v=v1beta1;g=meta.k8s.io,application/json", marshal.ContentTypeJson),
+			Entry("JSON preferred over Table", "application/json,application/json;as=Table;v=v1;g=meta.k8s.io", marshal.ContentTypeJson),
+			Entry("YAML preferred over Table", "application/yaml,application/json;as=Table;v=v1;g=meta.k8s.io", marshal.ContentTypeYaml),
+		)
+
+		It("should refuse invalid includeObject values", func() {
+			// given
+			r := httptest.NewRequest(http.MethodGet, "/?includeObject=Everything", nil)
+			r.Header.Set("Accept", marshal.ContentTypeTable)
+
+			// when
+			_, err := provider(r)
+
+			// then
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("TableMarshaler", func() {
+		o := &metav1.PartialObjectMetadata{
+			TypeMeta:   metav1.TypeMeta{Kind: "Workspace", APIVersion: "workspaces.konflux-ci.dev/v1alpha1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"},
+		}
+
+		marshalTable := func(io metav1.IncludeObjectPolicy) (metav1.Table, map[string]any) {
+			m := marshal.TableMarshaler{Convertor: convertor, IncludeObject: io}
+			d, err := m.Marshal(o)
+			Expect(err).NotTo(HaveOccurred())
+
+			t := metav1.Table{}
+			Expect(json.Unmarshal(d, &t)).To(Succeed())
+			Expect(t.Kind).To(Equal("Table"))
+			Expect(t.APIVersion).To(Equal("meta.k8s.io/v1"))
+			Expect(t.Rows).To(HaveLen(1))
+			Expect(t.Rows[0].Cells).To(Equal([]any{"foo"}))
+
+			obj := map[string]any{}
+			if t.Rows[0].Object.Raw != nil {
+				Expect(json.Unmarshal(t.Rows[0].Object.Raw, &obj)).To(Succeed())
+			}
+			return t, obj
+		}
+
+		It("should include the objects' metadata by default", func() {
+			_, obj := marshalTable("")
+			Expect(obj).To(HaveKeyWithValue("kind", "PartialObjectMetadata"))
+			Expect(obj).To(HaveKeyWithValue("apiVersion", "meta.k8s.io/v1"))
+			Expect(obj).To(HaveKeyWithValue("metadata", HaveKeyWithValue("name", "foo")))
+		})
+
+		It("should include the whole objects if requested", func() {
+			_, obj := marshalTable(metav1.IncludeObject)
+			Expect(obj).To(HaveKeyWithValue("kind", "Workspace"))
+		})
+
+		It("should not include the objects if requested", func() {
+			t, _ := marshalTable(metav1.IncludeNone)
+			Expect(t.Rows[0].Object.Raw).To(Or(BeNil(), Equal([]byte("null"))))
+		})
+
+		It("should forward convertor errors", func() {
+			m := marshal.TableMarshaler{Convertor: convertor}
+			_, err := m.Marshal("not an object")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
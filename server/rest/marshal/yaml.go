@@ -0,0 +1,25 @@
+package marshal
+
+import "sigs.k8s.io/yaml"
+
+const ContentTypeYaml string = "application/yaml"
+
+type YamlMarshaler struct{}
+
+func (m *YamlMarshaler) Marshal(v any) ([]byte, error) {
+	return yaml.Marshal(v)
+}
+
+func (m *YamlMarshaler) ContentType() string {
+	return ContentTypeYaml
+}
+
+type YamlUnmarshaler struct{}
+
+func (m *YamlUnmarshaler) Unmarshal(d []byte, r any) error {
+	return yaml.Unmarshal(d, r)
+}
+
+func (m *YamlUnmarshaler) ContentType() string {
+	return ContentTypeYaml
+}
@@ -0,0 +1,54 @@
+package marshal_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("Yaml", func() {
+	It("should marshal using the JSON field names", func() {
+		// given
+		w := restworkspacesv1alpha1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec:       restworkspacesv1alpha1.WorkspaceSpec{Visibility: restworkspacesv1alpha1.WorkspaceVisibilityCommunity},
+		}
+
+		// when
+		d, err := marshal.YamlMarshal.Marshal(w)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(d)).To(ContainSubstring("visibility: community"))
+	})
+
+	It("should unmarshal a Workspace", func() {
+		// given
+		d := []byte(`
+apiVersion: workspaces.konflux-ci.dev/v1alpha1
+kind: Workspace
+metadata:
+  name: foo
+spec:
+  visibility: private
+  members:
+  - username: alice
+    role: admin
+`)
+
+		// when
+		w := restworkspacesv1alpha1.Workspace{}
+		err := marshal.YamlUnmarshal.Unmarshal(d, &w)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(w.Name).To(Equal("foo"))
+		Expect(w.Spec.Visibility).To(Equal(restworkspacesv1alpha1.WorkspaceVisibilityPrivate))
+		Expect(w.Spec.Members).To(ConsistOf(restworkspacesv1alpha1.WorkspaceMember{Username: "alice", Role: restworkspacesv1alpha1.WorkspaceRoleAdmin}))
+	})
+})
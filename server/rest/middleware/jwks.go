@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MicahParks/jwkset"
+	"github.com/MicahParks/keyfunc/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/time/rate"
+
+	"github.com/konflux-workspaces/workspaces/server/log"
+)
+
+const (
+	// DefaultJWKSMinRefreshInterval is the minimum time between two fetches of a remote JWKS
+	// triggered by tokens signed with an unknown key id
+	DefaultJWKSMinRefreshInterval = 1 * time.Minute
+	// DefaultJWKSRefreshInterval is the time between two periodic fetches of a remote JWKS
+	DefaultJWKSRefreshInterval = 1 * time.Hour
+	// DefaultJWKSRequestTimeout is the timeout of the requests to the remote JWKS endpoint
+	DefaultJWKSRequestTimeout = 10 * time.Second
+)
+
+// jwksUseWhitelist are the key uses accepted for verifying the tokens.
+// Keys not declaring their use are accepted too.
+var jwksUseWhitelist = []jwkset.USE{jwkset.UseSig, ""}
+
+// JWKS is the set of public keys used to verify the signature of JWTs.
+// Keys can be statically provided or fetched from a remote JWKS endpoint.
+// Remote key sets are refreshed periodically and when a token signed with
+// an unknown key id is received.
+type JWKS struct {
+	keyfunc keyfunc.Keyfunc
+}
+
+// NewStaticJWKS builds a JWKS from a static list of public keys
+func NewStaticJWKS(keys ...crypto.PublicKey) (*JWKS, error) {
+	s := jwkset.NewMemoryStorage()
+	for i, k := range keys {
+		jwk, err := jwkset.NewJWKFromKey(k, jwkset.JWKOptions{
+			Metadata: jwkset.JWKMetadataOptions{KID: fmt.Sprintf("static-%d", i)},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error building JWK from public key: %w", err)
+		}
+		if err := s.KeyWrite(context.Background(), jwk); err != nil {
+			return nil, err
+		}
+	}
+
+	return newJWKS(context.Background(), s)
+}
+
+// NewRemoteJWKS builds a JWKS fetching the keys from the provided JWKS endpoint.
+// The keys are refreshed in background until ctx is done.
+func NewRemoteJWKS(ctx context.Context, url string, client *http.Client) (*JWKS, error) {
+	if client == nil {
+		client = &http.Client{Timeout: DefaultJWKSRequestTimeout}
+	}
+
+	l := log.FromContext(ctx).With("url", url)
+	hs, err := jwkset.NewStorageFromHTTP(url, jwkset.HTTPClientStorageOptions{
+		Client:          client,
+		Ctx:             ctx,
+		HTTPTimeout:     DefaultJWKSRequestTimeout,
+		RefreshInterval: DefaultJWKSRefreshInterval,
+		RefreshErrorHandler: func(ctx context.Context, err error) {
+			l.ErrorContext(ctx, "error refreshing JWKS", "error", err)
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching JWKS: %w", err)
+	}
+
+	// Refreshes are rate limited, so that tokens with random key ids can not flood the JWKS endpoint
+	s, err := jwkset.NewHTTPClient(jwkset.HTTPClientOptions{
+		HTTPURLs:          map[string]jwkset.Storage{url: hs},
+		RefreshUnknownKID: rate.NewLimiter(rate.Every(DefaultJWKSMinRefreshInterval), 1),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching JWKS: %w", err)
+	}
+
+	return newJWKS(ctx, s)
+}
+
+func newJWKS(ctx context.Context, storage jwkset.Storage) (*JWKS, error) {
+	k, err := keyfunc.New(keyfunc.Options{
+		Ctx:          ctx,
+		Storage:      storage,
+		UseWhitelist: jwksUseWhitelist,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &JWKS{keyfunc: k}, nil
+}
+
+// KeyfuncCtx returns the jwt.Keyfunc retrieving the keys in the scope of ctx.
+// If the token declares a key id, the matching key is returned, refreshing the
+// remote key set if the key is unknown.
+// Otherwise, all the keys are returned and the token is verified against each of them.
+func (k *JWKS) KeyfuncCtx(ctx context.Context) jwt.Keyfunc {
+	return k.keyfunc.KeyfuncCtx(ctx)
+}
+
+// ParsePEMPublicKeys parses the PEM encoded public keys in data
+func ParsePEMPublicKeys(data []byte) ([]crypto.PublicKey, error) {
+	keys := []crypto.PublicKey{}
+	for {
+		var b *pem.Block
+		b, data = pem.Decode(data)
+		if b == nil {
+			break
+		}
+
+		k, err := x509.ParsePKIXPublicKey(b.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing public key: %w", err)
+		}
+		keys = append(keys, k)
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("no PEM encoded public key found")
+	}
+	return keys, nil
+}
@@ -0,0 +1,110 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
+	"github.com/konflux-workspaces/workspaces/server/log"
+	"github.com/konflux-workspaces/workspaces/server/rest/status"
+)
+
+var _ http.Handler = &JwtAuthMiddleware{}
+
+// JwtValidMethods are the signing methods accepted by the JwtAuthMiddleware
+var JwtValidMethods = []string{
+	jwt.SigningMethodRS256.Alg(), jwt.SigningMethodRS384.Alg(), jwt.SigningMethodRS512.Alg(),
+	jwt.SigningMethodPS256.Alg(), jwt.SigningMethodPS384.Alg(), jwt.SigningMethodPS512.Alg(),
+	jwt.SigningMethodES256.Alg(), jwt.SigningMethodES384.Alg(), jwt.SigningMethodES512.Alg(),
+}
+
+// JwtKeyLookupTimeout bounds the time spent retrieving the key verifying a token,
+// including the refresh of a remote JWKS when the token is signed with an unknown key
+const JwtKeyLookupTimeout = 10 * time.Second
+
+// KeyfuncCtx returns the jwt.Keyfunc retrieving the keys in the scope of ctx
+type KeyfuncCtx func(ctx context.Context) jwt.Keyfunc
+
+// JwtClaims are the claims the JwtAuthMiddleware extracts from the bearer token
+type JwtClaims struct {
+	jwt.RegisteredClaims
+
+	Email  string `json:"email,omitempty"`
+	UserID string `json:"user_id,omitempty"`
+}
+
+// JwtAuthMiddleware authenticates the request verifying its bearer JWT,
+// then adds the user's claims in request context and calls the next handler
+type JwtAuthMiddleware struct {
+	keyfunc KeyfuncCtx
+	parser  *jwt.Parser
+	next    http.Handler
+}
+
+// NewJwtAuthMiddleware builds a new JwtAuthMiddleware.
+// Tokens are verified with the key returned by keyfunc in the scope of the request, the expiration time is required.
+// Additional checks, like on the issuer or the audience, can be configured with opts.
+func NewJwtAuthMiddleware(next http.Handler, keyfunc KeyfuncCtx, opts ...jwt.ParserOption) *JwtAuthMiddleware {
+	oo := append([]jwt.ParserOption{
+		jwt.WithValidMethods(JwtValidMethods),
+		jwt.WithExpirationRequired(),
+	}, opts...)
+
+	return &JwtAuthMiddleware{
+		keyfunc: keyfunc,
+		parser:  jwt.NewParser(oo...),
+		next:    next,
+	}
+}
+
+// ServeHTTP authenticates the request then calls the next handler
+func (m *JwtAuthMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l := log.FromContext(r.Context())
+
+	t, ok := bearerToken(r)
+	if !ok {
+		l.Debug("missing bearer token")
+		status.WriteError(w, kerrors.NewUnauthorized("missing bearer token"))
+		return
+	}
+
+	c := JwtClaims{}
+	kctx, cancel := context.WithTimeout(r.Context(), JwtKeyLookupTimeout)
+	defer cancel()
+	if _, err := m.parser.ParseWithClaims(t, &c, m.keyfunc(kctx)); err != nil {
+		l.Debug("invalid bearer token", "error", err)
+		status.WriteError(w, kerrors.NewUnauthorized("invalid bearer token"))
+		return
+	}
+
+	if c.Subject == "" {
+		l.Debug("bearer token has no subject")
+		status.WriteError(w, kerrors.NewUnauthorized("invalid bearer token"))
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), ccontext.UserSubKey, c.Subject)
+	if c.Email != "" {
+		ctx = context.WithValue(ctx, ccontext.UserEmailKey, c.Email)
+	}
+	if c.UserID != "" {
+		ctx = context.WithValue(ctx, ccontext.UserIdKey, c.UserID)
+	}
+	m.next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	h := r.Header.Get("Authorization")
+	scheme, token, ok := strings.Cut(h, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
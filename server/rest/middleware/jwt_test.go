@@ -0,0 +1,280 @@
+package middleware_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
+	"github.com/konflux-workspaces/workspaces/server/rest/middleware"
+	"github.com/konflux-workspaces/workspaces/server/rest/middleware/mocks"
+)
+
+const (
+	testIssuer   = "https://sso.example.com"
+	testAudience = "workspaces"
+	testKid      = "test-key"
+)
+
+var _ = Describe("Jwt", func() {
+	var (
+		ctx context.Context
+
+		// keys
+		rsaKey *rsa.PrivateKey
+		rsaKid string
+		ecKey  *ecdsa.PrivateKey
+		jwks   *httptest.Server
+
+		// mocks
+		h *mocks.MockFakeHTTPHandler
+
+		// http
+		w *httptest.ResponseRecorder
+		r *http.Request
+
+		// middleware
+		m *middleware.JwtAuthMiddleware
+	)
+
+	signToken := func(method jwt.SigningMethod, key interface{}, kid string, claims jwt.MapClaims) string {
+		GinkgoHelper()
+		t := jwt.NewWithClaims(method, claims)
+		if kid != "" {
+			t.Header["kid"] = kid
+		}
+		s, err := t.SignedString(key)
+		Expect(err).NotTo(HaveOccurred())
+		return s
+	}
+
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"exp":     time.Now().Add(time.Hour).Unix(),
+			"iss":     testIssuer,
+			"aud":     testAudience,
+			"sub":     testUserSub,
+			"email":   "user@example.com",
+			"user_id": "test-user-id",
+		}
+	}
+
+	expectUnauthorized := func() {
+		GinkgoHelper()
+		Expect(w.Code).To(Equal(http.StatusUnauthorized))
+		s := metav1.Status{}
+		Expect(json.Unmarshal(w.Body.Bytes(), &s)).To(Succeed())
+		Expect(s.Reason).To(Equal(metav1.StatusReasonUnauthorized))
+	}
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+
+		// keys
+		var err error
+		rsaKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		ecKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		rsaKid = testKid
+		jwks = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"keys": []map[string]string{
+					{
+						"kid": rsaKid,
+						"kty": "RSA",
+						"use": "sig",
+						"n":   base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()),
+						"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(rsaKey.E)).Bytes()),
+					},
+					{
+						"kid": "test-ec-key",
+						"kty": "EC",
+						"crv": "P-256",
+						"x":   base64.RawURLEncoding.EncodeToString(ecKey.X.Bytes()),
+						"y":   base64.RawURLEncoding.EncodeToString(ecKey.Y.Bytes()),
+					},
+				},
+			})
+		}))
+		DeferCleanup(jwks.Close)
+
+		// mocks
+		ctrl := gomock.NewController(GinkgoT())
+		h = mocks.NewMockFakeHTTPHandler(ctrl)
+
+		// http
+		w = httptest.NewRecorder()
+		lr, err := http.NewRequestWithContext(ctx, methodGet, endpointWhatever, bytes.NewBuffer([]byte{}))
+		Expect(err).NotTo(HaveOccurred())
+		r = lr
+
+		// middleware
+		ks, err := middleware.NewRemoteJWKS(ctx, jwks.URL, jwks.Client())
+		Expect(err).NotTo(HaveOccurred())
+		m = middleware.NewJwtAuthMiddleware(h, ks.KeyfuncCtx,
+			jwt.WithIssuer(testIssuer),
+			jwt.WithAudience(testAudience),
+		)
+	})
+
+	When("the token is valid", func() {
+		DescribeTable("adds the claims to the context and invokes next handler",
+			func(method func() (jwt.SigningMethod, interface{}, string)) {
+				// given
+				sm, key, kid := method()
+				r.Header.Set("Authorization", "Bearer "+signToken(sm, key, kid, validClaims()))
+
+				// set expectations
+				h.EXPECT().
+					ServeHTTP(gomock.Any(), gomock.Any()).
+					Times(1).
+					Do(func(_ http.ResponseWriter, r *http.Request) {
+						Expect(r.Context().Value(ccontext.UserSubKey)).To(Equal(testUserSub))
+						Expect(r.Context().Value(ccontext.UserEmailKey)).To(Equal("user@example.com"))
+						Expect(r.Context().Value(ccontext.UserIdKey)).To(Equal("test-user-id"))
+					})
+
+				// when
+				m.ServeHTTP(w, r)
+			},
+			Entry("RSA key", func() (jwt.SigningMethod, interface{}, string) { return jwt.SigningMethodRS256, rsaKey, testKid }),
+			Entry("EC key", func() (jwt.SigningMethod, interface{}, string) { return jwt.SigningMethodES256, ecKey, "test-ec-key" }),
+			Entry("no key id", func() (jwt.SigningMethod, interface{}, string) { return jwt.SigningMethodRS512, rsaKey, "" }),
+		)
+	})
+
+	When("the keys are rotated", func() {
+		It("refreshes the keys and verifies the token", func() {
+			// given
+			rsaKid = "rotated-key"
+			r.Header.Set("Authorization", "Bearer "+signToken(jwt.SigningMethodRS256, rsaKey, rsaKid, validClaims()))
+
+			// set expectations
+			h.EXPECT().ServeHTTP(gomock.Any(), gomock.Any()).Times(1)
+
+			// when
+			m.ServeHTTP(w, r)
+		})
+	})
+
+	When("the token is not valid", func() {
+		BeforeEach(func() {
+			h.EXPECT().ServeHTTP(gomock.Any(), gomock.Any()).Times(0)
+		})
+
+		It("refuses requests without a bearer token", func() {
+			// when
+			m.ServeHTTP(w, r)
+
+			// then
+			expectUnauthorized()
+		})
+
+		It("refuses requests with a different authorization scheme", func() {
+			// given
+			r.SetBasicAuth("user", "password")
+
+			// when
+			m.ServeHTTP(w, r)
+
+			// then
+			expectUnauthorized()
+		})
+
+		DescribeTable("refuses invalid tokens",
+			func(tamper func(jwt.MapClaims) string) {
+				// given
+				r.Header.Set("Authorization", "Bearer "+tamper(validClaims()))
+
+				// when
+				m.ServeHTTP(w, r)
+
+				// then
+				expectUnauthorized()
+			},
+			Entry("expired", func(c jwt.MapClaims) string {
+				c["exp"] = time.Now().Add(-time.Hour).Unix()
+				return signToken(jwt.SigningMethodRS256, rsaKey, testKid, c)
+			}),
+			Entry("without expiration", func(c jwt.MapClaims) string {
+				delete(c, "exp")
+				return signToken(jwt.SigningMethodRS256, rsaKey, testKid, c)
+			}),
+			Entry("wrong issuer", func(c jwt.MapClaims) string {
+				c["iss"] = "https://evil.example.com"
+				return signToken(jwt.SigningMethodRS256, rsaKey, testKid, c)
+			}),
+			Entry("wrong audience", func(c jwt.MapClaims) string {
+				c["aud"] = "another-service"
+				return signToken(jwt.SigningMethodRS256, rsaKey, testKid, c)
+			}),
+			Entry("without subject", func(c jwt.MapClaims) string {
+				delete(c, "sub")
+				return signToken(jwt.SigningMethodRS256, rsaKey, testKid, c)
+			}),
+			Entry("unknown key id", func(c jwt.MapClaims) string {
+				return signToken(jwt.SigningMethodRS256, rsaKey, "unknown", c)
+			}),
+			Entry("signed with an unknown key", func(c jwt.MapClaims) string {
+				k, err := rsa.GenerateKey(rand.Reader, 2048)
+				Expect(err).NotTo(HaveOccurred())
+				return signToken(jwt.SigningMethodRS256, k, testKid, c)
+			}),
+			Entry("symmetric signature", func(c jwt.MapClaims) string {
+				return signToken(jwt.SigningMethodHS256, []byte("secret"), testKid, c)
+			}),
+			Entry("not signed", func(c jwt.MapClaims) string {
+				return signToken(jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "", c)
+			}),
+			Entry("malformed", func(c jwt.MapClaims) string {
+				return "not-a-jwt"
+			}),
+		)
+	})
+
+	When("keys are provided as PEM", func() {
+		It("verifies the tokens", func() {
+			// given
+			d, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+			Expect(err).NotTo(HaveOccurred())
+			keys, err := middleware.ParsePEMPublicKeys(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: d}))
+			Expect(err).NotTo(HaveOccurred())
+			ks, err := middleware.NewStaticJWKS(keys...)
+			Expect(err).NotTo(HaveOccurred())
+			m = middleware.NewJwtAuthMiddleware(h, ks.KeyfuncCtx)
+			r.Header.Set("Authorization", "Bearer "+signToken(jwt.SigningMethodRS512, rsaKey, "", validClaims()))
+
+			// set expectations
+			h.EXPECT().ServeHTTP(gomock.Any(), gomock.Any()).Times(1)
+
+			// when
+			m.ServeHTTP(w, r)
+		})
+
+		It("refuses invalid PEM data", func() {
+			// when
+			_, err := middleware.ParsePEMPublicKeys([]byte("not-a-pem"))
+
+			// then
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
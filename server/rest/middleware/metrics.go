@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/konflux-workspaces/workspaces/server/metrics"
+	"github.com/konflux-workspaces/workspaces/server/rest/request"
+)
+
+var _ http.Handler = &MetricsMiddleware{}
+
+// MetricsMiddleware measures the requests served by the next handler.
+// Requests are labeled with the route the next handler is registered for,
+// so that the cardinality of the metrics does not depend on the requested URLs.
+type MetricsMiddleware struct {
+	route string
+	next  http.Handler
+}
+
+// NewMetricsMiddleware builds a new MetricsMiddleware for the given route
+func NewMetricsMiddleware(next http.Handler, route string) *MetricsMiddleware {
+	return &MetricsMiddleware{
+		route: route,
+		next:  next,
+	}
+}
+
+// ServeHTTP calls the next handler and measures the request.
+// Watch requests are counted, but their duration is not observed, as it
+// depends on how long the client keeps the connection open.
+func (m *MetricsMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	sw := newStatusRecorder(w)
+
+	m.next.ServeHTTP(sw, r)
+
+	code := strconv.Itoa(sw.Status())
+	metrics.HTTPRequestsTotal.WithLabelValues(m.route, r.Method, code).Inc()
+	if !request.IsWatch(r) {
+		metrics.HTTPRequestDuration.WithLabelValues(m.route, r.Method, code).Observe(time.Since(start).Seconds())
+	}
+}
+
+// statusRecorder records the status code written by the handler.
+// It supports flushing, as required by watch requests.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func newStatusRecorder(w http.ResponseWriter) *statusRecorder {
+	return &statusRecorder{ResponseWriter: w}
+}
+
+// Status returns the status code written by the handler
+func (s *statusRecorder) Status() int {
+	if s.status == 0 {
+		return http.StatusOK
+	}
+	return s.status
+}
+
+func (s *statusRecorder) WriteHeader(code int) {
+	if s.status == 0 {
+		s.status = code
+	}
+	s.ResponseWriter.WriteHeader(code)
+}
+
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	if s.status == 0 {
+		s.status = http.StatusOK
+	}
+	return s.ResponseWriter.Write(b)
+}
+
+func (s *statusRecorder) Flush() {
+	if f, ok := s.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the wrapped ResponseWriter, as expected by http.ResponseController
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
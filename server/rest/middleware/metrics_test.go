@@ -0,0 +1,72 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/konflux-workspaces/workspaces/server/metrics"
+	"github.com/konflux-workspaces/workspaces/server/rest/middleware"
+)
+
+var _ = Describe("MetricsMiddleware", Label("middleware"), Label("metrics"), func() {
+	It("counts the requests by route, verb and status code", func() {
+		// given
+		route := "/metrics-test/{name}"
+		m := middleware.NewMetricsMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/metrics-test/missing" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte("ok"))
+		}), route)
+
+		// when
+		for _, p := range []string{"/metrics-test/a", "/metrics-test/b", "/metrics-test/missing"} {
+			m.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, p, nil))
+		}
+
+		// then
+		Expect(testutil.ToFloat64(metrics.HTTPRequestsTotal.WithLabelValues(route, http.MethodGet, "200"))).To(Equal(2.0))
+		Expect(testutil.ToFloat64(metrics.HTTPRequestsTotal.WithLabelValues(route, http.MethodGet, "404"))).To(Equal(1.0))
+		Expect(testutil.CollectAndCount(metrics.HTTPRequestDuration, metrics.KonfluxWorkspacesServerHTTPRequestDurationSeconds)).To(BeNumerically(">=", 2))
+	})
+
+	It("does not observe the duration of watch requests", func() {
+		// given
+		route := "/metrics-test/watched"
+		m := middleware.NewMetricsMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("ok"))
+		}), route)
+
+		// when
+		m.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, route+"?watch=true", nil))
+
+		// then
+		Expect(testutil.ToFloat64(metrics.HTTPRequestsTotal.WithLabelValues(route, http.MethodGet, "200"))).To(Equal(1.0))
+		Expect(metrics.HTTPRequestDuration.DeleteLabelValues(route, http.MethodGet, "200")).To(BeFalse())
+	})
+
+	It("allows the next handler to flush the response", func() {
+		// given
+		flushed := false
+		m := middleware.NewMetricsMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			f, ok := w.(http.Flusher)
+			Expect(ok).To(BeTrue())
+			f.Flush()
+			flushed = true
+		}), "/metrics-test/watch")
+		w := httptest.NewRecorder()
+
+		// when
+		m.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics-test/watch", nil))
+
+		// then
+		Expect(flushed).To(BeTrue())
+		Expect(w.Flushed).To(BeTrue())
+		Expect(testutil.ToFloat64(metrics.HTTPRequestsTotal.WithLabelValues("/metrics-test/watch", http.MethodGet, "200"))).To(Equal(1.0))
+	})
+})
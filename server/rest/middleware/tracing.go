@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/konflux-workspaces/workspaces/server/telemetry"
+)
+
+var _ http.Handler = &TracingMiddleware{}
+
+// TracingMiddleware wraps the next handler in a span named after the route
+// the next handler is registered for. The trace context propagated by the
+// client, if any, is used as the parent of the span.
+type TracingMiddleware struct {
+	route  string
+	tracer trace.Tracer
+	next   http.Handler
+}
+
+// NewTracingMiddleware builds a new TracingMiddleware for the given route
+// using the tracer of the given TracerProvider
+func NewTracingMiddleware(next http.Handler, route string, tracerProvider trace.TracerProvider) *TracingMiddleware {
+	return &TracingMiddleware{
+		route:  route,
+		tracer: tracerProvider.Tracer(telemetry.TracerName),
+		next:   next,
+	}
+}
+
+// ServeHTTP starts the span, calls the next handler and ends the span
+func (m *TracingMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+	ctx, span := m.tracer.Start(ctx, fmt.Sprintf("%s %s", r.Method, m.route),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(r.Method),
+			semconv.HTTPRoute(m.route),
+		))
+	defer span.End()
+
+	sw := newStatusRecorder(w)
+	m.next.ServeHTTP(sw, r.WithContext(ctx))
+
+	span.SetAttributes(semconv.HTTPResponseStatusCode(sw.Status()))
+	if sw.Status() >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, http.StatusText(sw.Status()))
+	}
+}
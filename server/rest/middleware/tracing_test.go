@@ -0,0 +1,92 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/konflux-workspaces/workspaces/server/rest/middleware"
+)
+
+var _ = Describe("TracingMiddleware", Label("middleware"), Label("tracing"), func() {
+	route := "/tracing-test/{name}"
+
+	var exporter *tracetest.InMemoryExporter
+	var tracerProvider *sdktrace.TracerProvider
+
+	BeforeEach(func() {
+		exporter = tracetest.NewInMemoryExporter()
+		tracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+	})
+
+	AfterEach(func() {
+		Expect(tracerProvider.Shutdown(context.Background())).To(Succeed())
+	})
+
+	It("wraps the request in a span named after the route", func() {
+		// given
+		var spanContext trace.SpanContext
+		m := middleware.NewTracingMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			spanContext = trace.SpanContextFromContext(r.Context())
+			w.WriteHeader(http.StatusNoContent)
+		}), route, tracerProvider)
+
+		// when
+		m.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/tracing-test/a", nil))
+
+		// then
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Name).To(Equal("GET /tracing-test/{name}"))
+		Expect(spans[0].SpanKind).To(Equal(trace.SpanKindServer))
+		Expect(spans[0].SpanContext.SpanID()).To(Equal(spanContext.SpanID()))
+		Expect(spans[0].Attributes).To(ContainElements(
+			semconv.HTTPRequestMethodKey.String(http.MethodGet),
+			semconv.HTTPRoute(route),
+			semconv.HTTPResponseStatusCode(http.StatusNoContent),
+		))
+		Expect(spans[0].Status.Code).To(Equal(codes.Unset))
+	})
+
+	It("continues the trace propagated by the client", func() {
+		// given
+		m := middleware.NewTracingMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}), route, tracerProvider)
+		request := httptest.NewRequest(http.MethodGet, "/tracing-test/a", nil)
+		request.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+		// when
+		m.ServeHTTP(httptest.NewRecorder(), request)
+
+		// then
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].SpanContext.TraceID().String()).To(Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+		Expect(spans[0].Parent.SpanID().String()).To(Equal("00f067aa0ba902b7"))
+	})
+
+	It("marks the span as failed on server errors", func() {
+		// given
+		m := middleware.NewTracingMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}), route, tracerProvider)
+
+		// when
+		m.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/tracing-test/a", nil))
+
+		// then
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Status.Code).To(Equal(codes.Error))
+	})
+})
@@ -3,14 +3,33 @@ package middleware
 import (
 	"context"
 	"net/http"
+	"slices"
+	"strings"
 
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
+	"github.com/konflux-workspaces/workspaces/server/metrics"
+	"github.com/konflux-workspaces/workspaces/server/persistence/clientinterface"
+	"github.com/konflux-workspaces/workspaces/server/rest/status"
 
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
 )
 
+// Reasons of the metav1.Status returned when a user is not allowed to access the API
+const (
+	StatusReasonUserNotSignedUp metav1.StatusReason = "UserNotSignedUp"
+	StatusReasonUserNotApproved metav1.StatusReason = "UserNotApproved"
+	StatusReasonUserDeactivated metav1.StatusReason = "UserDeactivated"
+	StatusReasonUserBanned      metav1.StatusReason = "UserBanned"
+)
+
+// UserSignupMiddleware retrieves the UserSignup of the authenticated user, refuses the
+// request if the user is not allowed to access the API, otherwise adds the user's
+// CompliantUsername in request context and calls the next handler
 type UserSignupMiddleware struct {
 	cache cache.Cache
 
@@ -33,46 +52,111 @@ func (m *UserSignupMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// retrieve UserSignup for given sub
-	us, err := m.lookupUserSignup(r.Context(), u)
+	// retrieve UserSignup for given claims
+	id, _ := r.Context().Value(ccontext.UserIdKey).(string)
+	us, err := m.lookupUserSignup(r.Context(), u, id)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
+		metrics.UserSignupLookupFailures.WithLabelValues(metrics.UserSignupLookupFailureReasonError).Inc()
+		status.WriteError(w, err)
 		return
 	}
 
 	if us == nil {
-		w.WriteHeader(http.StatusForbidden)
-		if _, err := w.Write([]byte("user needs to sign in")); err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-		}
+		metrics.UserSignupLookupFailures.WithLabelValues(metrics.UserSignupLookupFailureReasonNotFound).Inc()
+		status.WriteError(w, forbidden(StatusReasonUserNotSignedUp, "user needs to sign in"))
+		return
+	}
+
+	// user is banned
+	banned, err := m.isBanned(r.Context(), us)
+	if err != nil {
+		status.WriteError(w, err)
+		return
+	}
+	if banned {
+		status.WriteError(w, forbidden(StatusReasonUserBanned, "user is banned"))
+		return
+	}
+
+	// user is deactivated
+	if isUserSignupInState(us, toolchainv1alpha1.UserSignupStateDeactivated, toolchainv1alpha1.UserSignupStateLabelValueDeactivated) {
+		status.WriteError(w, forbidden(StatusReasonUserDeactivated, "user is deactivated"))
 		return
 	}
 
 	// user is waiting for approval
 	if us.Status.CompliantUsername == "" {
-		w.WriteHeader(http.StatusForbidden)
-		if _, err := w.Write([]byte("user is waiting for approval")); err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-		}
+		status.WriteError(w, forbidden(StatusReasonUserNotApproved, "user is waiting for approval"))
 		return
 	}
 
-	// TODO(@filariow): check if user is deactivated or banned
-
 	// inject the userSignup.ComplaintUsername
 	ctx := context.WithValue(r.Context(), ccontext.UserSignupComplaintNameKey, us.Status.CompliantUsername)
 	m.next.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (m *UserSignupMiddleware) lookupUserSignup(ctx context.Context, sub string) (*toolchainv1alpha1.UserSignup, error) {
-	uu := toolchainv1alpha1.UserSignupList{}
-	if err := m.cache.List(ctx, &uu); err != nil {
-		return nil, err
+// isBanned checks if the UserSignup is banned or if a BannedUser exists for the user's email
+func (m *UserSignupMiddleware) isBanned(ctx context.Context, us *toolchainv1alpha1.UserSignup) (bool, error) {
+	if isUserSignupInState(us, toolchainv1alpha1.UserSignupStateBanned, toolchainv1alpha1.UserSignupStateLabelValueBanned) {
+		return true, nil
+	}
+
+	email := us.Spec.IdentityClaims.Email
+	if email == "" {
+		return false, nil
+	}
+
+	// as KubeSaw does, BannedUsers are looked up by the email hash
+	opts := []client.ListOption{}
+	if h, ok := us.GetLabels()[toolchainv1alpha1.UserSignupUserEmailHashLabelKey]; ok {
+		opts = append(opts, client.MatchingLabels{toolchainv1alpha1.BannedUserEmailHashLabelKey: h})
+	}
+
+	bb := toolchainv1alpha1.BannedUserList{}
+	if err := m.cache.List(ctx, &bb, opts...); err != nil {
+		return false, err
+	}
+	return slices.ContainsFunc(bb.Items, func(b toolchainv1alpha1.BannedUser) bool {
+		return strings.EqualFold(b.Spec.Email, email)
+	}), nil
+}
+
+// isUserSignupInState checks both the UserSignup's states and its state label
+func isUserSignupInState(us *toolchainv1alpha1.UserSignup, state toolchainv1alpha1.UserSignupState, label string) bool {
+	return slices.Contains(us.Spec.States, state) ||
+		us.GetLabels()[toolchainv1alpha1.UserSignupStateLabelKey] == label
+}
+
+func forbidden(reason metav1.StatusReason, message string) error {
+	return &kerrors.StatusError{ErrStatus: metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusForbidden,
+		Reason:  reason,
+		Message: message,
+	}}
+}
+
+// lookupUserSignup looks for the UserSignup matching the user's sub.
+// If no UserSignup matches the sub, the lookup falls back to the user id claim, when provided.
+// The email claim is not looked up, as a token with an unknown sub would otherwise
+// authenticate as the owner of whichever UserSignup has the same email.
+func (m *UserSignupMiddleware) lookupUserSignup(ctx context.Context, sub, userID string) (*toolchainv1alpha1.UserSignup, error) {
+	lookups := []struct{ key, value string }{
+		{key: clientinterface.IndexKeyUserSignupSub, value: sub},
+		{key: clientinterface.IndexKeyUserSignupUserID, value: userID},
 	}
 
-	for _, u := range uu.Items {
-		if u.Spec.IdentityClaims.Sub == sub {
-			return &u, nil
+	for _, l := range lookups {
+		if l.value == "" {
+			continue
+		}
+
+		uu := toolchainv1alpha1.UserSignupList{}
+		if err := m.cache.List(ctx, &uu, client.MatchingFields{l.key: l.value}); err != nil {
+			return nil, err
+		}
+		if len(uu.Items) > 0 {
+			return &uu.Items[0], nil
 		}
 	}
 
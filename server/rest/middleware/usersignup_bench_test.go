@@ -0,0 +1,153 @@
+package middleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+
+	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
+	"github.com/konflux-workspaces/workspaces/server/persistence/clientinterface"
+	"github.com/konflux-workspaces/workspaces/server/rest/middleware"
+)
+
+var benchmarkUserSignupsCount = []int{100, 1_000, 10_000}
+
+// BenchmarkUserSignupMiddleware measures the UserSignup lookup performed on each request
+// against a cache holding an increasing number of UserSignups
+func BenchmarkUserSignupMiddleware(b *testing.B) {
+	for _, n := range benchmarkUserSignupsCount {
+		c := newBenchmarkCache(b, n)
+		sub := fmt.Sprintf("sub-%d", n-1)
+		ctx := context.WithValue(context.Background(), ccontext.UserSubKey, sub)
+		r := httptest.NewRequest(http.MethodGet, "/whatever", nil).WithContext(ctx)
+		m := middleware.NewUserSignupMiddleware(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}), c)
+
+		b.Run(fmt.Sprintf("indexed/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				w := httptest.NewRecorder()
+				m.ServeHTTP(w, r)
+				if w.Code != http.StatusOK {
+					b.Fatalf("unexpected status code %d", w.Code)
+				}
+			}
+		})
+
+		// the lookup as it was performed before introducing the indexers
+		b.Run(fmt.Sprintf("scan/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				uu := toolchainv1alpha1.UserSignupList{}
+				if err := c.List(ctx, &uu); err != nil {
+					b.Fatal(err)
+				}
+				found := false
+				for _, u := range uu.Items {
+					if u.Spec.IdentityClaims.Sub == sub {
+						found = true
+						break
+					}
+				}
+				if !found {
+					b.Fatal("usersignup not found")
+				}
+			}
+		})
+	}
+}
+
+// newBenchmarkCache returns a started cache filled with n approved UserSignups.
+// The cache is backed by a fake API Server only serving UserSignups and BannedUsers.
+func newBenchmarkCache(b *testing.B, n int) cache.Cache {
+	b.Helper()
+
+	uu := toolchainv1alpha1.UserSignupList{
+		TypeMeta: metav1.TypeMeta{APIVersion: toolchainv1alpha1.GroupVersion.String(), Kind: "UserSignupList"},
+		ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+		Items:    make([]toolchainv1alpha1.UserSignup, n),
+	}
+	for i := range uu.Items {
+		u := &uu.Items[i]
+		u.Name = fmt.Sprintf("user-%d", i)
+		u.Namespace = "toolchain-host-operator"
+		u.ResourceVersion = "1"
+		u.Spec.IdentityClaims.Sub = fmt.Sprintf("sub-%d", i)
+		u.Spec.IdentityClaims.UserID = fmt.Sprintf("user-id-%d", i)
+		u.Status.CompliantUsername = u.Name
+	}
+	bb := toolchainv1alpha1.BannedUserList{
+		TypeMeta: metav1.TypeMeta{APIVersion: toolchainv1alpha1.GroupVersion.String(), Kind: "BannedUserList"},
+		ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+	}
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// watches are kept open without sending any event
+		if r.URL.Query().Get("watch") == "true" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			return
+		}
+
+		var l runtime.Object
+		switch r.URL.Path {
+		case "/apis/toolchain.dev.openshift.com/v1alpha1/usersignups":
+			l = &uu
+		case "/apis/toolchain.dev.openshift.com/v1alpha1/bannedusers":
+			l = &bb
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(l)
+	}))
+	b.Cleanup(s.Close)
+
+	sc := runtime.NewScheme()
+	if err := toolchainv1alpha1.AddToScheme(sc); err != nil {
+		b.Fatal(err)
+	}
+	m := meta.NewDefaultRESTMapper(nil)
+	m.Add(toolchainv1alpha1.GroupVersion.WithKind("UserSignup"), meta.RESTScopeNamespace)
+	m.Add(toolchainv1alpha1.GroupVersion.WithKind("BannedUser"), meta.RESTScopeNamespace)
+
+	c, err := cache.New(&rest.Config{Host: s.URL}, cache.Options{Scheme: sc, Mapper: m})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	b.Cleanup(cancel)
+	indexers := map[string]func(*toolchainv1alpha1.UserSignup) string{
+		clientinterface.IndexKeyUserSignupSub:    func(u *toolchainv1alpha1.UserSignup) string { return u.Spec.IdentityClaims.Sub },
+		clientinterface.IndexKeyUserSignupUserID: func(u *toolchainv1alpha1.UserSignup) string { return u.Spec.IdentityClaims.UserID },
+	}
+	for k, f := range indexers {
+		if err := c.IndexField(ctx, &toolchainv1alpha1.UserSignup{}, k, func(o client.Object) []string {
+			return []string{f(o.(*toolchainv1alpha1.UserSignup))}
+		}); err != nil {
+			b.Fatal(err)
+		}
+	}
+	if _, err := c.GetInformer(ctx, &toolchainv1alpha1.BannedUser{}); err != nil {
+		b.Fatal(err)
+	}
+
+	go func() { _ = c.Start(ctx) }()
+	if !c.WaitForCacheSync(ctx) {
+		b.Fatal("error synching cache")
+	}
+	return c
+}
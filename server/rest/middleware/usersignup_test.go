@@ -3,20 +3,25 @@ package middleware_test
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"go.uber.org/mock/gomock"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
 
 	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
+	"github.com/konflux-workspaces/workspaces/server/metrics"
+	"github.com/konflux-workspaces/workspaces/server/persistence/clientinterface"
 	"github.com/konflux-workspaces/workspaces/server/rest/middleware"
 	"github.com/konflux-workspaces/workspaces/server/rest/middleware/mocks"
 )
@@ -99,30 +104,38 @@ var _ = Describe("Usersignup", func() {
 
 		It("requires an usersignup", func() {
 			// set expectations
-			c.EXPECT().List(gomock.Any(), gomock.Any()).Times(1)
+			c.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any()).
+				Times(1).
+				DoAndReturn(listUserSignups())
 			h.EXPECT().ServeHTTP(gomock.Any(), gomock.Any()).Times(0)
+			failures := metrics.UserSignupLookupFailures.WithLabelValues(metrics.UserSignupLookupFailureReasonNotFound)
+			before := testutil.ToFloat64(failures)
 
 			// when
 			m.ServeHTTP(w, r.WithContext(ctx))
 
 			// then
-			Expect(w.Code).To(Equal(http.StatusForbidden))
-			Expect(w.Body.String()).To(Equal("user needs to sign in"))
+			expectForbidden(w, middleware.StatusReasonUserNotSignedUp, "user needs to sign in")
+			Expect(testutil.ToFloat64(failures)).To(Equal(before + 1))
 		})
 
 		It("requires the usersignup fetch to complete successfully", func() {
 			// set expectations
 			c.EXPECT().
-				List(gomock.Any(), gomock.Any()).
+				List(gomock.Any(), gomock.Any(), gomock.Any()).
 				Times(1).
 				Return(fmt.Errorf("error"))
 			h.EXPECT().ServeHTTP(gomock.Any(), gomock.Any()).Times(0)
+			failures := metrics.UserSignupLookupFailures.WithLabelValues(metrics.UserSignupLookupFailureReasonError)
+			before := testutil.ToFloat64(failures)
 
 			// when
 			m.ServeHTTP(w, r.WithContext(ctx))
 
 			// then
 			Expect(w.Code).To(Equal(http.StatusInternalServerError))
+			Expect(testutil.ToFloat64(failures)).To(Equal(before + 1))
 		})
 
 		It("requires the usersignup to be approved", func() {
@@ -130,39 +143,31 @@ var _ = Describe("Usersignup", func() {
 			c.EXPECT().
 				List(gomock.Any(), gomock.Any(), gomock.Any()).
 				Times(1).
-				DoAndReturn(
-					func(_ context.Context, list *toolchainv1alpha1.UserSignupList, _ ...client.ListOption) error {
-						*list = toolchainv1alpha1.UserSignupList{
-							Items: []toolchainv1alpha1.UserSignup{
-								{
-									ObjectMeta: metav1.ObjectMeta{
-										Name:      "test-user",
-										Namespace: "toolchain-host-operator",
-									},
-									Spec: toolchainv1alpha1.UserSignupSpec{
-										IdentityClaims: toolchainv1alpha1.IdentityClaimsEmbedded{
-											PropagatedClaims: toolchainv1alpha1.PropagatedClaims{
-												Sub: testUserSub,
-											},
-										},
-									},
-									Status: toolchainv1alpha1.UserSignupStatus{
-										// CompliantUsername: "test-user",
-									},
+				DoAndReturn(listUserSignups(
+					toolchainv1alpha1.UserSignup{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "test-user",
+							Namespace: "toolchain-host-operator",
+						},
+						Spec: toolchainv1alpha1.UserSignupSpec{
+							IdentityClaims: toolchainv1alpha1.IdentityClaimsEmbedded{
+								PropagatedClaims: toolchainv1alpha1.PropagatedClaims{
+									Sub: testUserSub,
 								},
 							},
-						}
-						return nil
+						},
+						Status: toolchainv1alpha1.UserSignupStatus{
+							// CompliantUsername: "test-user",
+						},
 					},
-				)
+				))
 			h.EXPECT().ServeHTTP(gomock.Any(), gomock.Any()).Times(0)
 
 			// when
 			m.ServeHTTP(w, r.WithContext(ctx))
 
 			// then
-			Expect(w.Code).To(Equal(http.StatusForbidden))
-			Expect(w.Body.String()).To(Equal("user is waiting for approval"))
+			expectForbidden(w, middleware.StatusReasonUserNotApproved, "user is waiting for approval")
 		})
 
 		It("succeeds when ComplaintName is set", func() {
@@ -170,31 +175,24 @@ var _ = Describe("Usersignup", func() {
 			c.EXPECT().
 				List(gomock.Any(), gomock.Any(), gomock.Any()).
 				Times(1).
-				DoAndReturn(
-					func(_ context.Context, list *toolchainv1alpha1.UserSignupList, _ ...client.ListOption) error {
-						*list = toolchainv1alpha1.UserSignupList{
-							Items: []toolchainv1alpha1.UserSignup{
-								{
-									ObjectMeta: metav1.ObjectMeta{
-										Name:      "test-user",
-										Namespace: "toolchain-host-operator",
-									},
-									Spec: toolchainv1alpha1.UserSignupSpec{
-										IdentityClaims: toolchainv1alpha1.IdentityClaimsEmbedded{
-											PropagatedClaims: toolchainv1alpha1.PropagatedClaims{
-												Sub: testUserSub,
-											},
-										},
-									},
-									Status: toolchainv1alpha1.UserSignupStatus{
-										CompliantUsername: "test-user",
-									},
+				DoAndReturn(listUserSignups(
+					toolchainv1alpha1.UserSignup{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "test-user",
+							Namespace: "toolchain-host-operator",
+						},
+						Spec: toolchainv1alpha1.UserSignupSpec{
+							IdentityClaims: toolchainv1alpha1.IdentityClaimsEmbedded{
+								PropagatedClaims: toolchainv1alpha1.PropagatedClaims{
+									Sub: testUserSub,
 								},
 							},
-						}
-						return nil
+						},
+						Status: toolchainv1alpha1.UserSignupStatus{
+							CompliantUsername: "test-user",
+						},
 					},
-				)
+				))
 			h.EXPECT().
 				ServeHTTP(gomock.Any(), gomock.Any()).
 				Times(1).
@@ -217,5 +215,222 @@ var _ = Describe("Usersignup", func() {
 			Expect(w.Code).To(Equal(999))
 			Expect(w.Body.String()).To(BeZero())
 		})
+
+		It("falls back to the user id when no usersignup matches the sub", func() {
+			// given
+			ctx = context.WithValue(ctx, ccontext.UserIdKey, "test-user-id")
+
+			// set expectations
+			c.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any()).
+				Times(2).
+				DoAndReturn(listUserSignups(
+					toolchainv1alpha1.UserSignup{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "test-user",
+							Namespace: "toolchain-host-operator",
+						},
+						Spec: toolchainv1alpha1.UserSignupSpec{
+							IdentityClaims: toolchainv1alpha1.IdentityClaimsEmbedded{
+								PropagatedClaims: toolchainv1alpha1.PropagatedClaims{
+									Sub:    "another-sub",
+									UserID: "test-user-id",
+								},
+							},
+						},
+						Status: toolchainv1alpha1.UserSignupStatus{
+							CompliantUsername: "test-user",
+						},
+					},
+				))
+			h.EXPECT().
+				ServeHTTP(gomock.Any(), gomock.Any()).
+				Times(1).
+				DoAndReturn(
+					func(_ http.ResponseWriter, r *http.Request) {
+						u, ok := r.Context().Value(ccontext.UserSignupComplaintNameKey).(string)
+						Expect(ok).To(BeTrue(), "expecting UserSignup.ComplaintName to be forwarded in the context")
+						Expect(u).To(Equal("test-user"))
+					},
+				)
+
+			// when
+			m.ServeHTTP(w, r.WithContext(ctx))
+		})
+
+		It("does not fall back to the email when no usersignup matches the sub nor the user id", func() {
+			// given
+			ctx = context.WithValue(ctx, ccontext.UserIdKey, "test-user-id")
+			ctx = context.WithValue(ctx, ccontext.UserEmailKey, "test-user@example.com")
+
+			// set expectations
+			c.EXPECT().
+				List(gomock.Any(), gomock.AssignableToTypeOf(&toolchainv1alpha1.UserSignupList{}), gomock.Any()).
+				Times(2).
+				DoAndReturn(listUserSignups(
+					toolchainv1alpha1.UserSignup{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "test-user",
+							Namespace: "toolchain-host-operator",
+						},
+						Spec: toolchainv1alpha1.UserSignupSpec{
+							IdentityClaims: toolchainv1alpha1.IdentityClaimsEmbedded{
+								PropagatedClaims: toolchainv1alpha1.PropagatedClaims{
+									Sub:    "another-sub",
+									UserID: "another-user-id",
+									Email:  "test-user@example.com",
+								},
+							},
+						},
+						Status: toolchainv1alpha1.UserSignupStatus{
+							CompliantUsername: "test-user",
+						},
+					},
+				))
+			h.EXPECT().ServeHTTP(gomock.Any(), gomock.Any()).Times(0)
+
+			// when
+			m.ServeHTTP(w, r.WithContext(ctx))
+
+			// then
+			expectForbidden(w, middleware.StatusReasonUserNotSignedUp, "user needs to sign in")
+		})
+
+		When("the user is not allowed to access the API", func() {
+			var us toolchainv1alpha1.UserSignup
+			var bb []toolchainv1alpha1.BannedUser
+
+			BeforeEach(func() {
+				bb = nil
+				us = toolchainv1alpha1.UserSignup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-user",
+						Namespace: "toolchain-host-operator",
+						Labels: map[string]string{
+							toolchainv1alpha1.UserSignupUserEmailHashLabelKey: "email-hash",
+						},
+					},
+					Spec: toolchainv1alpha1.UserSignupSpec{
+						IdentityClaims: toolchainv1alpha1.IdentityClaimsEmbedded{
+							PropagatedClaims: toolchainv1alpha1.PropagatedClaims{
+								Sub:   testUserSub,
+								Email: "test-user@example.com",
+							},
+						},
+					},
+					Status: toolchainv1alpha1.UserSignupStatus{
+						CompliantUsername: "test-user",
+					},
+				}
+
+				c.EXPECT().
+					List(gomock.Any(), gomock.Any(), gomock.Any()).
+					AnyTimes().
+					DoAndReturn(
+						func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+							switch l := list.(type) {
+							case *toolchainv1alpha1.UserSignupList:
+								return listUserSignups(us)(ctx, l, opts...)
+							case *toolchainv1alpha1.BannedUserList:
+								lo := client.ListOptions{}
+								lo.ApplyOptions(opts)
+								Expect(lo.LabelSelector.String()).To(Equal(toolchainv1alpha1.BannedUserEmailHashLabelKey + "=email-hash"))
+								l.Items = bb
+							default:
+								Fail(fmt.Sprintf("unexpected list type %T", list))
+							}
+							return nil
+						},
+					)
+				h.EXPECT().ServeHTTP(gomock.Any(), gomock.Any()).Times(0)
+			})
+
+			It("refuses deactivated users", func() {
+				// given
+				us.Spec.States = []toolchainv1alpha1.UserSignupState{toolchainv1alpha1.UserSignupStateDeactivated}
+
+				// when
+				m.ServeHTTP(w, r.WithContext(ctx))
+
+				// then
+				expectForbidden(w, middleware.StatusReasonUserDeactivated, "user is deactivated")
+			})
+
+			It("refuses users labeled as deactivated", func() {
+				// given
+				us.Labels[toolchainv1alpha1.UserSignupStateLabelKey] = toolchainv1alpha1.UserSignupStateLabelValueDeactivated
+
+				// when
+				m.ServeHTTP(w, r.WithContext(ctx))
+
+				// then
+				expectForbidden(w, middleware.StatusReasonUserDeactivated, "user is deactivated")
+			})
+
+			It("refuses banned users", func() {
+				// given
+				us.Spec.States = []toolchainv1alpha1.UserSignupState{toolchainv1alpha1.UserSignupStateBanned}
+
+				// when
+				m.ServeHTTP(w, r.WithContext(ctx))
+
+				// then
+				expectForbidden(w, middleware.StatusReasonUserBanned, "user is banned")
+			})
+
+			It("refuses users with a BannedUser", func() {
+				// given
+				bb = []toolchainv1alpha1.BannedUser{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "banned",
+							Namespace: "toolchain-host-operator",
+							Labels: map[string]string{
+								toolchainv1alpha1.BannedUserEmailHashLabelKey: "email-hash",
+							},
+						},
+						Spec: toolchainv1alpha1.BannedUserSpec{Email: "Test-User@example.com"},
+					},
+				}
+
+				// when
+				m.ServeHTTP(w, r.WithContext(ctx))
+
+				// then
+				expectForbidden(w, middleware.StatusReasonUserBanned, "user is banned")
+			})
+		})
 	})
 })
+
+// listUserSignups returns a fake List implementation returning the
+// UserSignups matching the field selector, as the indexed cache would do
+func listUserSignups(uu ...toolchainv1alpha1.UserSignup) func(context.Context, *toolchainv1alpha1.UserSignupList, ...client.ListOption) error {
+	return func(_ context.Context, list *toolchainv1alpha1.UserSignupList, opts ...client.ListOption) error {
+		lo := client.ListOptions{}
+		lo.ApplyOptions(opts)
+		Expect(lo.FieldSelector).NotTo(BeNil(), "expecting UserSignups to be looked up by index")
+
+		list.Items = nil
+		for _, u := range uu {
+			c := u.Spec.IdentityClaims.PropagatedClaims
+			if lo.FieldSelector.Matches(fields.Set{
+				clientinterface.IndexKeyUserSignupSub:    c.Sub,
+				clientinterface.IndexKeyUserSignupUserID: c.UserID,
+			}) {
+				list.Items = append(list.Items, u)
+			}
+		}
+		return nil
+	}
+}
+
+func expectForbidden(w *httptest.ResponseRecorder, reason metav1.StatusReason, message string) {
+	GinkgoHelper()
+
+	Expect(w.Code).To(Equal(http.StatusForbidden))
+	s := metav1.Status{}
+	Expect(json.Unmarshal(w.Body.Bytes(), &s)).To(Succeed())
+	Expect(s.Reason).To(Equal(reason))
+	Expect(s.Message).To(Equal(message))
+}
@@ -0,0 +1,196 @@
+package openapi
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	openapi_v2 "github.com/google/gnostic-models/openapiv2"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/managedfields"
+	"k8s.io/kube-openapi/pkg/builder"
+	"k8s.io/kube-openapi/pkg/builder3"
+	"k8s.io/kube-openapi/pkg/common"
+	"k8s.io/kube-openapi/pkg/common/restfuladapter"
+	"k8s.io/kube-openapi/pkg/handler3"
+	"k8s.io/kube-openapi/pkg/spec3"
+	"k8s.io/kube-openapi/pkg/util"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+
+	"github.com/konflux-workspaces/workspaces/server/rest/header"
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+
+	generatedopenapi "github.com/konflux-workspaces/workspaces/server/api/openapi"
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+const (
+	// V2Path is the path the OpenAPI v2 document is served at
+	V2Path string = "/openapi/v2"
+	// V3Path is the path the OpenAPI v3 discovery document is served at
+	V3Path string = "/openapi/v3"
+
+	// ContentTypeProtobufV2 is the content type of the OpenAPI v2 document encoded in protobuf
+	ContentTypeProtobufV2 string = "application/com.github.proto-openapi.spec.v2@v1.0+protobuf"
+
+	// extensionGVK is the extension used by kubectl to find the schema of a kind
+	extensionGVK string = "x-kubernetes-group-version-kind"
+)
+
+// GroupVersionPath is the path of the workspaces group version OpenAPI v3 document
+var GroupVersionPath = V3Path + "/apis/" + restworkspacesv1alpha1.GroupVersion.String()
+
+// BuildV2Spec builds the OpenAPI v2 document describing the REST API
+func BuildV2Spec() (*spec.Swagger, error) {
+	n, err := newDefinitionNamer()
+	if err != nil {
+		return nil, err
+	}
+
+	return builder.BuildOpenAPISpecFromRoutes(restfuladapter.AdaptWebServices(webServices()), &common.Config{
+		Info:              info(),
+		GetDefinitions:    generatedopenapi.GetOpenAPIDefinitions,
+		GetDefinitionName: n.GetDefinitionName,
+	})
+}
+
+// BuildV3Spec builds the OpenAPI v3 document describing the workspaces group version
+func BuildV3Spec() (*spec3.OpenAPI, error) {
+	n, err := newDefinitionNamer()
+	if err != nil {
+		return nil, err
+	}
+
+	return builder3.BuildOpenAPISpecFromRoutes(restfuladapter.AdaptWebServices(webServices()), &common.OpenAPIV3Config{
+		Info:              info(),
+		GetDefinitions:    generatedopenapi.GetOpenAPIDefinitions,
+		GetDefinitionName: n.GetDefinitionName,
+	})
+}
+
+// V2Handler the http.Request handler for the OpenAPI v2 endpoint.
+// The document is served as JSON or, if requested, as protobuf.
+type V2Handler struct {
+	json         []byte
+	protobuf     []byte
+	etag         string
+	lastModified time.Time
+}
+
+// NewV2Handler creates a V2Handler serving the given OpenAPI v2 document
+func NewV2Handler(s *spec.Swagger) (*V2Handler, error) {
+	j, err := json.Marshal(s)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling OpenAPI v2 document: %w", err)
+	}
+
+	d, err := openapi_v2.ParseDocument(j)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing OpenAPI v2 document: %w", err)
+	}
+	p, err := proto.Marshal(d)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling OpenAPI v2 document to protobuf: %w", err)
+	}
+
+	return &V2Handler{
+		json:         j,
+		protobuf:     p,
+		etag:         fmt.Sprintf("%X", sha512.Sum512(j)),
+		lastModified: time.Now(),
+	}, nil
+}
+
+func (h *V2Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ct, d := marshal.ContentTypeJson, h.json
+	if strings.Contains(r.Header.Get("Accept"), "protobuf") {
+		ct, d = ContentTypeProtobufV2, h.protobuf
+	}
+
+	w.Header().Add("Vary", "Accept")
+	w.Header().Set(header.ContentType, ct)
+	w.Header().Set("Etag", strconv.Quote(h.etag))
+	http.ServeContent(w, r, V2Path, h.lastModified, bytes.NewReader(d))
+}
+
+// NewV3Service creates the handler3.OpenAPIService serving the workspaces group version OpenAPI v3 document.
+// Its HandleDiscovery method serves V3Path and its HandleGroupVersion method serves GroupVersionPath.
+func NewV3Service(s *spec3.OpenAPI) *handler3.OpenAPIService {
+	o := handler3.NewOpenAPIService()
+	o.UpdateGroupVersion(strings.TrimPrefix(GroupVersionPath, V3Path+"/"), s)
+	return o
+}
+
+func info() *spec.Info {
+	return &spec.Info{
+		InfoProps: spec.InfoProps{
+			Title:   "Workspaces",
+			Version: restworkspacesv1alpha1.GroupVersion.Version,
+		},
+	}
+}
+
+// definitionNamer names the definitions as the Kubernetes API server does,
+// and tags the ones matching a kind with its GroupVersionKinds
+type definitionNamer struct {
+	gvks map[string][]schema.GroupVersionKind
+}
+
+func newDefinitionNamer() (*definitionNamer, error) {
+	s := runtime.NewScheme()
+	if err := restworkspacesv1alpha1.AddToScheme(s); err != nil {
+		return nil, err
+	}
+
+	n := &definitionNamer{gvks: map[string][]schema.GroupVersionKind{}}
+	for gvk, t := range s.AllKnownTypes() {
+		if gvk.Version == runtime.APIVersionInternal {
+			continue
+		}
+		name := util.GetCanonicalTypeName(reflect.New(t).Elem().Interface())
+		n.gvks[name] = append(n.gvks[name], gvk)
+	}
+	for _, gg := range n.gvks {
+		slices.SortFunc(gg, func(a, b schema.GroupVersionKind) int {
+			return strings.Compare(a.String(), b.String())
+		})
+	}
+	return n, nil
+}
+
+// GetDefinitionName returns the REST friendly name of the definition and its extensions
+func (n *definitionNamer) GetDefinitionName(name string) (string, spec.Extensions) {
+	gg, ok := n.gvks[name]
+	if !ok {
+		return util.ToRESTFriendlyName(name), nil
+	}
+
+	ee := make([]any, 0, len(gg))
+	for _, g := range gg {
+		ee = append(ee, map[string]any{
+			"group":   g.Group,
+			"version": g.Version,
+			"kind":    g.Kind,
+		})
+	}
+	return util.ToRESTFriendlyName(name), spec.Extensions{extensionGVK: ee}
+}
+
+// NewTypeConverter builds the managedfields.TypeConverter used by server-side apply
+// to merge the configurations applied to Workspaces, from their OpenAPI v3 schemas
+func NewTypeConverter() (managedfields.TypeConverter, error) {
+	s, err := BuildV3Spec()
+	if err != nil {
+		return nil, err
+	}
+	return managedfields.NewTypeConverter(s.Components.Schemas, false)
+}
@@ -0,0 +1,194 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	openapi_v2 "github.com/google/gnostic-models/openapiv2"
+	"google.golang.org/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kube-openapi/pkg/handler3"
+	"k8s.io/kube-openapi/pkg/spec3"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+
+	"github.com/konflux-workspaces/workspaces/server/rest/openapi"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("OpenAPI", func() {
+	workspaceDefinition := "com.github.konflux-workspaces.workspaces.server.api.v1alpha1.Workspace"
+	workspaceGVK := []any{map[string]any{
+		"group":   "workspaces.konflux-ci.dev",
+		"version": "v1alpha1",
+		"kind":    "Workspace",
+	}}
+
+	Describe("v2", func() {
+		var s *spec.Swagger
+
+		BeforeEach(func() {
+			var err error
+			s, err = openapi.BuildV2Spec()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should describe the workspaces paths", func() {
+			Expect(s.Paths.Paths).To(HaveKey("/apis/workspaces.konflux-ci.dev/v1alpha1/workspaces"))
+			Expect(s.Paths.Paths).To(HaveKey("/apis/workspaces.konflux-ci.dev/v1alpha1/namespaces/{namespace}/workspaces"))
+			Expect(s.Paths.Paths).To(HaveKey("/apis/workspaces.konflux-ci.dev/v1alpha1/namespaces/{namespace}/workspaces/{name}"))
+			Expect(s.Paths.Paths).To(HaveKey("/apis/workspaces.konflux-ci.dev/v1alpha1/namespaces/{namespace}/workspaces/{name}/members/{member}"))
+			Expect(s.Paths.Paths).To(HaveKey("/apis/workspaces.konflux-ci.dev/v1alpha1/namespaces/{namespace}/workspaces/{name}/events"))
+		})
+
+		It("should describe the events with the owned WorkspaceEventList only", func() {
+			Expect(s.Definitions).To(HaveKey(workspaceDefinition + "EventList"))
+			for n := range s.Definitions {
+				Expect(n).NotTo(HavePrefix("io.k8s.api.core.v1."))
+			}
+		})
+
+		It("should tag the Workspace definition with its GroupVersionKind", func() {
+			Expect(s.Definitions).To(HaveKey(workspaceDefinition))
+			Expect(s.Definitions[workspaceDefinition].Extensions).
+				To(HaveKeyWithValue("x-kubernetes-group-version-kind", workspaceGVK))
+		})
+
+		Describe("handler", func() {
+			var h *openapi.V2Handler
+
+			BeforeEach(func() {
+				var err error
+				h, err = openapi.NewV2Handler(s)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should serve the document as JSON by default", func() {
+				// given
+				r := httptest.NewRequest(http.MethodGet, openapi.V2Path, nil)
+				w := httptest.NewRecorder()
+
+				// when
+				h.ServeHTTP(w, r)
+
+				// then
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Header().Get("Content-Type")).To(Equal("application/json"))
+				d := spec.Swagger{}
+				Expect(json.Unmarshal(w.Body.Bytes(), &d)).To(Succeed())
+				Expect(d.Definitions).To(HaveKey(workspaceDefinition))
+			})
+
+			It("should serve the document as protobuf if requested", func() {
+				// given
+				r := httptest.NewRequest(http.MethodGet, openapi.V2Path, nil)
+				r.Header.Set("Accept", openapi.ContentTypeProtobufV2)
+				w := httptest.NewRecorder()
+
+				// when
+				h.ServeHTTP(w, r)
+
+				// then
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Header().Get("Content-Type")).To(Equal(openapi.ContentTypeProtobufV2))
+				d := openapi_v2.Document{}
+				Expect(proto.Unmarshal(w.Body.Bytes(), &d)).To(Succeed())
+				Expect(d.GetInfo().GetTitle()).To(Equal("Workspaces"))
+			})
+
+			It("should reply not modified if the document did not change", func() {
+				// given
+				w := httptest.NewRecorder()
+				h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, openapi.V2Path, nil))
+				r := httptest.NewRequest(http.MethodGet, openapi.V2Path, nil)
+				r.Header.Set("If-None-Match", w.Header().Get("Etag"))
+				w = httptest.NewRecorder()
+
+				// when
+				h.ServeHTTP(w, r)
+
+				// then
+				Expect(w.Code).To(Equal(http.StatusNotModified))
+			})
+		})
+	})
+
+	It("should build the type converter for server-side apply", func() {
+		// when
+		tc, err := openapi.NewTypeConverter()
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		w := &restworkspacesv1alpha1.Workspace{
+			TypeMeta: metav1.TypeMeta{Kind: "Workspace", APIVersion: restworkspacesv1alpha1.GroupVersion.String()},
+			Spec: restworkspacesv1alpha1.WorkspaceSpec{
+				Members: []restworkspacesv1alpha1.WorkspaceMember{{Username: "foo", Role: restworkspacesv1alpha1.WorkspaceRoleAdmin}},
+			},
+		}
+		_, err = tc.ObjectToTyped(w)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("v3", func() {
+		var s *spec3.OpenAPI
+
+		BeforeEach(func() {
+			var err error
+			s, err = openapi.BuildV3Spec()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should describe the workspaces paths", func() {
+			Expect(s.Paths.Paths).To(HaveKey("/apis/workspaces.konflux-ci.dev/v1alpha1/workspaces"))
+			Expect(s.Paths.Paths).To(HaveKey("/apis/workspaces.konflux-ci.dev/v1alpha1/namespaces/{namespace}/workspaces/{name}"))
+		})
+
+		It("should tag the Workspace schema with its GroupVersionKind", func() {
+			Expect(s.Components.Schemas).To(HaveKey(workspaceDefinition))
+			Expect(s.Components.Schemas[workspaceDefinition].Extensions).
+				To(HaveKeyWithValue("x-kubernetes-group-version-kind", workspaceGVK))
+		})
+
+		Describe("service", func() {
+			var o *handler3.OpenAPIService
+
+			BeforeEach(func() {
+				o = openapi.NewV3Service(s)
+			})
+
+			It("should list the workspaces group version", func() {
+				// given
+				r := httptest.NewRequest(http.MethodGet, openapi.V3Path, nil)
+				w := httptest.NewRecorder()
+
+				// when
+				o.HandleDiscovery(w, r)
+
+				// then
+				Expect(w.Code).To(Equal(http.StatusOK))
+				d := handler3.OpenAPIV3Discovery{}
+				Expect(json.Unmarshal(w.Body.Bytes(), &d)).To(Succeed())
+				Expect(d.Paths).To(HaveKey("apis/workspaces.konflux-ci.dev/v1alpha1"))
+			})
+
+			It("should serve the workspaces group version document", func() {
+				// given
+				r := httptest.NewRequest(http.MethodGet, openapi.GroupVersionPath, nil)
+				w := httptest.NewRecorder()
+
+				// when
+				o.HandleGroupVersion(w, r)
+
+				// then
+				Expect(w.Code).To(Equal(http.StatusOK))
+				d := spec3.OpenAPI{}
+				Expect(json.Unmarshal(w.Body.Bytes(), &d)).To(Succeed())
+				Expect(d.Components.Schemas).To(HaveKey(workspaceDefinition))
+			})
+		})
+	})
+})
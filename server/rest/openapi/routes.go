@@ -0,0 +1,171 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+// webServices describes the routes served by the REST API.
+// The routes are used to build the OpenAPI paths only, requests are served by the handlers in the workspace package.
+func webServices() []*restful.WebService {
+	ws := new(restful.WebService).
+		Path("/apis/"+restworkspacesv1alpha1.GroupVersion.String()).
+		Consumes(marshal.ContentTypeJson, marshal.ContentTypeYaml, marshal.ContentTypeProtobuf).
+		Produces(marshal.ContentTypeJson, marshal.ContentTypeYaml, marshal.ContentTypeProtobuf)
+
+	namespace := ws.PathParameter("namespace", "object name and auth scope, such as for teams and projects")
+	name := ws.PathParameter("name", "name of the Workspace")
+	member := ws.PathParameter("member", "username of the Workspace member")
+	ifMatch := ws.HeaderParameter("If-Match", "Apply the request only if the Workspace's resourceVersion matches the given entity tag.")
+	dryRun := ws.QueryParameter("dryRun", "When present, indicates that modifications should not be persisted. The only valid value is All.")
+	listParams := []*restful.Parameter{
+		ws.QueryParameter("labelSelector", "A selector to restrict the list of returned objects by their labels. Defaults to everything."),
+		ws.QueryParameter("fieldSelector", "A selector to restrict the list of returned objects by their fields. Defaults to everything."),
+		ws.QueryParameter("limit", "limit is a maximum number of responses to return for a list call.").DataType("integer"),
+		ws.QueryParameter("continue", "The continue option should be set when retrieving more results from the server."),
+		ws.QueryParameter("watch", "Watch for changes to the described resources and return them as a stream of add, update, and remove notifications.").DataType("boolean"),
+		ws.QueryParameter("resourceVersion", "When specified with a watch call, shows changes that occur after that particular version of a resource."),
+	}
+
+	// List and Watch
+	ws.Route(withParams(ws.GET("/workspaces"), listParams...).
+		To(describeOnly).
+		Operation("listWorkspaceForAllNamespaces").
+		Doc("list or watch objects of kind Workspace").
+		Returns(http.StatusOK, "OK", restworkspacesv1alpha1.WorkspaceList{}))
+	ws.Route(withParams(ws.GET("/namespaces/{namespace}/workspaces"), append(listParams, namespace)...).
+		To(describeOnly).
+		Operation("listNamespacedWorkspace").
+		Doc("list or watch objects of kind Workspace").
+		Returns(http.StatusOK, "OK", restworkspacesv1alpha1.WorkspaceList{}))
+
+	// Create
+	ws.Route(ws.POST("/namespaces/{namespace}/workspaces").
+		To(describeOnly).
+		Operation("createNamespacedWorkspace").
+		Doc("create a Workspace").
+		Param(namespace).Param(dryRun).
+		Reads(restworkspacesv1alpha1.Workspace{}).
+		Returns(http.StatusCreated, "Created", restworkspacesv1alpha1.Workspace{}))
+
+	// Read
+	ws.Route(ws.GET("/namespaces/{namespace}/workspaces/{name}").
+		To(describeOnly).
+		Operation("readNamespacedWorkspace").
+		Doc("read the specified Workspace").
+		Param(namespace).Param(name).
+		Param(ws.HeaderParameter("If-None-Match", "Reply Not Modified if the Workspace's resourceVersion matches one of the given entity tags.")).
+		Returns(http.StatusOK, "OK", restworkspacesv1alpha1.Workspace{}))
+
+	// Update
+	ws.Route(ws.PUT("/namespaces/{namespace}/workspaces/{name}").
+		To(describeOnly).
+		Operation("replaceNamespacedWorkspace").
+		Doc("replace the specified Workspace").
+		Param(namespace).Param(name).Param(dryRun).Param(ifMatch).
+		Reads(restworkspacesv1alpha1.Workspace{}).
+		Returns(http.StatusOK, "OK", restworkspacesv1alpha1.Workspace{}))
+
+	// Patch
+	ws.Route(ws.PATCH("/namespaces/{namespace}/workspaces/{name}").
+		To(describeOnly).
+		Operation("patchNamespacedWorkspace").
+		Doc("partially update the specified Workspace").
+		Consumes(
+			string(types.JSONPatchType),
+			string(types.MergePatchType),
+			string(types.StrategicMergePatchType),
+			string(types.ApplyPatchType),
+		).
+		Param(namespace).Param(name).Param(dryRun).Param(ifMatch).
+		Param(ws.QueryParameter("fieldManager", "fieldManager is a name associated with the actor or entity that is making these changes. It is required for apply requests.")).
+		Param(ws.QueryParameter("force", "Force is going to \"force\" Apply requests. It means user will re-acquire conflicting fields owned by other people.").DataType("boolean")).
+		Reads(metav1.Patch{}).
+		Returns(http.StatusOK, "OK", restworkspacesv1alpha1.Workspace{}).
+		Returns(http.StatusCreated, "Created", restworkspacesv1alpha1.Workspace{}))
+
+	// Delete
+	ws.Route(ws.DELETE("/namespaces/{namespace}/workspaces/{name}").
+		To(describeOnly).
+		Operation("deleteNamespacedWorkspace").
+		Doc("delete a Workspace").
+		Param(namespace).Param(name).
+		Returns(http.StatusOK, "OK", restworkspacesv1alpha1.Workspace{}))
+
+	// Add or update Member
+	ws.Route(ws.PUT("/namespaces/{namespace}/workspaces/{name}/members/{member}").
+		To(describeOnly).
+		Operation("replaceNamespacedWorkspaceMember").
+		Doc("add a member to the specified Workspace or update its role").
+		Param(namespace).Param(name).Param(member).
+		Reads(restworkspacesv1alpha1.WorkspaceMember{}).
+		Returns(http.StatusOK, "OK", restworkspacesv1alpha1.Workspace{}))
+
+	// Remove Member
+	ws.Route(ws.DELETE("/namespaces/{namespace}/workspaces/{name}/members/{member}").
+		To(describeOnly).
+		Operation("deleteNamespacedWorkspaceMember").
+		Doc("remove a member from the specified Workspace").
+		Param(namespace).Param(name).Param(member).
+		Returns(http.StatusOK, "OK", restworkspacesv1alpha1.Workspace{}))
+
+	// Rename
+	ws.Route(ws.POST("/namespaces/{namespace}/workspaces/{name}/rename").
+		To(describeOnly).
+		Operation("renameNamespacedWorkspace").
+		Doc("rename the specified Workspace").
+		Param(namespace).Param(name).
+		Reads(restworkspacesv1alpha1.WorkspaceRename{}).
+		Returns(http.StatusOK, "OK", restworkspacesv1alpha1.Workspace{}))
+
+	// Propose ownership transfer
+	ws.Route(ws.PUT("/namespaces/{namespace}/workspaces/{name}/transfer").
+		To(describeOnly).
+		Operation("replaceNamespacedWorkspaceTransfer").
+		Doc("propose the transfer of the ownership of the specified Workspace to another user").
+		Param(namespace).Param(name).
+		Reads(restworkspacesv1alpha1.WorkspaceOwnershipTransfer{}).
+		Returns(http.StatusOK, "OK", restworkspacesv1alpha1.Workspace{}))
+
+	// Cancel or decline ownership transfer
+	ws.Route(ws.DELETE("/namespaces/{namespace}/workspaces/{name}/transfer").
+		To(describeOnly).
+		Operation("deleteNamespacedWorkspaceTransfer").
+		Doc("cancel or decline the pending ownership transfer of the specified Workspace").
+		Param(namespace).Param(name).
+		Returns(http.StatusOK, "OK", restworkspacesv1alpha1.Workspace{}))
+
+	// Accept ownership transfer
+	ws.Route(ws.POST("/namespaces/{namespace}/workspaces/{name}/transfer/accept").
+		To(describeOnly).
+		Operation("acceptNamespacedWorkspaceTransfer").
+		Doc("accept the pending ownership transfer of the specified Workspace").
+		Param(namespace).Param(name).
+		Returns(http.StatusOK, "OK", restworkspacesv1alpha1.Workspace{}))
+
+	// List events
+	ws.Route(ws.GET("/namespaces/{namespace}/workspaces/{name}/events").
+		To(describeOnly).
+		Operation("listNamespacedWorkspaceEvents").
+		Doc("list the Events recorded on the specified Workspace").
+		Param(namespace).Param(name).
+		Returns(http.StatusOK, "OK", restworkspacesv1alpha1.WorkspaceEventList{}))
+
+	return []*restful.WebService{ws}
+}
+
+func withParams(rb *restful.RouteBuilder, params ...*restful.Parameter) *restful.RouteBuilder {
+	for _, p := range params {
+		rb.Param(p)
+	}
+	return rb
+}
+
+func describeOnly(*restful.Request, *restful.Response) {}
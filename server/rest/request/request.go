@@ -0,0 +1,13 @@
+package request
+
+import "net/http"
+
+// IsWatch returns true if the request asks to watch the resources
+func IsWatch(r *http.Request) bool {
+	switch r.URL.Query().Get("watch") {
+	case "true", "1":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,24 @@
+package request_test
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/konflux-workspaces/workspaces/server/rest/request"
+)
+
+var _ = Describe("Request", func() {
+	DescribeTable("watch requests detection",
+		func(query string, expected bool) {
+			r, err := http.NewRequest(http.MethodGet, "/apis/workspaces.io/v1alpha1/workspaces"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(request.IsWatch(r)).To(Equal(expected))
+		},
+		Entry("no query", "", false),
+		Entry("watch=true", "?watch=true", true),
+		Entry("watch=1", "?watch=1", true),
+		Entry("watch=false", "?watch=false", false),
+	)
+})
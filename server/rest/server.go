@@ -4,128 +4,283 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
+	"go.opentelemetry.io/otel"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 
 	ccontext "github.com/konflux-workspaces/workspaces/server/core/context"
+	"github.com/konflux-workspaces/workspaces/server/rest/discovery"
 	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
 	"github.com/konflux-workspaces/workspaces/server/rest/middleware"
+	"github.com/konflux-workspaces/workspaces/server/rest/openapi"
+	"github.com/konflux-workspaces/workspaces/server/rest/request"
 	"github.com/konflux-workspaces/workspaces/server/rest/workspace"
 )
 
 const (
+	APIsPrefix                 string = `/apis`
+	GroupPrefix                string = `/apis/workspaces.konflux-ci.dev`
+	GroupVersionPrefix         string = `/apis/workspaces.konflux-ci.dev/v1alpha1`
 	WorkspacesPrefix           string = `/apis/workspaces.konflux-ci.dev/v1alpha1/workspaces`
 	NamespacedWorkspacesPrefix string = `/apis/workspaces.konflux-ci.dev/v1alpha1/namespaces/{namespace}/workspaces`
 )
 
+// Handlers are the handlers of the requests served by the REST API server
+type Handlers struct {
+	Read            workspace.ReadWorkspaceQueryHandlerFunc
+	List            workspace.ListWorkspaceQueryHandlerFunc
+	Watch           workspace.WatchWorkspaceQueryHandlerFunc
+	Create          workspace.CreateWorkspaceCommandHandlerFunc
+	Update          workspace.UpdateWorkspaceCommandHandlerFunc
+	Patch           workspace.PatchWorkspaceCommandHandlerFunc
+	Delete          workspace.DeleteWorkspaceCommandHandlerFunc
+	AddMember       workspace.AddWorkspaceMemberCommandHandlerFunc
+	RemoveMember    workspace.RemoveWorkspaceMemberCommandHandlerFunc
+	Rename          workspace.RenameWorkspaceCommandHandlerFunc
+	ProposeTransfer workspace.ProposeWorkspaceOwnershipTransferCommandHandlerFunc
+	CancelTransfer  workspace.CancelWorkspaceOwnershipTransferCommandHandlerFunc
+	AcceptTransfer  workspace.AcceptWorkspaceOwnershipTransferCommandHandlerFunc
+	ListEvents      workspace.ListWorkspaceEventsQueryHandlerFunc
+
+	// KubesawHealth serves the unauthenticated KubeSaw health endpoint
+	KubesawHealth http.Handler
+}
+
 func New(
 	logger *slog.Logger,
 	addr string,
 	cache cache.Cache,
-	readHandle workspace.ReadWorkspaceQueryHandlerFunc,
-	listHandle workspace.ListWorkspaceQueryHandlerFunc,
-	createHandle workspace.CreateWorkspaceCommandHandlerFunc,
-	updateHandle workspace.UpdateWorkspaceCommandHandlerFunc,
-	patchHandle workspace.PatchWorkspaceCommandHandlerFunc,
-) *http.Server {
+	auth AuthMiddlewareFunc,
+	handlers Handlers,
+) (*http.Server, error) {
+	h, err := buildServerHandler(logger, cache, auth, handlers)
+	if err != nil {
+		return nil, err
+	}
+
 	return &http.Server{
 		Addr:              addr,
-		Handler:           buildServerHandler(logger, cache, readHandle, listHandle, createHandle, updateHandle, patchHandle),
+		Handler:           h,
 		ReadHeaderTimeout: 3 * time.Second,
-	}
+	}, nil
 }
 
 func buildServerHandler(
 	logger *slog.Logger,
 	cache cache.Cache,
-	readHandle workspace.ReadWorkspaceQueryHandlerFunc,
-	listHandle workspace.ListWorkspaceQueryHandlerFunc,
-	createHandle workspace.CreateWorkspaceCommandHandlerFunc,
-	updateHandle workspace.UpdateWorkspaceCommandHandlerFunc,
-	patchHandle workspace.PatchWorkspaceCommandHandlerFunc,
-) http.Handler {
+	auth AuthMiddlewareFunc,
+	handlers Handlers,
+) (http.Handler, error) {
 	mux := http.NewServeMux()
-	addHealthz(mux)
-	addWorkspaces(mux, cache, readHandle, listHandle, createHandle, updateHandle, patchHandle)
-	mux.HandleFunc("GET /", func(w http.ResponseWriter, r *http.Request) {
+	addHealthz(mux, handlers.KubesawHealth)
+	addDiscovery(mux)
+	if err := addOpenAPI(mux); err != nil {
+		return nil, err
+	}
+	addWorkspaces(mux, cache, auth, handlers)
+	handle(mux, "GET /", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusNotFound)
-	})
+	}))
 
 	if logger == nil {
-		return mux
+		return mux, nil
 	}
 
 	return middleware.NewLoggerInjectorMiddleware(logger,
 		middleware.NewRequestLoggerMiddleware(mux),
-	)
+	), nil
 }
 
 func addWorkspaces(
 	mux *http.ServeMux,
 	cache cache.Cache,
-	readHandle workspace.ReadWorkspaceQueryHandlerFunc,
-	listHandle workspace.ListWorkspaceQueryHandlerFunc,
-	_ workspace.CreateWorkspaceCommandHandlerFunc,
-	updateHandle workspace.UpdateWorkspaceCommandHandlerFunc,
-	patchHandle workspace.PatchWorkspaceCommandHandlerFunc,
+	auth AuthMiddlewareFunc,
+	handlers Handlers,
 ) {
 	// Read
-	mux.Handle(fmt.Sprintf("GET %s/{name}", NamespacedWorkspacesPrefix),
-		withAuthHeaderInfo(
+	handle(mux, fmt.Sprintf("GET %s/{name}", NamespacedWorkspacesPrefix),
+		auth(
 			withUserSignupAuth(cache,
 				workspace.NewReadWorkspaceHandler(
 					workspace.MapReadWorkspaceHttp,
-					readHandle,
-					marshal.DefaultMarshalerProvider,
+					handlers.Read,
+					workspace.TableMarshalerProvider,
 				))))
 
-	// List
-	lh := withAuthHeaderInfo(
+	// List and Watch
+	lh := auth(
 		withUserSignupAuth(cache,
-			workspace.NewListWorkspaceHandler(
-				workspace.MapListWorkspaceHttp,
-				listHandle,
-				marshal.DefaultMarshalerProvider,
+			withWatchSupport(
+				workspace.NewListWorkspaceHandler(
+					workspace.MapListWorkspaceHttp,
+					handlers.List,
+					workspace.TableMarshalerProvider,
+				),
+				workspace.NewWatchWorkspaceHandler(
+					workspace.MapWatchWorkspaceHttp,
+					handlers.Watch,
+					marshal.StreamMarshalerProvider,
+				),
 			),
 		))
-	mux.Handle(fmt.Sprintf("GET %s", WorkspacesPrefix), lh)
-	mux.Handle(fmt.Sprintf("GET %s", NamespacedWorkspacesPrefix), lh)
+	handle(mux, fmt.Sprintf("GET %s", WorkspacesPrefix), lh)
+	handle(mux, fmt.Sprintf("GET %s", NamespacedWorkspacesPrefix), lh)
 
 	// Update
-	mux.Handle(fmt.Sprintf("PUT %s/{name}", NamespacedWorkspacesPrefix),
-		withAuthHeaderInfo(
+	handle(mux, fmt.Sprintf("PUT %s/{name}", NamespacedWorkspacesPrefix),
+		auth(
 			withUserSignupAuth(cache,
 				workspace.NewUpdateWorkspaceHandler(
 					workspace.MapPutWorkspaceHttp,
-					updateHandle,
+					handlers.Update,
 					marshal.DefaultMarshalerProvider,
 					marshal.DefaultUnmarshalerProvider,
 				))))
 
 	// Patch
-	mux.Handle(fmt.Sprintf("PATCH %s/{name}", NamespacedWorkspacesPrefix),
-		withAuthHeaderInfo(
+	handle(mux, fmt.Sprintf("PATCH %s/{name}", NamespacedWorkspacesPrefix),
+		auth(
 			withUserSignupAuth(cache,
 				workspace.NewPatchWorkspaceHandler(
 					workspace.MapPatchWorkspaceHttp,
-					patchHandle,
+					handlers.Patch,
 					marshal.DefaultMarshalerProvider,
 				))))
 
 	// Create
-	// mux.Handle(fmt.Sprintf("POST %s", NamespacedWorkspacesPrefix),
-	// 	withAuthHeaderInfo(
-	// 		withUserSignupAuth(cache, true,
-	// 			workspace.NewPostWorkspaceHandler(
-	// 				workspace.MapPostWorkspaceHttp,
-	// 				postHandle,
-	// 				marshal.DefaultMarshalerProvider,
-	// 				marshal.DefaultUnmarshalerProvider,
-	// 			))))
+	handle(mux, fmt.Sprintf("POST %s", NamespacedWorkspacesPrefix),
+		auth(
+			withUserSignupAuth(cache,
+				workspace.NewPostWorkspaceHandler(
+					workspace.MapPostWorkspaceHttp,
+					handlers.Create,
+					marshal.DefaultMarshalerProvider,
+					marshal.DefaultUnmarshalerProvider,
+				))))
+
+	// Delete
+	handle(mux, fmt.Sprintf("DELETE %s/{name}", NamespacedWorkspacesPrefix),
+		auth(
+			withUserSignupAuth(cache,
+				workspace.NewDeleteWorkspaceHandler(
+					workspace.MapDeleteWorkspaceHttp,
+					handlers.Delete,
+					marshal.DefaultMarshalerProvider,
+				))))
+
+	// Add or update Member
+	handle(mux, fmt.Sprintf("PUT %s/{name}/members/{member}", NamespacedWorkspacesPrefix),
+		auth(
+			withUserSignupAuth(cache,
+				workspace.NewPutWorkspaceMemberHandler(
+					workspace.MapPutWorkspaceMemberHttp,
+					handlers.AddMember,
+					marshal.DefaultMarshalerProvider,
+					marshal.DefaultUnmarshalerProvider,
+				))))
+
+	// Remove Member
+	handle(mux, fmt.Sprintf("DELETE %s/{name}/members/{member}", NamespacedWorkspacesPrefix),
+		auth(
+			withUserSignupAuth(cache,
+				workspace.NewDeleteWorkspaceMemberHandler(
+					workspace.MapDeleteWorkspaceMemberHttp,
+					handlers.RemoveMember,
+					marshal.DefaultMarshalerProvider,
+				))))
+
+	// Rename
+	handle(mux, fmt.Sprintf("POST %s/{name}/rename", NamespacedWorkspacesPrefix),
+		auth(
+			withUserSignupAuth(cache,
+				workspace.NewPostWorkspaceRenameHandler(
+					workspace.MapPostWorkspaceRenameHttp,
+					handlers.Rename,
+					marshal.DefaultMarshalerProvider,
+					marshal.DefaultUnmarshalerProvider,
+				))))
+
+	// Propose ownership transfer
+	handle(mux, fmt.Sprintf("PUT %s/{name}/transfer", NamespacedWorkspacesPrefix),
+		auth(
+			withUserSignupAuth(cache,
+				workspace.NewPutWorkspaceTransferHandler(
+					workspace.MapPutWorkspaceTransferHttp,
+					handlers.ProposeTransfer,
+					marshal.DefaultMarshalerProvider,
+					marshal.DefaultUnmarshalerProvider,
+				))))
+
+	// Cancel or decline ownership transfer
+	handle(mux, fmt.Sprintf("DELETE %s/{name}/transfer", NamespacedWorkspacesPrefix),
+		auth(
+			withUserSignupAuth(cache,
+				workspace.NewDeleteWorkspaceTransferHandler(
+					workspace.MapDeleteWorkspaceTransferHttp,
+					handlers.CancelTransfer,
+					marshal.DefaultMarshalerProvider,
+				))))
+
+	// Accept ownership transfer
+	handle(mux, fmt.Sprintf("POST %s/{name}/transfer/accept", NamespacedWorkspacesPrefix),
+		auth(
+			withUserSignupAuth(cache,
+				workspace.NewPostWorkspaceTransferAcceptHandler(
+					workspace.MapPostWorkspaceTransferAcceptHttp,
+					handlers.AcceptTransfer,
+					marshal.DefaultMarshalerProvider,
+				))))
+
+	// List events
+	handle(mux, fmt.Sprintf("GET %s/{name}/events", NamespacedWorkspacesPrefix),
+		auth(
+			withUserSignupAuth(cache,
+				workspace.NewListWorkspaceEventsHandler(
+					workspace.MapListWorkspaceEventsHttp,
+					handlers.ListEvents,
+					marshal.DefaultMarshalerProvider,
+				))))
+}
+
+// handle registers the handler for the pattern, measuring and tracing the
+// requests it serves labeled with the pattern's path as route
+func handle(mux *http.ServeMux, pattern string, handler http.Handler) {
+	_, route, _ := strings.Cut(pattern, " ")
+	mux.Handle(pattern,
+		middleware.NewMetricsMiddleware(
+			middleware.NewTracingMiddleware(handler, route, otel.GetTracerProvider()),
+			route,
+		))
+}
+
+// withWatchSupport forwards watch requests to the watch handler and any other request to the list handler
+func withWatchSupport(list, watch http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if request.IsWatch(r) {
+			watch.ServeHTTP(w, r)
+			return
+		}
+		list.ServeHTTP(w, r)
+	})
 }
 
-func withAuthHeaderInfo(next http.Handler) http.Handler {
+// AuthMiddlewareFunc wraps the handler with the middleware authenticating the requests
+type AuthMiddlewareFunc func(next http.Handler) http.Handler
+
+// WithJwtAuth authenticates the requests verifying their bearer JWT
+func WithJwtAuth(keyfunc middleware.KeyfuncCtx, opts ...jwt.ParserOption) AuthMiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return middleware.NewJwtAuthMiddleware(next, keyfunc, opts...)
+	}
+}
+
+// WithTrustedProxyAuth trusts the user's sub provided in the X-Subject header.
+// It must be used only when the requests are authenticated by a trusted proxy
+// and the server is not reachable bypassing it.
+func WithTrustedProxyAuth(next http.Handler) http.Handler {
 	return middleware.NewHeaderInfoMiddleware(next, map[string]interface{}{
 		"X-Subject": ccontext.UserSubKey,
 	})
@@ -135,12 +290,42 @@ func withUserSignupAuth(cache cache.Cache, next http.Handler) http.Handler {
 	return middleware.NewUserSignupMiddleware(next, cache)
 }
 
-func addHealthz(mux *http.ServeMux) {
-	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) {
+func addHealthz(mux *http.ServeMux, kubesawHealthHandler http.Handler) {
+	handle(mux, "GET /healthz", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if _, err := w.Write([]byte("alive")); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			return
 		}
 		w.WriteHeader(http.StatusOK)
-	})
+	}))
+	handle(mux, "GET /healthz/kubesaw", kubesawHealthHandler)
+}
+
+func addDiscovery(mux *http.ServeMux) {
+	handle(mux, fmt.Sprintf("GET %s", APIsPrefix), discovery.NewDefaultHandler(discovery.APIGroupList()))
+	handle(mux, fmt.Sprintf("GET %s", GroupPrefix), discovery.NewDefaultHandler(discovery.APIGroup()))
+	handle(mux, fmt.Sprintf("GET %s", GroupVersionPrefix), discovery.NewDefaultHandler(discovery.APIResourceList()))
+}
+
+func addOpenAPI(mux *http.ServeMux) error {
+	// OpenAPI v2
+	v2, err := openapi.BuildV2Spec()
+	if err != nil {
+		return fmt.Errorf("error building OpenAPI v2 document: %w", err)
+	}
+	v2h, err := openapi.NewV2Handler(v2)
+	if err != nil {
+		return err
+	}
+	handle(mux, fmt.Sprintf("GET %s", openapi.V2Path), v2h)
+
+	// OpenAPI v3
+	v3, err := openapi.BuildV3Spec()
+	if err != nil {
+		return fmt.Errorf("error building OpenAPI v3 document: %w", err)
+	}
+	v3s := openapi.NewV3Service(v3)
+	handle(mux, fmt.Sprintf("GET %s", openapi.V3Path), http.HandlerFunc(v3s.HandleDiscovery))
+	handle(mux, fmt.Sprintf("GET %s", openapi.GroupVersionPath), http.HandlerFunc(v3s.HandleGroupVersion))
+	return nil
 }
@@ -0,0 +1,76 @@
+package status
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/konflux-workspaces/workspaces/server/core"
+	"github.com/konflux-workspaces/workspaces/server/rest/header"
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+)
+
+// FromError translates an error into the metav1.Status to return to the client.
+// Errors implementing kerrors.APIStatus are returned as they are, core.ErrNotFound
+// is translated into a NotFound status, and any other error is hidden behind
+// a generic InternalError status.
+func FromError(err error) *metav1.Status {
+	var s metav1.Status
+	var as kerrors.APIStatus
+	switch {
+	case errors.As(err, &as):
+		s = as.Status()
+	case errors.Is(err, core.ErrNotFound):
+		s = metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusNotFound,
+			Reason:  metav1.StatusReasonNotFound,
+			Message: err.Error(),
+		}
+	default:
+		s = metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusInternalServerError,
+			Reason:  metav1.StatusReasonInternalError,
+			Message: "an internal error occurred",
+		}
+	}
+
+	s.Kind = "Status"
+	s.APIVersion = "v1"
+	if s.Status == "" {
+		s.Status = metav1.StatusFailure
+	}
+	if s.Code == 0 {
+		s.Code = http.StatusInternalServerError
+	}
+	return &s
+}
+
+// WriteError writes the metav1.Status translated from the error as a JSON response
+func WriteError(w http.ResponseWriter, err error) {
+	s := FromError(err)
+	d, merr := json.Marshal(s)
+	if merr != nil {
+		w.WriteHeader(int(s.Code))
+		return
+	}
+
+	w.Header().Set(header.ContentType, marshal.ContentTypeJson)
+	w.WriteHeader(int(s.Code))
+	_, _ = w.Write(d)
+}
+
+// WriteBadRequest writes the error as a BadRequest response, unless the error
+// already carries its own status, like the NotAcceptable and UnsupportedMediaType
+// errors returned by content negotiation
+func WriteBadRequest(w http.ResponseWriter, err error) {
+	var as kerrors.APIStatus
+	if !errors.As(err, &as) {
+		err = kerrors.NewBadRequest(err.Error())
+	}
+	WriteError(w, err)
+}
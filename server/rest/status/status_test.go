@@ -0,0 +1,87 @@
+package status_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/konflux-workspaces/workspaces/server/core"
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+	"github.com/konflux-workspaces/workspaces/server/rest/status"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("Status", func() {
+	gr := restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource()
+
+	DescribeTable("translating errors",
+		func(err error, expectedCode int32, expectedReason metav1.StatusReason) {
+			// when
+			s := status.FromError(err)
+
+			// then
+			Expect(s.Kind).To(Equal("Status"))
+			Expect(s.APIVersion).To(Equal("v1"))
+			Expect(s.Status).To(Equal(metav1.StatusFailure))
+			Expect(s.Code).To(Equal(expectedCode))
+			Expect(s.Reason).To(Equal(expectedReason))
+		},
+		Entry("NotFound", kerrors.NewNotFound(gr, "foo"), int32(http.StatusNotFound), metav1.StatusReasonNotFound),
+		Entry("core.ErrNotFound", core.ErrNotFound, int32(http.StatusNotFound), metav1.StatusReasonNotFound),
+		Entry("core unauthenticated error", core.NewUnauthenticatedError(), int32(http.StatusUnauthorized), metav1.StatusReasonUnauthorized),
+		Entry("wrapped core.ErrNotFound", fmt.Errorf("error: %w", core.ErrNotFound), int32(http.StatusNotFound), metav1.StatusReasonNotFound),
+		Entry("Forbidden", kerrors.NewForbidden(gr, "foo", fmt.Errorf("forbidden")), int32(http.StatusForbidden), metav1.StatusReasonForbidden),
+		Entry("AlreadyExists", kerrors.NewAlreadyExists(gr, "foo"), int32(http.StatusConflict), metav1.StatusReasonAlreadyExists),
+		Entry("BadRequest", kerrors.NewBadRequest("bad"), int32(http.StatusBadRequest), metav1.StatusReasonBadRequest),
+		Entry("ResourceExpired", kerrors.NewResourceExpired("expired"), int32(http.StatusGone), metav1.StatusReasonExpired),
+		Entry("wrapped kerror", fmt.Errorf("error: %w", kerrors.NewConflict(gr, "foo", fmt.Errorf("conflict"))), int32(http.StatusConflict), metav1.StatusReasonConflict),
+		Entry("generic error", fmt.Errorf("generic error"), int32(http.StatusInternalServerError), metav1.StatusReasonInternalError),
+	)
+
+	It("does not leak details of generic errors", func() {
+		// when
+		s := status.FromError(fmt.Errorf("secret details"))
+
+		// then
+		Expect(s.Message).NotTo(ContainSubstring("secret details"))
+	})
+
+	It("writes the status as JSON", func() {
+		// given
+		w := httptest.NewRecorder()
+		err := kerrors.NewNotFound(gr, "foo")
+
+		// when
+		status.WriteError(w, err)
+
+		// then
+		Expect(w.Code).To(Equal(http.StatusNotFound))
+		Expect(w.Header().Get("Content-Type")).To(Equal(marshal.ContentTypeJson))
+		s := metav1.Status{}
+		Expect(json.Unmarshal(w.Body.Bytes(), &s)).To(Succeed())
+		Expect(s.Reason).To(Equal(metav1.StatusReasonNotFound))
+		Expect(s.Message).To(Equal(err.Error()))
+		Expect(s.Details.Name).To(Equal("foo"))
+	})
+	DescribeTable("writes bad requests", func(err error, expectedCode int) {
+		// given
+		w := httptest.NewRecorder()
+
+		// when
+		status.WriteBadRequest(w, err)
+
+		// then
+		Expect(w.Code).To(Equal(expectedCode))
+	},
+		Entry("generic error", fmt.Errorf("invalid request"), http.StatusBadRequest),
+		Entry("wrapped kerror", fmt.Errorf("error: %w", kerrors.NewRequestEntityTooLargeError("too large")), http.StatusRequestEntityTooLarge),
+	)
+})
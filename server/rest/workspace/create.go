@@ -11,6 +11,7 @@ import (
 	"github.com/konflux-workspaces/workspaces/server/log"
 	"github.com/konflux-workspaces/workspaces/server/rest/header"
 	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+	"github.com/konflux-workspaces/workspaces/server/rest/status"
 )
 
 type PostWorkspaceMapperFunc func(*http.Request, marshal.UnmarshalerProvider) (*workspace.CreateWorkspaceCommand, error)
@@ -48,8 +49,8 @@ func (p *PostWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	l.Debug("building marshaler for request")
 	m, err := p.MarshalerProvider(r)
 	if err != nil {
-		l.Error("error building marshaler for request", "error", err)
-		w.WriteHeader(http.StatusBadRequest)
+		l.Debug("error building marshaler for request", "error", err)
+		status.WriteBadRequest(w, err)
 		return
 	}
 
@@ -57,8 +58,8 @@ func (p *PostWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	l.Debug("mapping request to create command")
 	q, err := p.MapperFunc(r, p.UnmarshalerProvider)
 	if err != nil {
-		l.Error("error mapping request to create command", "error", err)
-		w.WriteHeader(http.StatusBadRequest)
+		l.Debug("error mapping request to create command", "error", err)
+		status.WriteBadRequest(w, err)
 		return
 	}
 
@@ -66,8 +67,8 @@ func (p *PostWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	l.Debug("executing create command", "command", q)
 	cr, err := p.CreateHandler(r.Context(), *q)
 	if err != nil {
-		l.Error("error executing create command", "error", err)
-		w.WriteHeader(http.StatusInternalServerError)
+		logHandlerError(l, "error executing create command", err)
+		status.WriteError(w, err)
 		return
 	}
 
@@ -76,13 +77,14 @@ func (p *PostWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	d, err := m.Marshal(cr.Workspace)
 	if err != nil {
 		l.Error("error marshaling response", "error", err)
-		w.WriteHeader(http.StatusInternalServerError)
+		status.WriteError(w, err)
 		return
 	}
 
 	// reply
 	l.Debug("writing response", "response", d)
 	w.Header().Add(header.ContentType, m.ContentType())
+	w.WriteHeader(http.StatusCreated)
 	if _, err := w.Write(d); err != nil {
 		l.Error("error writing response", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -116,8 +118,15 @@ func MapPostWorkspaceHttp(r *http.Request, unmarshaler marshal.UnmarshalerProvid
 	ns := r.PathValue("namespace")
 	w.SetNamespace(ns)
 
+	// parse dry run option
+	dr, err := parseDryRun(r)
+	if err != nil {
+		return nil, err
+	}
+
 	// build command
 	return &workspace.CreateWorkspaceCommand{
 		Workspace: w,
+		DryRun:    dr,
 	}, nil
 }
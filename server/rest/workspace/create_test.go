@@ -3,6 +3,7 @@ package workspace_test
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -10,6 +11,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/konflux-workspaces/workspaces/server/rest/workspace/mocks"
 
@@ -53,48 +56,129 @@ var _ = Describe("Creation tests", func() {
 			handler.ServeHTTP(response, request)
 		},
 		Entry("failure in marshal provider", workspace.MapPostWorkspaceHttp, nopCreateHandler, errorMarshalProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
-			fake.EXPECT().WriteHeader(http.StatusBadRequest)
+			expectStatus(fake, http.StatusBadRequest)
 			return fake
 		}),
 		Entry("failure in unmarshal provider", workspace.MapPostWorkspaceHttp, nopCreateHandler, marshal.DefaultMarshalerProvider, errorUnmarshalProvider, func() http.ResponseWriter {
-			fake.EXPECT().WriteHeader(http.StatusBadRequest)
+			expectStatus(fake, http.StatusBadRequest)
+			return fake
+		}),
+		Entry("unsupported Accept", workspace.MapPostWorkspaceHttp, nopCreateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			request.Header.Set("Accept", "text/html")
+			expectStatus(fake, http.StatusNotAcceptable)
+			return fake
+		}),
+		Entry("unsupported Content-Type", workspace.MapPostWorkspaceHttp, nopCreateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			request.Header.Set("Content-Type", "text/plain")
+			expectStatus(fake, http.StatusUnsupportedMediaType)
 			return fake
 		}),
 		Entry("no body sent in request", workspace.MapPostWorkspaceHttp, nopCreateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
 			request.Body = io.NopCloser(bytes.NewReader([]byte{}))
-			fake.EXPECT().WriteHeader(http.StatusBadRequest)
+			expectStatus(fake, http.StatusBadRequest)
 			return fake
 		}),
 		Entry("failure unmarshaling request", workspace.MapPostWorkspaceHttp, nopCreateHandler, marshal.DefaultMarshalerProvider, badUnmarshalProvider, func() http.ResponseWriter {
-			fake.EXPECT().WriteHeader(http.StatusBadRequest)
+			expectStatus(fake, http.StatusBadRequest)
+			return fake
+		}),
+		Entry("invalid dryRun", workspace.MapPostWorkspaceHttp, nopCreateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			request.URL.RawQuery = "dryRun=true"
+			expectStatus(fake, http.StatusBadRequest)
 			return fake
 		}),
 		Entry("failure in create handler", workspace.MapPostWorkspaceHttp, badCreateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
-			fake.EXPECT().WriteHeader(http.StatusInternalServerError)
+			expectStatus(fake, http.StatusInternalServerError)
+			return fake
+		}),
+		Entry("workspace already exists", workspace.MapPostWorkspaceHttp, alreadyExistsCreateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			expectStatus(fake, http.StatusConflict)
+			return fake
+		}),
+		Entry("creation forbidden", workspace.MapPostWorkspaceHttp, forbiddenCreateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			expectStatus(fake, http.StatusForbidden)
+			return fake
+		}),
+		Entry("invalid workspace", workspace.MapPostWorkspaceHttp, invalidCreateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			expectStatus(fake, http.StatusBadRequest)
 			return fake
 		}),
 		Entry("failure marshaling response", workspace.MapPostWorkspaceHttp, nopCreateHandler, badMarshalProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
-			fake.EXPECT().WriteHeader(http.StatusInternalServerError)
+			expectStatus(fake, http.StatusInternalServerError)
 			return fake
 		}),
 		Entry("failure to write response", workspace.MapPostWorkspaceHttp, nopCreateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
 			fake.EXPECT().Header().Return(http.Header{})
+			fake.EXPECT().WriteHeader(http.StatusCreated)
 			fake.EXPECT().Write(gomock.Any()).Return(0, fmt.Errorf("failed to write response body"))
 			fake.EXPECT().WriteHeader(http.StatusInternalServerError)
 			return fake
 		}),
-		Entry("failure to write response", workspace.MapPostWorkspaceHttp, nopCreateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+		Entry("workspace created", workspace.MapPostWorkspaceHttp, nopCreateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
 			fake.EXPECT().Header().Return(http.Header{})
+			fake.EXPECT().WriteHeader(http.StatusCreated)
+			fake.EXPECT().Write(gomock.Any()).DoAndReturn(func(a any) (int, error) {
+				slice, ok := a.([]byte)
+				Expect(ok).To(BeTrue())
+				return len(slice), nil
+			})
+			return fake
+		}),
+		Entry("workspace created from YAML", workspace.MapPostWorkspaceHttp, nopCreateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			d, err := marshal.YamlMarshal.Marshal(w)
+			Expect(err).NotTo(HaveOccurred())
+			request.Body = io.NopCloser(bytes.NewReader(d))
+			request.Header.Set("Content-Type", marshal.ContentTypeYaml)
+			request.Header.Set("Accept", marshal.ContentTypeYaml)
+
+			h := http.Header{}
+			fake.EXPECT().Header().Return(h)
+			fake.EXPECT().WriteHeader(http.StatusCreated)
 			fake.EXPECT().Write(gomock.Any()).DoAndReturn(func(a any) (int, error) {
 				slice, ok := a.([]byte)
 				Expect(ok).To(BeTrue())
+				Expect(h.Get("Content-Type")).To(Equal(marshal.ContentTypeYaml))
+				r := restworkspacesv1alpha1.Workspace{}
+				Expect(marshal.YamlUnmarshal.Unmarshal(slice, &r)).To(Succeed())
+				Expect(r.Name).To(Equal("foo"))
 				return len(slice), nil
 			})
 			return fake
 		}),
 	)
+
+	DescribeTable("mapping the dry run option",
+		func(query string, expectedDryRun bool) {
+			// given
+			request.URL.RawQuery = query
+
+			// when
+			c, err := workspace.MapPostWorkspaceHttp(request, marshal.DefaultUnmarshalerProvider)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.DryRun).To(Equal(expectedDryRun))
+		},
+		Entry("no dry run", "", false),
+		Entry("dry run", "dryRun=All", true),
+	)
 })
 
+// expectStatus expects a metav1.Status with the given code to be written as response
+func expectStatus(fake *mocks.MockFakeResponseWriter, code int) {
+	fake.EXPECT().Header().Return(http.Header{})
+	fake.EXPECT().WriteHeader(code)
+	fake.EXPECT().Write(gomock.Any()).DoAndReturn(func(a any) (int, error) {
+		d, ok := a.([]byte)
+		Expect(ok).To(BeTrue())
+		s := metav1.Status{}
+		Expect(json.Unmarshal(d, &s)).To(Succeed())
+		Expect(s.Kind).To(Equal("Status"))
+		Expect(s.Code).To(BeEquivalentTo(code))
+		return len(d), nil
+	})
+}
+
 func errorMarshalProvider(*http.Request) (marshal.Marshaler, error) {
 	return nil, fmt.Errorf("bad marshaler provider")
 }
@@ -136,6 +220,18 @@ func badCreateHandler(ctx context.Context, cmd coreworkspace.CreateWorkspaceComm
 	return nil, fmt.Errorf("bad create handler")
 }
 
+func alreadyExistsCreateHandler(ctx context.Context, cmd coreworkspace.CreateWorkspaceCommand) (*coreworkspace.CreateWorkspaceResponse, error) {
+	return nil, kerrors.NewAlreadyExists(restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource(), cmd.Workspace.Name)
+}
+
+func forbiddenCreateHandler(ctx context.Context, cmd coreworkspace.CreateWorkspaceCommand) (*coreworkspace.CreateWorkspaceResponse, error) {
+	return nil, kerrors.NewForbidden(restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource(), cmd.Workspace.Name, fmt.Errorf("forbidden"))
+}
+
+func invalidCreateHandler(ctx context.Context, cmd coreworkspace.CreateWorkspaceCommand) (*coreworkspace.CreateWorkspaceResponse, error) {
+	return nil, kerrors.NewBadRequest("invalid workspace name")
+}
+
 func nopCreateHandler(_ctx context.Context, cmd coreworkspace.CreateWorkspaceCommand) (*coreworkspace.CreateWorkspaceResponse, error) {
 	return &coreworkspace.CreateWorkspaceResponse{
 		Workspace: &cmd.Workspace,
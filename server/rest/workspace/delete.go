@@ -1 +1,121 @@
 package workspace
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/konflux-workspaces/workspaces/server/core/workspace"
+	"github.com/konflux-workspaces/workspaces/server/log"
+	"github.com/konflux-workspaces/workspaces/server/rest/header"
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+	"github.com/konflux-workspaces/workspaces/server/rest/status"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var (
+	_ http.Handler = &DeleteWorkspaceHandler{}
+
+	_ DeleteWorkspaceMapperFunc = MapDeleteWorkspaceHttp
+)
+
+// handler dependencies
+type DeleteWorkspaceMapperFunc func(*http.Request) (*workspace.DeleteWorkspaceCommand, error)
+type DeleteWorkspaceCommandHandlerFunc func(context.Context, workspace.DeleteWorkspaceCommand) (*workspace.DeleteWorkspaceResponse, error)
+
+// DeleteWorkspaceHandler the http.Request handler for Delete Workspaces endpoint
+type DeleteWorkspaceHandler struct {
+	MapperFunc     DeleteWorkspaceMapperFunc
+	CommandHandler DeleteWorkspaceCommandHandlerFunc
+
+	MarshalerProvider marshal.MarshalerProvider
+}
+
+// NewDefaultDeleteWorkspaceHandler creates a DeleteWorkspaceHandler
+func NewDefaultDeleteWorkspaceHandler(
+	handler DeleteWorkspaceCommandHandlerFunc,
+) *DeleteWorkspaceHandler {
+	return NewDeleteWorkspaceHandler(
+		MapDeleteWorkspaceHttp,
+		handler,
+		marshal.DefaultMarshalerProvider,
+	)
+}
+
+// NewDeleteWorkspaceHandler creates a DeleteWorkspaceHandler
+func NewDeleteWorkspaceHandler(
+	mapperFunc DeleteWorkspaceMapperFunc,
+	commandHandler DeleteWorkspaceCommandHandlerFunc,
+	marshalerProvider marshal.MarshalerProvider,
+) *DeleteWorkspaceHandler {
+	return &DeleteWorkspaceHandler{
+		MapperFunc:        mapperFunc,
+		CommandHandler:    commandHandler,
+		MarshalerProvider: marshalerProvider,
+	}
+}
+
+func (h *DeleteWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l := log.FromContext(r.Context())
+	l.Debug("executing delete")
+
+	// build marshaler for the given request
+	l.Debug("building marshaler for request")
+	m, err := h.MarshalerProvider(r)
+	if err != nil {
+		l.Debug("error building marshaler for request", "error", err)
+		status.WriteBadRequest(w, err)
+		return
+	}
+
+	// map
+	l.Debug("mapping request to delete command")
+	c, err := h.MapperFunc(r)
+	if err != nil {
+		l.Debug("error mapping request to command", "error", err)
+		status.WriteBadRequest(w, err)
+		return
+	}
+
+	// execute
+	l.Debug("executing delete command", "command", c)
+	cr, err := h.CommandHandler(r.Context(), *c)
+	if err != nil {
+		logHandlerError(l, "error executing delete command", err)
+		status.WriteError(w, err)
+		return
+	}
+
+	// marshal response
+	l.Debug("marshaling response", "response", &cr)
+	d, err := m.Marshal(cr.Workspace)
+	if err != nil {
+		l.Error("unexpected error marshaling response", "error", err)
+		status.WriteError(w, err)
+		return
+	}
+
+	// reply
+	l.Debug("writing response", "response", d)
+	w.Header().Add(header.ContentType, m.ContentType())
+	if _, err := w.Write(d); err != nil {
+		l.Error("unexpected error writing response", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+}
+
+func MapDeleteWorkspaceHttp(r *http.Request) (*workspace.DeleteWorkspaceCommand, error) {
+	// retrieve name and namespace from path
+	n := r.PathValue("name")
+	ns := r.PathValue("namespace")
+
+	w := restworkspacesv1alpha1.Workspace{}
+	w.SetName(n)
+	w.SetNamespace(ns)
+
+	// build command
+	return &workspace.DeleteWorkspaceCommand{
+		Workspace: w,
+	}, nil
+}
@@ -0,0 +1,20 @@
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// parseDryRun returns true if the request asks for a dry run with the `dryRun=All` query parameter.
+// As in the Kubernetes API Server, `All` is the only supported value.
+func parseDryRun(r *http.Request) (bool, error) {
+	vv := r.URL.Query()["dryRun"]
+	for _, v := range vv {
+		if v != metav1.DryRunAll {
+			return false, fmt.Errorf("invalid dryRun %q: only %q is supported", v, metav1.DryRunAll)
+		}
+	}
+	return len(vv) > 0, nil
+}
@@ -0,0 +1,102 @@
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/konflux-workspaces/workspaces/server/rest/header"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+// The ETag of a Workspace is its quoted resourceVersion, so that HTTP preconditions
+// map to the optimistic concurrency control of the Kubernetes API Server.
+
+// setETag sets the ETag header of the response to the workspace's resourceVersion
+func setETag(w http.ResponseWriter, ws *restworkspacesv1alpha1.Workspace) {
+	if ws == nil || ws.ResourceVersion == "" {
+		return
+	}
+	w.Header().Set(header.ETag, strconv.Quote(ws.ResourceVersion))
+}
+
+// matchesIfNoneMatch returns true if the If-None-Match header of the request lists the workspace's ETag.
+// As in RFC 9110, ETags are compared weakly.
+func matchesIfNoneMatch(r *http.Request, ws *restworkspacesv1alpha1.Workspace) bool {
+	inm := r.Header.Get(header.IfNoneMatch)
+	if inm == "" || ws == nil || ws.ResourceVersion == "" {
+		return false
+	}
+
+	for _, t := range strings.Split(inm, ",") {
+		t = strings.TrimPrefix(strings.TrimSpace(t), "W/")
+		if t == "*" || t == strconv.Quote(ws.ResourceVersion) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseIfMatch returns the resourceVersion required by the If-Match header of the request,
+// or an empty string if the header is missing or is `*`.
+// Only a single strong ETag is supported.
+func parseIfMatch(r *http.Request) (string, error) {
+	im := strings.TrimSpace(r.Header.Get(header.IfMatch))
+	if im == "" || im == "*" {
+		return "", nil
+	}
+
+	rv, err := strconv.Unquote(im)
+	if err != nil || strings.HasPrefix(im, "W/") || strings.Contains(rv, `"`) {
+		return "", fmt.Errorf("invalid If-Match %q: a single strong entity tag is expected", im)
+	}
+	return rv, nil
+}
+
+// asPreconditionFailed translates the Conflict errors returned while executing a request
+// carrying an If-Match header into the PreconditionFailed errors mandated by HTTP
+func asPreconditionFailed(r *http.Request, err error) error {
+	if r.Header.Get(header.IfMatch) == "" || !kerrors.IsConflict(err) {
+		return err
+	}
+
+	var as kerrors.APIStatus
+	if !errors.As(err, &as) {
+		return err
+	}
+	s := as.Status()
+	s.Code = http.StatusPreconditionFailed
+	return &kerrors.StatusError{ErrStatus: s}
+}
+
+// newPreconditionFailed builds the error returned when the If-Match header does not match the workspace
+func newPreconditionFailed(name string, message string) error {
+	return newPreconditionError(http.StatusPreconditionFailed, name, message)
+}
+
+// newPreconditionRequired builds the error returned when a request replacing the workspace
+// carries neither a resourceVersion nor an If-Match header, as lost updates could not be detected
+func newPreconditionRequired(name string) error {
+	return newPreconditionError(http.StatusPreconditionRequired, name,
+		"a resourceVersion or an If-Match header is required to replace the workspace; use `If-Match: *` to replace it unconditionally")
+}
+
+func newPreconditionError(code int32, name string, message string) error {
+	return &kerrors.StatusError{ErrStatus: metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    code,
+		Reason:  metav1.StatusReasonConflict,
+		Message: message,
+		Details: &metav1.StatusDetails{
+			Name:  name,
+			Group: restworkspacesv1alpha1.GroupVersion.Group,
+			Kind:  "workspaces",
+		},
+	}}
+}
@@ -0,0 +1,110 @@
+package workspace
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/konflux-workspaces/workspaces/server/core/workspace"
+	"github.com/konflux-workspaces/workspaces/server/log"
+	"github.com/konflux-workspaces/workspaces/server/rest/header"
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+	"github.com/konflux-workspaces/workspaces/server/rest/status"
+)
+
+var (
+	_ http.Handler = &ListWorkspaceEventsHandler{}
+
+	_ ListWorkspaceEventsMapperFunc = MapListWorkspaceEventsHttp
+)
+
+// handler dependencies
+type ListWorkspaceEventsMapperFunc func(r *http.Request) (*workspace.ListWorkspaceEventsQuery, error)
+type ListWorkspaceEventsQueryHandlerFunc func(context.Context, workspace.ListWorkspaceEventsQuery) (*workspace.ListWorkspaceEventsResponse, error)
+
+// ListWorkspaceEventsHandler the http.Request handler for List Workspace Events endpoint
+type ListWorkspaceEventsHandler struct {
+	MapperFunc   ListWorkspaceEventsMapperFunc
+	QueryHandler ListWorkspaceEventsQueryHandlerFunc
+
+	MarshalerProvider marshal.MarshalerProvider
+}
+
+// NewDefaultListWorkspaceEventsHandler creates a ListWorkspaceEventsHandler
+func NewDefaultListWorkspaceEventsHandler(
+	handler ListWorkspaceEventsQueryHandlerFunc,
+) *ListWorkspaceEventsHandler {
+	return NewListWorkspaceEventsHandler(
+		MapListWorkspaceEventsHttp,
+		handler,
+		marshal.DefaultMarshalerProvider,
+	)
+}
+
+// NewListWorkspaceEventsHandler creates a ListWorkspaceEventsHandler
+func NewListWorkspaceEventsHandler(
+	mapperFunc ListWorkspaceEventsMapperFunc,
+	queryHandler ListWorkspaceEventsQueryHandlerFunc,
+	marshalerProvider marshal.MarshalerProvider,
+) *ListWorkspaceEventsHandler {
+	return &ListWorkspaceEventsHandler{
+		MapperFunc:        mapperFunc,
+		QueryHandler:      queryHandler,
+		MarshalerProvider: marshalerProvider,
+	}
+}
+
+func (h *ListWorkspaceEventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l := log.FromContext(r.Context())
+	l.Debug("executing list events")
+
+	// build marshaler for the given request
+	l.Debug("building marshaler for request")
+	m, err := h.MarshalerProvider(r)
+	if err != nil {
+		l.Debug("error building marshaler for request", "error", err)
+		status.WriteBadRequest(w, err)
+		return
+	}
+
+	// map
+	l.Debug("mapping request to list events query")
+	q, err := h.MapperFunc(r)
+	if err != nil {
+		l.Debug("error mapping request to list events query", "error", err)
+		status.WriteBadRequest(w, err)
+		return
+	}
+
+	// execute
+	l.Debug("executing list events query", "query", q)
+	qr, err := h.QueryHandler(r.Context(), *q)
+	if err != nil {
+		logHandlerError(l, "error executing list events query", err)
+		status.WriteError(w, err)
+		return
+	}
+
+	// marshal response
+	l.Debug("marshaling response", "query", qr)
+	d, err := m.Marshal(qr.Events)
+	if err != nil {
+		l.Error("error marshaling response", "error", err)
+		status.WriteError(w, err)
+		return
+	}
+
+	// reply
+	l.Debug("writing response", "response", d)
+	w.Header().Add(header.ContentType, m.ContentType())
+	if _, err := w.Write(d); err != nil {
+		l.Error("error writing response", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+}
+
+func MapListWorkspaceEventsHttp(r *http.Request) (*workspace.ListWorkspaceEventsQuery, error) {
+	c := r.PathValue("name")
+	ns := r.PathValue("namespace")
+	return &workspace.ListWorkspaceEventsQuery{Name: c, Owner: ns}, nil
+}
@@ -0,0 +1,101 @@
+package workspace_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/konflux-workspaces/workspaces/server/rest/workspace/mocks"
+
+	coreworkspace "github.com/konflux-workspaces/workspaces/server/core/workspace"
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+	"github.com/konflux-workspaces/workspaces/server/rest/workspace"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("Events", func() {
+	var (
+		ctrl    *gomock.Controller
+		request *http.Request
+		fake    *mocks.MockFakeResponseWriter
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		w := &restworkspacesv1alpha1.Workspace{}
+		w.Name = "foo"
+		w.Namespace = "bar"
+
+		request = buildGetRequest(w)
+		request.SetPathValue("namespace", w.Namespace)
+		request.SetPathValue("name", w.Name)
+		fake = mocks.NewMockFakeResponseWriter(ctrl)
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	DescribeTable("workspace GET handler: events",
+		func(
+			eventsHandler workspace.ListWorkspaceEventsQueryHandlerFunc,
+			marshaler marshal.MarshalerProvider,
+			responseFunc func() http.ResponseWriter,
+		) {
+			response := responseFunc()
+			handler := workspace.NewListWorkspaceEventsHandler(workspace.MapListWorkspaceEventsHttp, eventsHandler, marshaler)
+			handler.ServeHTTP(response, request)
+		},
+		Entry("failure in marshal provider", nopEventsHandler, errorMarshalProvider, func() http.ResponseWriter {
+			expectStatus(fake, http.StatusBadRequest)
+			return fake
+		}),
+		Entry("failure in events handler", badEventsHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
+			expectStatus(fake, http.StatusInternalServerError)
+			return fake
+		}),
+		Entry("failure marshaling response", nopEventsHandler, badMarshalProvider, func() http.ResponseWriter {
+			expectStatus(fake, http.StatusInternalServerError)
+			return fake
+		}),
+		Entry("failure to write response", nopEventsHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
+			fake.EXPECT().Header().Return(http.Header{})
+			fake.EXPECT().Write(gomock.Any()).Return(0, fmt.Errorf("failed to write response body"))
+			fake.EXPECT().WriteHeader(http.StatusInternalServerError)
+			return fake
+		}),
+		Entry("events of the workspace", nopEventsHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
+			fake.EXPECT().Header().Return(http.Header{})
+			fake.EXPECT().Write(gomock.Any()).DoAndReturn(func(a any) (int, error) {
+				d, ok := a.([]byte)
+				Expect(ok).To(BeTrue())
+				ee := restworkspacesv1alpha1.WorkspaceEventList{}
+				Expect(json.Unmarshal(d, &ee)).To(Succeed())
+				Expect(ee.Items).To(HaveLen(1))
+				Expect(ee.Items[0].ObjectMeta.Namespace).To(Equal("bar"))
+				Expect(ee.Items[0].Reason).To(Equal("OwnerResolved"))
+				return len(d), nil
+			})
+			return fake
+		}),
+	)
+})
+
+func badEventsHandler(ctx context.Context, query coreworkspace.ListWorkspaceEventsQuery) (*coreworkspace.ListWorkspaceEventsResponse, error) {
+	return nil, fmt.Errorf("bad events handler")
+}
+
+func nopEventsHandler(_ context.Context, query coreworkspace.ListWorkspaceEventsQuery) (*coreworkspace.ListWorkspaceEventsResponse, error) {
+	return &coreworkspace.ListWorkspaceEventsResponse{
+		Events: &restworkspacesv1alpha1.WorkspaceEventList{Items: []restworkspacesv1alpha1.WorkspaceEvent{{
+			ObjectMeta: metav1.ObjectMeta{Namespace: query.Owner},
+			Reason:     "OwnerResolved",
+		}}},
+	}, nil
+}
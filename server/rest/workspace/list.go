@@ -2,12 +2,18 @@ package workspace
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/konflux-workspaces/workspaces/server/core/workspace"
 	"github.com/konflux-workspaces/workspaces/server/log"
 	"github.com/konflux-workspaces/workspaces/server/rest/header"
 	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+	"github.com/konflux-workspaces/workspaces/server/rest/status"
 )
 
 var (
@@ -34,7 +40,7 @@ func NewDefaultListWorkspaceHandler(
 	return NewListWorkspaceHandler(
 		MapListWorkspaceHttp,
 		handler,
-		marshal.DefaultMarshalerProvider,
+		TableMarshalerProvider,
 	)
 }
 
@@ -59,8 +65,8 @@ func (h *ListWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	l.Debug("building marshaler for request")
 	m, err := h.MarshalerProvider(r)
 	if err != nil {
-		l.Error("error building marshaler for request", "error", err)
-		w.WriteHeader(http.StatusBadRequest)
+		l.Debug("error building marshaler for request", "error", err)
+		status.WriteBadRequest(w, err)
 		return
 	}
 
@@ -68,8 +74,8 @@ func (h *ListWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	l.Debug("mapping request to list query")
 	q, err := h.MapperFunc(r)
 	if err != nil {
-		l.Error("error mapping request to query", "error", err)
-		w.WriteHeader(http.StatusBadRequest)
+		l.Debug("error mapping request to query", "error", err)
+		status.WriteBadRequest(w, err)
 		return
 	}
 
@@ -77,8 +83,8 @@ func (h *ListWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	l.Debug("executing create query", "query", q)
 	qr, err := h.QueryHandler(r.Context(), *q)
 	if err != nil {
-		l.Error("error executing list query", "query", q, "error", err)
-		w.WriteHeader(http.StatusInternalServerError)
+		logHandlerError(l, "error executing list query", err, "query", q)
+		status.WriteError(w, err)
 		return
 	}
 
@@ -87,7 +93,7 @@ func (h *ListWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	d, err := m.Marshal(qr.Workspaces)
 	if err != nil {
 		l.Error("error marshaling response", "error", err)
-		w.WriteHeader(http.StatusInternalServerError)
+		status.WriteError(w, err)
 		return
 	}
 
@@ -101,11 +107,53 @@ func (h *ListWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// MapListWorkspaceHttp maps the namespace path value and the labelSelector, fieldSelector, limit, and continue query parameters to a ListWorkspaceQuery
 func MapListWorkspaceHttp(r *http.Request) (*workspace.ListWorkspaceQuery, error) {
 	q := workspace.ListWorkspaceQuery{}
 	ns := r.PathValue("namespace")
 	if ns != "" {
 		q.Namespace = ns
 	}
+
+	// selectors
+	ls, fs, err := mapSelectors(r)
+	if err != nil {
+		return nil, err
+	}
+	q.LabelSelector, q.FieldSelector = ls, fs
+
+	// pagination
+	if l := r.URL.Query().Get("limit"); l != "" {
+		limit, err := strconv.ParseInt(l, 10, 64)
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid limit %q: must be a non-negative integer", l)
+		}
+		q.Limit = limit
+	}
+	q.Continue = r.URL.Query().Get("continue")
+
 	return &q, nil
 }
+
+// mapSelectors parses the labelSelector and fieldSelector query parameters, if any
+func mapSelectors(r *http.Request) (labels.Selector, fields.Selector, error) {
+	var ls labels.Selector
+	if v := r.URL.Query().Get("labelSelector"); v != "" {
+		s, err := labels.Parse(v)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid label selector %q: %w", v, err)
+		}
+		ls = s
+	}
+
+	var fs fields.Selector
+	if v := r.URL.Query().Get("fieldSelector"); v != "" {
+		s, err := fields.ParseSelector(v)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid field selector %q: %w", v, err)
+		}
+		fs = s
+	}
+
+	return ls, fs, nil
+}
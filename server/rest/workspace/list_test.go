@@ -9,6 +9,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/konflux-workspaces/workspaces/server/rest/workspace/mocks"
 
@@ -51,15 +53,15 @@ var _ = Describe("List", func() {
 			handler.ServeHTTP(response, request)
 		},
 		Entry("failure in marshal provider", workspace.MapListWorkspaceHttp, nopListHandler, errorMarshalProvider, func() http.ResponseWriter {
-			fake.EXPECT().WriteHeader(http.StatusBadRequest)
+			expectStatus(fake, http.StatusBadRequest)
 			return fake
 		}),
 		Entry("failure in read handler", workspace.MapListWorkspaceHttp, badListHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
-			fake.EXPECT().WriteHeader(http.StatusInternalServerError)
+			expectStatus(fake, http.StatusInternalServerError)
 			return fake
 		}),
 		Entry("failure marshaling response", workspace.MapListWorkspaceHttp, nopListHandler, badMarshalProvider, func() http.ResponseWriter {
-			fake.EXPECT().WriteHeader(http.StatusInternalServerError)
+			expectStatus(fake, http.StatusInternalServerError)
 			return fake
 		}),
 		Entry("failure to write response", workspace.MapListWorkspaceHttp, nopListHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
@@ -77,6 +79,46 @@ var _ = Describe("List", func() {
 			})
 			return fake
 		}),
+		Entry("invalid label selector", workspace.MapListWorkspaceHttp, nopListHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
+			request.URL.RawQuery = "labelSelector=team%3D%3D%3D"
+			expectStatus(fake, http.StatusBadRequest)
+			return fake
+		}),
+		Entry("invalid field selector", workspace.MapListWorkspaceHttp, nopListHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
+			request.URL.RawQuery = "fieldSelector=spec.visibility%3D%3D%3D"
+			expectStatus(fake, http.StatusBadRequest)
+			return fake
+		}),
+		Entry("invalid limit", workspace.MapListWorkspaceHttp, nopListHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
+			request.URL.RawQuery = "limit=-1"
+			expectStatus(fake, http.StatusBadRequest)
+			return fake
+		}),
+	)
+
+	DescribeTable("maps pagination query parameters", func(query string, expected coreworkspace.ListWorkspaceQuery) {
+		// given
+		request.URL.RawQuery = query
+		request.SetPathValue("namespace", w.Namespace)
+
+		// when
+		q, err := workspace.MapListWorkspaceHttp(request)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*q).To(Equal(expected))
+	},
+		Entry("no parameters", "", coreworkspace.ListWorkspaceQuery{Namespace: "bar"}),
+		Entry("limit", "limit=10", coreworkspace.ListWorkspaceQuery{Namespace: "bar", Limit: 10}),
+		Entry("limit and continue", "limit=10&continue=token", coreworkspace.ListWorkspaceQuery{Namespace: "bar", Limit: 10, Continue: "token"}),
+		Entry("field selector", "fieldSelector=spec.visibility%3Dcommunity", coreworkspace.ListWorkspaceQuery{
+			Namespace:     "bar",
+			FieldSelector: fields.OneTermEqualSelector("spec.visibility", "community"),
+		}),
+		Entry("label selector", "labelSelector=team%3Dplatform", coreworkspace.ListWorkspaceQuery{
+			Namespace:     "bar",
+			LabelSelector: labels.SelectorFromSet(labels.Set{"team": "platform"}),
+		}),
 	)
 })
 
@@ -0,0 +1,20 @@
+package workspace
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/konflux-workspaces/workspaces/server/rest/status"
+)
+
+// logHandlerError logs the error returned by a query or command handler.
+// Errors replied to the client with a 4xx status are caused by the request,
+// so they are logged at Debug level; any other error is logged at Error level.
+func logHandlerError(l *slog.Logger, msg string, err error, args ...any) {
+	args = append(args, "error", err)
+	if status.FromError(err).Code < http.StatusInternalServerError {
+		l.Debug(msg, args...)
+		return
+	}
+	l.Error(msg, args...)
+}
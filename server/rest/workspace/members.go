@@ -0,0 +1,249 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/konflux-workspaces/workspaces/server/core/workspace"
+	"github.com/konflux-workspaces/workspaces/server/log"
+	"github.com/konflux-workspaces/workspaces/server/rest/header"
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+	"github.com/konflux-workspaces/workspaces/server/rest/status"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var (
+	_ http.Handler = &PutWorkspaceMemberHandler{}
+	_ http.Handler = &DeleteWorkspaceMemberHandler{}
+
+	_ PutWorkspaceMemberMapperFunc    = MapPutWorkspaceMemberHttp
+	_ DeleteWorkspaceMemberMapperFunc = MapDeleteWorkspaceMemberHttp
+)
+
+// handler dependencies
+type PutWorkspaceMemberMapperFunc func(*http.Request, marshal.UnmarshalerProvider) (*workspace.AddWorkspaceMemberCommand, error)
+type AddWorkspaceMemberCommandHandlerFunc func(context.Context, workspace.AddWorkspaceMemberCommand) (*workspace.AddWorkspaceMemberResponse, error)
+
+type DeleteWorkspaceMemberMapperFunc func(*http.Request) (*workspace.RemoveWorkspaceMemberCommand, error)
+type RemoveWorkspaceMemberCommandHandlerFunc func(context.Context, workspace.RemoveWorkspaceMemberCommand) (*workspace.RemoveWorkspaceMemberResponse, error)
+
+// PutWorkspaceMemberHandler the http.Request handler for Put Workspace Member endpoint
+type PutWorkspaceMemberHandler struct {
+	MapperFunc     PutWorkspaceMemberMapperFunc
+	CommandHandler AddWorkspaceMemberCommandHandlerFunc
+
+	MarshalerProvider   marshal.MarshalerProvider
+	UnmarshalerProvider marshal.UnmarshalerProvider
+}
+
+// NewDefaultPutWorkspaceMemberHandler creates a PutWorkspaceMemberHandler
+func NewDefaultPutWorkspaceMemberHandler(
+	handler AddWorkspaceMemberCommandHandlerFunc,
+) *PutWorkspaceMemberHandler {
+	return NewPutWorkspaceMemberHandler(
+		MapPutWorkspaceMemberHttp,
+		handler,
+		marshal.DefaultMarshalerProvider,
+		marshal.DefaultUnmarshalerProvider,
+	)
+}
+
+// NewPutWorkspaceMemberHandler creates a PutWorkspaceMemberHandler
+func NewPutWorkspaceMemberHandler(
+	mapperFunc PutWorkspaceMemberMapperFunc,
+	commandHandler AddWorkspaceMemberCommandHandlerFunc,
+	marshalerProvider marshal.MarshalerProvider,
+	unmarshalerProvider marshal.UnmarshalerProvider,
+) *PutWorkspaceMemberHandler {
+	return &PutWorkspaceMemberHandler{
+		MapperFunc:          mapperFunc,
+		CommandHandler:      commandHandler,
+		MarshalerProvider:   marshalerProvider,
+		UnmarshalerProvider: unmarshalerProvider,
+	}
+}
+
+func (h *PutWorkspaceMemberHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l := log.FromContext(r.Context())
+	l.Debug("executing put member")
+
+	// build marshaler for the given request
+	l.Debug("building marshaler for request")
+	m, err := h.MarshalerProvider(r)
+	if err != nil {
+		l.Debug("error building marshaler for request", "error", err)
+		status.WriteBadRequest(w, err)
+		return
+	}
+
+	// map
+	l.Debug("mapping request to add member command")
+	c, err := h.MapperFunc(r, h.UnmarshalerProvider)
+	if err != nil {
+		l.Debug("error mapping request to command", "error", err)
+		status.WriteBadRequest(w, err)
+		return
+	}
+
+	// execute
+	l.Debug("executing add member command", "command", c)
+	cr, err := h.CommandHandler(r.Context(), *c)
+	if err != nil {
+		logHandlerError(l, "error executing add member command", err)
+		status.WriteError(w, err)
+		return
+	}
+
+	// marshal response
+	l.Debug("marshaling response", "response", &cr)
+	d, err := m.Marshal(cr.Workspace)
+	if err != nil {
+		l.Error("unexpected error marshaling response", "error", err)
+		status.WriteError(w, err)
+		return
+	}
+
+	// reply
+	l.Debug("writing response", "response", d)
+	w.Header().Add(header.ContentType, m.ContentType())
+	if _, err := w.Write(d); err != nil {
+		l.Error("unexpected error writing response", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+}
+
+// DeleteWorkspaceMemberHandler the http.Request handler for Delete Workspace Member endpoint
+type DeleteWorkspaceMemberHandler struct {
+	MapperFunc     DeleteWorkspaceMemberMapperFunc
+	CommandHandler RemoveWorkspaceMemberCommandHandlerFunc
+
+	MarshalerProvider marshal.MarshalerProvider
+}
+
+// NewDefaultDeleteWorkspaceMemberHandler creates a DeleteWorkspaceMemberHandler
+func NewDefaultDeleteWorkspaceMemberHandler(
+	handler RemoveWorkspaceMemberCommandHandlerFunc,
+) *DeleteWorkspaceMemberHandler {
+	return NewDeleteWorkspaceMemberHandler(
+		MapDeleteWorkspaceMemberHttp,
+		handler,
+		marshal.DefaultMarshalerProvider,
+	)
+}
+
+// NewDeleteWorkspaceMemberHandler creates a DeleteWorkspaceMemberHandler
+func NewDeleteWorkspaceMemberHandler(
+	mapperFunc DeleteWorkspaceMemberMapperFunc,
+	commandHandler RemoveWorkspaceMemberCommandHandlerFunc,
+	marshalerProvider marshal.MarshalerProvider,
+) *DeleteWorkspaceMemberHandler {
+	return &DeleteWorkspaceMemberHandler{
+		MapperFunc:        mapperFunc,
+		CommandHandler:    commandHandler,
+		MarshalerProvider: marshalerProvider,
+	}
+}
+
+func (h *DeleteWorkspaceMemberHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l := log.FromContext(r.Context())
+	l.Debug("executing delete member")
+
+	// build marshaler for the given request
+	l.Debug("building marshaler for request")
+	m, err := h.MarshalerProvider(r)
+	if err != nil {
+		l.Debug("error building marshaler for request", "error", err)
+		status.WriteBadRequest(w, err)
+		return
+	}
+
+	// map
+	l.Debug("mapping request to remove member command")
+	c, err := h.MapperFunc(r)
+	if err != nil {
+		l.Debug("error mapping request to command", "error", err)
+		status.WriteBadRequest(w, err)
+		return
+	}
+
+	// execute
+	l.Debug("executing remove member command", "command", c)
+	cr, err := h.CommandHandler(r.Context(), *c)
+	if err != nil {
+		logHandlerError(l, "error executing remove member command", err)
+		status.WriteError(w, err)
+		return
+	}
+
+	// marshal response
+	l.Debug("marshaling response", "response", &cr)
+	d, err := m.Marshal(cr.Workspace)
+	if err != nil {
+		l.Error("unexpected error marshaling response", "error", err)
+		status.WriteError(w, err)
+		return
+	}
+
+	// reply
+	l.Debug("writing response", "response", d)
+	w.Header().Add(header.ContentType, m.ContentType())
+	if _, err := w.Write(d); err != nil {
+		l.Error("unexpected error writing response", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+}
+
+func MapPutWorkspaceMemberHttp(r *http.Request, provider marshal.UnmarshalerProvider) (*workspace.AddWorkspaceMemberCommand, error) {
+	// build unmarshaler for the given request
+	u, err := provider(r)
+	if err != nil {
+		return nil, fmt.Errorf("error building unmarshaler body: %w", err)
+	}
+
+	// parse request body
+	d, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %w", err)
+	}
+
+	// unmarshal body to WorkspaceMember
+	wm := restworkspacesv1alpha1.WorkspaceMember{}
+	if err := u.Unmarshal(d, &wm); err != nil {
+		return nil, fmt.Errorf("error unmarshaling request body: %w", err)
+	}
+
+	// retrieve name, namespace, and member from path
+	n := r.PathValue("name")
+	ns := r.PathValue("namespace")
+	mn := r.PathValue("member")
+	if wm.Username != "" && wm.Username != mn {
+		return nil, fmt.Errorf("member username %q does not match the one in path %q", wm.Username, mn)
+	}
+	wm.Username = mn
+
+	// build command
+	return &workspace.AddWorkspaceMemberCommand{
+		Owner:     ns,
+		Workspace: n,
+		Member:    wm,
+	}, nil
+}
+
+func MapDeleteWorkspaceMemberHttp(r *http.Request) (*workspace.RemoveWorkspaceMemberCommand, error) {
+	// retrieve name, namespace, and member from path
+	n := r.PathValue("name")
+	ns := r.PathValue("namespace")
+	mn := r.PathValue("member")
+
+	// build command
+	return &workspace.RemoveWorkspaceMemberCommand{
+		Owner:     ns,
+		Workspace: n,
+		Member:    mn,
+	}, nil
+}
@@ -0,0 +1,233 @@
+package workspace_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/konflux-workspaces/workspaces/server/rest/workspace/mocks"
+
+	"github.com/konflux-workspaces/workspaces/server/core"
+	coreworkspace "github.com/konflux-workspaces/workspaces/server/core/workspace"
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+	"github.com/konflux-workspaces/workspaces/server/rest/workspace"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("Members tests", func() {
+	var (
+		ctrl *gomock.Controller
+		fake *mocks.MockFakeResponseWriter
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		fake = mocks.NewMockFakeResponseWriter(ctrl)
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	expectWorkspaceWritten := func() {
+		fake.EXPECT().Header().Return(http.Header{})
+		fake.EXPECT().Write(gomock.Any()).DoAndReturn(func(a any) (int, error) {
+			slice, ok := a.([]byte)
+			Expect(ok).To(BeTrue())
+			return len(slice), nil
+		})
+	}
+
+	Describe("PUT member", func() {
+		var request *http.Request
+
+		BeforeEach(func() {
+			request = buildPutMemberRequest("bar", "foo", "baz", restworkspacesv1alpha1.WorkspaceMember{
+				Role: restworkspacesv1alpha1.WorkspaceRoleContributor,
+			})
+		})
+
+		DescribeTable("workspace member PUT handler",
+			func(
+				mapperFunc workspace.PutWorkspaceMemberMapperFunc,
+				addHandler workspace.AddWorkspaceMemberCommandHandlerFunc,
+				marshaler marshal.MarshalerProvider,
+				unmarshaler marshal.UnmarshalerProvider,
+				responseFunc func() http.ResponseWriter,
+			) {
+				response := responseFunc()
+				handler := workspace.NewPutWorkspaceMemberHandler(mapperFunc, addHandler, marshaler, unmarshaler)
+				handler.ServeHTTP(response, request)
+			},
+			Entry("failure in marshal provider", workspace.MapPutWorkspaceMemberHttp, nopAddMemberHandler, errorMarshalProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+				expectStatus(fake, http.StatusBadRequest)
+				return fake
+			}),
+			Entry("failure in unmarshal provider", workspace.MapPutWorkspaceMemberHttp, nopAddMemberHandler, marshal.DefaultMarshalerProvider, errorUnmarshalProvider, func() http.ResponseWriter {
+				expectStatus(fake, http.StatusBadRequest)
+				return fake
+			}),
+			Entry("no body sent in request", workspace.MapPutWorkspaceMemberHttp, nopAddMemberHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+				request.Body = io.NopCloser(bytes.NewReader([]byte{}))
+				expectStatus(fake, http.StatusBadRequest)
+				return fake
+			}),
+			Entry("username not matching the path", workspace.MapPutWorkspaceMemberHttp, nopAddMemberHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+				request = buildPutMemberRequest("bar", "foo", "baz", restworkspacesv1alpha1.WorkspaceMember{
+					Username: "not-baz",
+					Role:     restworkspacesv1alpha1.WorkspaceRoleContributor,
+				})
+				expectStatus(fake, http.StatusBadRequest)
+				return fake
+			}),
+			Entry("failure in add member handler", workspace.MapPutWorkspaceMemberHttp, badAddMemberHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+				expectStatus(fake, http.StatusInternalServerError)
+				return fake
+			}),
+			Entry("member management forbidden", workspace.MapPutWorkspaceMemberHttp, forbiddenAddMemberHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+				expectStatus(fake, http.StatusForbidden)
+				return fake
+			}),
+			Entry("failure marshaling response", workspace.MapPutWorkspaceMemberHttp, nopAddMemberHandler, badMarshalProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+				expectStatus(fake, http.StatusInternalServerError)
+				return fake
+			}),
+			Entry("failure to write response", workspace.MapPutWorkspaceMemberHttp, nopAddMemberHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+				fake.EXPECT().Header().Return(http.Header{})
+				fake.EXPECT().Write(gomock.Any()).Return(0, fmt.Errorf("failed to write response body"))
+				fake.EXPECT().WriteHeader(http.StatusInternalServerError)
+				return fake
+			}),
+			Entry("member added", workspace.MapPutWorkspaceMemberHttp, nopAddMemberHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+				expectWorkspaceWritten()
+				return fake
+			}),
+		)
+
+		It("should map the member from path and body", func() {
+			// when
+			c, err := workspace.MapPutWorkspaceMemberHttp(request, marshal.DefaultUnmarshalerProvider)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*c).To(Equal(coreworkspace.AddWorkspaceMemberCommand{
+				Owner:     "bar",
+				Workspace: "foo",
+				Member: restworkspacesv1alpha1.WorkspaceMember{
+					Username: "baz",
+					Role:     restworkspacesv1alpha1.WorkspaceRoleContributor,
+				},
+			}))
+		})
+	})
+
+	Describe("DELETE member", func() {
+		var request *http.Request
+
+		BeforeEach(func() {
+			request = buildDeleteMemberRequest("bar", "foo", "baz")
+		})
+
+		DescribeTable("workspace member DELETE handler",
+			func(
+				mapperFunc workspace.DeleteWorkspaceMemberMapperFunc,
+				removeHandler workspace.RemoveWorkspaceMemberCommandHandlerFunc,
+				marshaler marshal.MarshalerProvider,
+				responseFunc func() http.ResponseWriter,
+			) {
+				response := responseFunc()
+				handler := workspace.NewDeleteWorkspaceMemberHandler(mapperFunc, removeHandler, marshaler)
+				handler.ServeHTTP(response, request)
+			},
+			Entry("failure in marshal provider", workspace.MapDeleteWorkspaceMemberHttp, nopRemoveMemberHandler, errorMarshalProvider, func() http.ResponseWriter {
+				expectStatus(fake, http.StatusBadRequest)
+				return fake
+			}),
+			Entry("failure in remove member handler", workspace.MapDeleteWorkspaceMemberHttp, badRemoveMemberHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
+				expectStatus(fake, http.StatusInternalServerError)
+				return fake
+			}),
+			Entry("member not found", workspace.MapDeleteWorkspaceMemberHttp, notFoundRemoveMemberHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
+				expectStatus(fake, http.StatusNotFound)
+				return fake
+			}),
+			Entry("failure marshaling response", workspace.MapDeleteWorkspaceMemberHttp, nopRemoveMemberHandler, badMarshalProvider, func() http.ResponseWriter {
+				expectStatus(fake, http.StatusInternalServerError)
+				return fake
+			}),
+			Entry("member removed", workspace.MapDeleteWorkspaceMemberHttp, nopRemoveMemberHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
+				expectWorkspaceWritten()
+				return fake
+			}),
+		)
+	})
+})
+
+func badAddMemberHandler(ctx context.Context, cmd coreworkspace.AddWorkspaceMemberCommand) (*coreworkspace.AddWorkspaceMemberResponse, error) {
+	return nil, fmt.Errorf("bad add member handler")
+}
+
+func forbiddenAddMemberHandler(ctx context.Context, cmd coreworkspace.AddWorkspaceMemberCommand) (*coreworkspace.AddWorkspaceMemberResponse, error) {
+	return nil, kerrors.NewForbidden(restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource(), cmd.Workspace, fmt.Errorf("only the owner and admins can manage the workspace's members"))
+}
+
+func nopAddMemberHandler(_ context.Context, cmd coreworkspace.AddWorkspaceMemberCommand) (*coreworkspace.AddWorkspaceMemberResponse, error) {
+	w := restworkspacesv1alpha1.Workspace{}
+	w.SetName(cmd.Workspace)
+	w.SetNamespace(cmd.Owner)
+	w.Spec.Members = []restworkspacesv1alpha1.WorkspaceMember{cmd.Member}
+	return &coreworkspace.AddWorkspaceMemberResponse{
+		Workspace: &w,
+	}, nil
+}
+
+func badRemoveMemberHandler(ctx context.Context, cmd coreworkspace.RemoveWorkspaceMemberCommand) (*coreworkspace.RemoveWorkspaceMemberResponse, error) {
+	return nil, fmt.Errorf("bad remove member handler")
+}
+
+func notFoundRemoveMemberHandler(ctx context.Context, cmd coreworkspace.RemoveWorkspaceMemberCommand) (*coreworkspace.RemoveWorkspaceMemberResponse, error) {
+	return nil, fmt.Errorf("%w: member %s", core.ErrNotFound, cmd.Member)
+}
+
+func nopRemoveMemberHandler(_ context.Context, cmd coreworkspace.RemoveWorkspaceMemberCommand) (*coreworkspace.RemoveWorkspaceMemberResponse, error) {
+	w := restworkspacesv1alpha1.Workspace{}
+	w.SetName(cmd.Workspace)
+	w.SetNamespace(cmd.Owner)
+	return &coreworkspace.RemoveWorkspaceMemberResponse{
+		Workspace: &w,
+	}, nil
+}
+
+func buildPutMemberRequest(namespace, name, member string, m restworkspacesv1alpha1.WorkspaceMember) *http.Request {
+	byteSlice, err := marshal.DefaultMarshal.Marshal(m)
+	Expect(err).NotTo(HaveOccurred())
+
+	url := fmt.Sprintf("/apis/workspaces.io/v1alpha1/namespaces/%s/workspaces/%s/members/%s", namespace, name, member)
+
+	request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(byteSlice))
+	Expect(err).NotTo(HaveOccurred())
+	request.Header.Add("Content-Type", marshal.DefaultUnmarshal.ContentType())
+	request.Header.Add("Accept", marshal.DefaultMarshal.ContentType())
+	request.SetPathValue("namespace", namespace)
+	request.SetPathValue("name", name)
+	request.SetPathValue("member", member)
+	return request
+}
+
+func buildDeleteMemberRequest(namespace, name, member string) *http.Request {
+	url := fmt.Sprintf("/apis/workspaces.io/v1alpha1/namespaces/%s/workspaces/%s/members/%s", namespace, name, member)
+
+	request, err := http.NewRequest(http.MethodDelete, url, nil)
+	Expect(err).NotTo(HaveOccurred())
+	request.Header.Add("Accept", marshal.DefaultMarshal.ContentType())
+	request.SetPathValue("namespace", namespace)
+	request.SetPathValue("name", name)
+	request.SetPathValue("member", member)
+	return request
+}
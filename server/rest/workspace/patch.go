@@ -2,16 +2,17 @@ package workspace
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 
-	"github.com/konflux-workspaces/workspaces/server/core"
 	"github.com/konflux-workspaces/workspaces/server/core/workspace"
 	"github.com/konflux-workspaces/workspaces/server/log"
 	"github.com/konflux-workspaces/workspaces/server/rest/header"
 	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+	"github.com/konflux-workspaces/workspaces/server/rest/status"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -66,7 +67,7 @@ func (h *PatchWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	m, err := h.MarshalerProvider(r)
 	if err != nil {
 		l.Debug("error building marshaler for request", "error", err)
-		w.WriteHeader(http.StatusBadRequest)
+		status.WriteBadRequest(w, err)
 		return
 	}
 
@@ -75,7 +76,7 @@ func (h *PatchWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	c, err := h.MapperFunc(r)
 	if err != nil {
 		l.Debug("error mapping request to command", "error", err)
-		w.WriteHeader(http.StatusBadRequest)
+		status.WriteBadRequest(w, err)
 		return
 	}
 
@@ -83,15 +84,9 @@ func (h *PatchWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	l.Debug("executing patch command", "command", c)
 	cr, err := h.CommandHandler(r.Context(), *c)
 	if err != nil {
-		l = l.With("error", err)
-		switch {
-		case errors.Is(err, core.ErrNotFound):
-			l.Debug("error executing patch command: resource not found")
-			w.WriteHeader(http.StatusNotFound)
-		default:
-			l.Error("error executing patch command")
-			w.WriteHeader(http.StatusInternalServerError)
-		}
+		err = asPreconditionFailed(r, err)
+		logHandlerError(l, "error executing patch command", err)
+		status.WriteError(w, err)
 		return
 	}
 
@@ -100,13 +95,17 @@ func (h *PatchWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	d, err := m.Marshal(cr.Workspace)
 	if err != nil {
 		l.Error("unexpected error marshaling response", "error", err)
-		w.WriteHeader(http.StatusInternalServerError)
+		status.WriteError(w, err)
 		return
 	}
 
 	// reply
 	l.Debug("writing response", "response", d)
 	w.Header().Add(header.ContentType, m.ContentType())
+	setETag(w, cr.Workspace)
+	if cr.Created {
+		w.WriteHeader(http.StatusCreated)
+	}
 	if _, err := w.Write(d); err != nil {
 		l.Error("unexpected error writing response", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -114,6 +113,9 @@ func (h *PatchWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	}
 }
 
+// MapPatchWorkspaceHttp maps the request to a PatchWorkspaceCommand.
+// The patch type is read from the Content-Type header, while the fieldManager and force
+// query parameters drive the tracking of the managed fields.
 func MapPatchWorkspaceHttp(r *http.Request) (*workspace.PatchWorkspaceCommand, error) {
 	// parse request body
 	d, err := io.ReadAll(r.Body)
@@ -126,19 +128,59 @@ func MapPatchWorkspaceHttp(r *http.Request) (*workspace.PatchWorkspaceCommand, e
 		return nil, err
 	}
 
+	// parse field manager options
+	q := r.URL.Query()
+	fm := q.Get("fieldManager")
+	if fm == "" && pt != types.ApplyPatchType {
+		fm = fieldManagerFromUserAgent(r.UserAgent())
+	}
+	force := false
+	if q.Has("force") {
+		if pt != types.ApplyPatchType {
+			return nil, fmt.Errorf("force may not be specified for non-apply patch")
+		}
+		if force, err = strconv.ParseBool(q.Get("force")); err != nil {
+			return nil, fmt.Errorf("invalid force %q: %w", q.Get("force"), err)
+		}
+	}
+
+	// parse dry run option
+	dr, err := parseDryRun(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// parse If-Match precondition
+	rv, err := parseIfMatch(r)
+	if err != nil {
+		return nil, err
+	}
+
 	// retrieve namespace from path
 	n := r.PathValue("name")
 	ns := r.PathValue("namespace")
 
 	// build command
 	return &workspace.PatchWorkspaceCommand{
-		Workspace: n,
-		Owner:     ns,
-		PatchType: pt,
-		Patch:     d,
+		Workspace:    n,
+		Owner:        ns,
+		PatchType:    pt,
+		Patch:        d,
+		FieldManager: fm,
+		Force:        force,
+		DryRun:       dr,
+
+		ResourceVersion: rv,
 	}, nil
 }
 
+// fieldManagerFromUserAgent returns the default field manager for non-apply patches,
+// as the Kubernetes API Server does: the User-Agent up to the first `/`
+func fieldManagerFromUserAgent(userAgent string) string {
+	fm, _, _ := strings.Cut(userAgent, "/")
+	return fm
+}
+
 func parsePatchType(r *http.Request) (types.PatchType, error) {
 	ct, ok := r.Header["Content-Type"]
 	if !ok || len(ct) != 1 {
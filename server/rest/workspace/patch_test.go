@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/konflux-workspaces/workspaces/server/rest/workspace/mocks"
@@ -53,26 +54,46 @@ var _ = Describe("Patch tests", func() {
 			handler.ServeHTTP(response, request)
 		},
 		Entry("failure in marshal provider", workspace.MapPatchWorkspaceHttp, nopPatchHandler, errorMarshalProvider, func() http.ResponseWriter {
-			fake.EXPECT().WriteHeader(http.StatusBadRequest)
+			expectStatus(fake, http.StatusBadRequest)
 			return fake
 		}),
 		Entry("no Content-Type in request", workspace.MapPatchWorkspaceHttp, nopPatchHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
 			request.Body = io.NopCloser(bytes.NewReader([]byte{}))
 			request.Header.Del("Content-Type")
-			fake.EXPECT().WriteHeader(http.StatusBadRequest).Times(1)
+			expectStatus(fake, http.StatusBadRequest)
 			return fake
 		}),
 		Entry("invalid Content-Type", workspace.MapPatchWorkspaceHttp, nopPatchHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
 			request.Header.Set("Content-Type", "invalid")
-			fake.EXPECT().WriteHeader(http.StatusBadRequest).Times(1)
+			expectStatus(fake, http.StatusBadRequest)
+			return fake
+		}),
+		Entry("force with non-apply patch", workspace.MapPatchWorkspaceHttp, nopPatchHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
+			request.URL.RawQuery = "force=true"
+			expectStatus(fake, http.StatusBadRequest)
+			return fake
+		}),
+		Entry("invalid dryRun", workspace.MapPatchWorkspaceHttp, nopPatchHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
+			request.URL.RawQuery = "dryRun=true"
+			expectStatus(fake, http.StatusBadRequest)
+			return fake
+		}),
+		Entry("invalid If-Match", workspace.MapPatchWorkspaceHttp, nopPatchHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
+			request.Header.Set("If-Match", `"1", "2"`)
+			expectStatus(fake, http.StatusBadRequest)
+			return fake
+		}),
+		Entry("conflict in patch handler with If-Match", workspace.MapPatchWorkspaceHttp, conflictPatchHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
+			request.Header.Set("If-Match", `"1"`)
+			expectStatus(fake, http.StatusPreconditionFailed)
 			return fake
 		}),
 		Entry("failure in patch handler", workspace.MapPatchWorkspaceHttp, badPatchHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
-			fake.EXPECT().WriteHeader(http.StatusInternalServerError)
+			expectStatus(fake, http.StatusInternalServerError)
 			return fake
 		}),
 		Entry("failure marshaling response", workspace.MapPatchWorkspaceHttp, nopPatchHandler, badMarshalProvider, func() http.ResponseWriter {
-			fake.EXPECT().WriteHeader(http.StatusInternalServerError)
+			expectStatus(fake, http.StatusInternalServerError)
 			return fake
 		}),
 		Entry("failure to write response", workspace.MapPatchWorkspaceHttp, nopPatchHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
@@ -90,9 +111,62 @@ var _ = Describe("Patch tests", func() {
 			})
 			return fake
 		}),
+		Entry("workspace created by apply patch", workspace.MapPatchWorkspaceHttp, createdPatchHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
+			request.Header.Set("Content-Type", string(types.ApplyPatchType))
+			fake.EXPECT().Header().Return(http.Header{})
+			fake.EXPECT().WriteHeader(http.StatusCreated)
+			fake.EXPECT().Write(gomock.Any()).DoAndReturn(func(a any) (int, error) {
+				slice, ok := a.([]byte)
+				Expect(ok).To(BeTrue())
+				return len(slice), nil
+			})
+			return fake
+		}),
+	)
+
+	DescribeTable("mapping the If-Match precondition",
+		func(ifMatch string, expectedResourceVersion string) {
+			// given
+			request.Header.Set("If-Match", ifMatch)
+
+			// when
+			c, err := workspace.MapPatchWorkspaceHttp(request)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.ResourceVersion).To(Equal(expectedResourceVersion))
+		},
+		Entry("no precondition", "", ""),
+		Entry("any version", "*", ""),
+		Entry("given version", `"42"`, "42"),
+	)
+
+	DescribeTable("mapping the field manager options",
+		func(contentType, query, userAgent string, expectedFieldManager string, expectedForce bool) {
+			// given
+			request.Header.Set("Content-Type", contentType)
+			request.Header.Set("User-Agent", userAgent)
+			request.URL.RawQuery = query
+
+			// when
+			c, err := workspace.MapPatchWorkspaceHttp(request)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.FieldManager).To(Equal(expectedFieldManager))
+			Expect(c.Force).To(Equal(expectedForce))
+		},
+		Entry("field manager from query", string(types.MergePatchType), "fieldManager=argocd", "kubectl/v1.31.0", "argocd", false),
+		Entry("field manager from User-Agent", string(types.JSONPatchType), "", "kubectl/v1.31.0 (linux/amd64)", "kubectl", false),
+		Entry("apply without field manager", string(types.ApplyPatchType), "", "kubectl/v1.31.0", "", false),
+		Entry("forced apply", string(types.ApplyPatchType), "fieldManager=kubectl&force=true", "kubectl/v1.31.0", "kubectl", true),
 	)
 })
 
+func conflictPatchHandler(ctx context.Context, cmd coreworkspace.PatchWorkspaceCommand) (*coreworkspace.PatchWorkspaceResponse, error) {
+	return nil, kerrors.NewConflict(restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource(), cmd.Workspace, fmt.Errorf("conflict"))
+}
+
 func badPatchHandler(ctx context.Context, cmd coreworkspace.PatchWorkspaceCommand) (*coreworkspace.PatchWorkspaceResponse, error) {
 	return nil, fmt.Errorf("bad patch handler")
 }
@@ -101,6 +175,10 @@ func nopPatchHandler(_ctx context.Context, cmd coreworkspace.PatchWorkspaceComma
 	return &coreworkspace.PatchWorkspaceResponse{}, nil
 }
 
+func createdPatchHandler(_ctx context.Context, cmd coreworkspace.PatchWorkspaceCommand) (*coreworkspace.PatchWorkspaceResponse, error) {
+	return &coreworkspace.PatchWorkspaceResponse{Created: true}, nil
+}
+
 func buildPatchRequest(workspace *restworkspacesv1alpha1.Workspace) *http.Request {
 	byteSlice, err := marshal.DefaultMarshal.Marshal(workspace)
 	Expect(err).NotTo(HaveOccurred())
@@ -2,14 +2,13 @@ package workspace
 
 import (
 	"context"
-	"errors"
 	"net/http"
 
-	"github.com/konflux-workspaces/workspaces/server/core"
 	"github.com/konflux-workspaces/workspaces/server/core/workspace"
 	"github.com/konflux-workspaces/workspaces/server/log"
 	"github.com/konflux-workspaces/workspaces/server/rest/header"
 	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+	"github.com/konflux-workspaces/workspaces/server/rest/status"
 )
 
 var (
@@ -37,7 +36,7 @@ func NewDefaultReadWorkspaceHandler(
 	return NewReadWorkspaceHandler(
 		MapReadWorkspaceHttp,
 		handler,
-		marshal.DefaultMarshalerProvider,
+		TableMarshalerProvider,
 	)
 }
 
@@ -62,8 +61,8 @@ func (h *ReadWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	l.Debug("building marshaler for request")
 	m, err := h.MarshalerProvider(r)
 	if err != nil {
-		l.Error("error building marshaler for request", "error", err)
-		w.WriteHeader(http.StatusBadRequest)
+		l.Debug("error building marshaler for request", "error", err)
+		status.WriteBadRequest(w, err)
 		return
 	}
 
@@ -71,8 +70,8 @@ func (h *ReadWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	l.Debug("mapping request to read query")
 	q, err := h.MapperFunc(r)
 	if err != nil {
-		l.Error("error mapping request to read query", "error", err)
-		w.WriteHeader(http.StatusBadRequest)
+		l.Debug("error mapping request to read query", "error", err)
+		status.WriteBadRequest(w, err)
 		return
 	}
 
@@ -80,13 +79,16 @@ func (h *ReadWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	l.Debug("executing read query", "query", q)
 	qr, err := h.QueryHandler(r.Context(), *q)
 	if err != nil {
-		l.Error("error executing read query", "error", err)
-		switch {
-		case errors.Is(err, core.ErrNotFound):
-			w.WriteHeader(http.StatusNotFound)
-		default:
-			w.WriteHeader(http.StatusInternalServerError)
-		}
+		logHandlerError(l, "error executing read query", err)
+		status.WriteError(w, err)
+		return
+	}
+
+	// reply not modified if the client already has the current version
+	setETag(w, qr.Workspace)
+	if matchesIfNoneMatch(r, qr.Workspace) {
+		l.Debug("workspace not modified", "resourceVersion", qr.Workspace.ResourceVersion)
+		w.WriteHeader(http.StatusNotModified)
 		return
 	}
 
@@ -95,7 +97,7 @@ func (h *ReadWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	d, err := m.Marshal(qr.Workspace)
 	if err != nil {
 		l.Error("error handling command", "error", err)
-		w.WriteHeader(http.StatusInternalServerError)
+		status.WriteError(w, err)
 		return
 	}
 
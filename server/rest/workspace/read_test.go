@@ -3,16 +3,21 @@ package workspace_test
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
+	"net/http/httptest"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/konflux-workspaces/workspaces/server/rest/workspace/mocks"
 
 	coreworkspace "github.com/konflux-workspaces/workspaces/server/core/workspace"
+	"github.com/konflux-workspaces/workspaces/server/log"
 	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
 	"github.com/konflux-workspaces/workspaces/server/rest/workspace"
 
@@ -51,15 +56,15 @@ var _ = Describe("Read", func() {
 			handler.ServeHTTP(response, request)
 		},
 		Entry("failure in marshal provider", workspace.MapReadWorkspaceHttp, nopReadHandler, errorMarshalProvider, func() http.ResponseWriter {
-			fake.EXPECT().WriteHeader(http.StatusBadRequest)
+			expectStatus(fake, http.StatusBadRequest)
 			return fake
 		}),
 		Entry("failure in read handler", workspace.MapReadWorkspaceHttp, badReadHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
-			fake.EXPECT().WriteHeader(http.StatusInternalServerError)
+			expectStatus(fake, http.StatusInternalServerError)
 			return fake
 		}),
 		Entry("failure marshaling response", workspace.MapReadWorkspaceHttp, nopReadHandler, badMarshalProvider, func() http.ResponseWriter {
-			fake.EXPECT().WriteHeader(http.StatusInternalServerError)
+			expectStatus(fake, http.StatusInternalServerError)
 			return fake
 		}),
 		Entry("failure to write response", workspace.MapReadWorkspaceHttp, nopReadHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
@@ -77,6 +82,67 @@ var _ = Describe("Read", func() {
 			})
 			return fake
 		}),
+		Entry("workspace with ETag", workspace.MapReadWorkspaceHttp, versionedReadHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
+			h := http.Header{}
+			fake.EXPECT().Header().Return(h).Times(2)
+			fake.EXPECT().Write(gomock.Any()).DoAndReturn(func(a any) (int, error) {
+				d, ok := a.([]byte)
+				Expect(ok).To(BeTrue())
+				Expect(h.Get("ETag")).To(Equal(`"42"`))
+				return len(d), nil
+			})
+			return fake
+		}),
+		Entry("workspace not modified", workspace.MapReadWorkspaceHttp, versionedReadHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
+			request.Header.Set("If-None-Match", `"41", W/"42"`)
+			h := http.Header{}
+			fake.EXPECT().Header().Return(h)
+			fake.EXPECT().WriteHeader(http.StatusNotModified)
+			return fake
+		}),
+		Entry("workspace modified", workspace.MapReadWorkspaceHttp, versionedReadHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
+			request.Header.Set("If-None-Match", `"41"`)
+			h := http.Header{}
+			fake.EXPECT().Header().Return(h).Times(2)
+			fake.EXPECT().Write(gomock.Any()).DoAndReturn(func(a any) (int, error) {
+				d, ok := a.([]byte)
+				Expect(ok).To(BeTrue())
+				return len(d), nil
+			})
+			return fake
+		}),
+	)
+
+	DescribeTable("logs the read handler's errors at a level matching the replied status",
+		func(err error, expectedLevel string) {
+			// given
+			b := bytes.Buffer{}
+			l := slog.New(slog.NewJSONHandler(&b, &slog.HandlerOptions{Level: slog.LevelDebug}))
+			handler := workspace.NewReadWorkspaceHandler(
+				workspace.MapReadWorkspaceHttp,
+				func(context.Context, coreworkspace.ReadWorkspaceQuery) (*coreworkspace.ReadWorkspaceResponse, error) {
+					return nil, err
+				},
+				marshal.DefaultMarshalerProvider,
+			)
+
+			// when
+			handler.ServeHTTP(httptest.NewRecorder(), request.WithContext(log.IntoContext(request.Context(), l)))
+
+			// then
+			levels := []string{}
+			for _, line := range bytes.Split(bytes.TrimSpace(b.Bytes()), []byte("\n")) {
+				r := struct{ Level, Msg string }{}
+				Expect(json.Unmarshal(line, &r)).To(Succeed())
+				if r.Msg == "error executing read query" {
+					levels = append(levels, r.Level)
+				}
+			}
+			Expect(levels).To(Equal([]string{expectedLevel}))
+		},
+		Entry("not found", kerrors.NewNotFound(restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource(), "foo"), "DEBUG"),
+		Entry("forbidden", kerrors.NewForbidden(restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource(), "foo", fmt.Errorf("forbidden")), "DEBUG"),
+		Entry("internal error", fmt.Errorf("unexpected error"), "ERROR"),
 	)
 })
 
@@ -90,6 +156,16 @@ func nopReadHandler(_ctx context.Context, cmd coreworkspace.ReadWorkspaceQuery)
 	}, nil
 }
 
+func versionedReadHandler(_ctx context.Context, cmd coreworkspace.ReadWorkspaceQuery) (*coreworkspace.ReadWorkspaceResponse, error) {
+	w := restworkspacesv1alpha1.Workspace{}
+	w.SetName(cmd.Name)
+	w.SetNamespace(cmd.Owner)
+	w.SetResourceVersion("42")
+	return &coreworkspace.ReadWorkspaceResponse{
+		Workspace: &w,
+	}, nil
+}
+
 func buildGetRequest(workspace *restworkspacesv1alpha1.Workspace) *http.Request {
 	byteSlice, err := marshal.DefaultMarshal.Marshal(workspace)
 	Expect(err).NotTo(HaveOccurred())
@@ -0,0 +1,149 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/konflux-workspaces/workspaces/server/core/workspace"
+	"github.com/konflux-workspaces/workspaces/server/log"
+	"github.com/konflux-workspaces/workspaces/server/rest/header"
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+	"github.com/konflux-workspaces/workspaces/server/rest/status"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var (
+	_ http.Handler = &PostWorkspaceRenameHandler{}
+
+	_ PostWorkspaceRenameMapperFunc = MapPostWorkspaceRenameHttp
+)
+
+// handler dependencies
+type PostWorkspaceRenameMapperFunc func(*http.Request, marshal.UnmarshalerProvider) (*workspace.RenameWorkspaceCommand, error)
+type RenameWorkspaceCommandHandlerFunc func(context.Context, workspace.RenameWorkspaceCommand) (*workspace.RenameWorkspaceResponse, error)
+
+// PostWorkspaceRenameHandler the http.Request handler for Rename Workspace endpoint
+type PostWorkspaceRenameHandler struct {
+	MapperFunc     PostWorkspaceRenameMapperFunc
+	CommandHandler RenameWorkspaceCommandHandlerFunc
+
+	MarshalerProvider   marshal.MarshalerProvider
+	UnmarshalerProvider marshal.UnmarshalerProvider
+}
+
+// NewDefaultPostWorkspaceRenameHandler creates a PostWorkspaceRenameHandler
+func NewDefaultPostWorkspaceRenameHandler(
+	handler RenameWorkspaceCommandHandlerFunc,
+) *PostWorkspaceRenameHandler {
+	return NewPostWorkspaceRenameHandler(
+		MapPostWorkspaceRenameHttp,
+		handler,
+		marshal.DefaultMarshalerProvider,
+		marshal.DefaultUnmarshalerProvider,
+	)
+}
+
+// NewPostWorkspaceRenameHandler creates a PostWorkspaceRenameHandler
+func NewPostWorkspaceRenameHandler(
+	mapperFunc PostWorkspaceRenameMapperFunc,
+	commandHandler RenameWorkspaceCommandHandlerFunc,
+	marshalerProvider marshal.MarshalerProvider,
+	unmarshalerProvider marshal.UnmarshalerProvider,
+) *PostWorkspaceRenameHandler {
+	return &PostWorkspaceRenameHandler{
+		MapperFunc:          mapperFunc,
+		CommandHandler:      commandHandler,
+		MarshalerProvider:   marshalerProvider,
+		UnmarshalerProvider: unmarshalerProvider,
+	}
+}
+
+func (h *PostWorkspaceRenameHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l := log.FromContext(r.Context())
+	l.Debug("executing rename")
+
+	// build marshaler for the given request
+	l.Debug("building marshaler for request")
+	m, err := h.MarshalerProvider(r)
+	if err != nil {
+		l.Debug("error building marshaler for request", "error", err)
+		status.WriteBadRequest(w, err)
+		return
+	}
+
+	// map
+	l.Debug("mapping request to rename command")
+	c, err := h.MapperFunc(r, h.UnmarshalerProvider)
+	if err != nil {
+		l.Debug("error mapping request to command", "error", err)
+		status.WriteBadRequest(w, err)
+		return
+	}
+
+	// execute
+	l.Debug("executing rename command", "command", c)
+	cr, err := h.CommandHandler(r.Context(), *c)
+	if err != nil {
+		logHandlerError(l, "error executing rename command", err)
+		status.WriteError(w, err)
+		return
+	}
+
+	// marshal response
+	l.Debug("marshaling response", "response", &cr)
+	d, err := m.Marshal(cr.Workspace)
+	if err != nil {
+		l.Error("unexpected error marshaling response", "error", err)
+		status.WriteError(w, err)
+		return
+	}
+
+	// reply
+	l.Debug("writing response", "response", d)
+	w.Header().Add(header.ContentType, m.ContentType())
+	setETag(w, cr.Workspace)
+	if _, err := w.Write(d); err != nil {
+		l.Error("unexpected error writing response", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+}
+
+// MapPostWorkspaceRenameHttp maps the request to a RenameWorkspaceCommand.
+// The body is a WorkspaceRename containing the new name of the workspace.
+func MapPostWorkspaceRenameHttp(r *http.Request, provider marshal.UnmarshalerProvider) (*workspace.RenameWorkspaceCommand, error) {
+	// build unmarshaler for the given request
+	u, err := provider(r)
+	if err != nil {
+		return nil, fmt.Errorf("error building unmarshaler body: %w", err)
+	}
+
+	// parse request body
+	d, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %w", err)
+	}
+
+	// unmarshal body to WorkspaceRename
+	wr := restworkspacesv1alpha1.WorkspaceRename{}
+	if err := u.Unmarshal(d, &wr); err != nil {
+		return nil, fmt.Errorf("error unmarshaling request body: %w", err)
+	}
+	if wr.Name == "" {
+		return nil, fmt.Errorf("the new name of the workspace is required")
+	}
+
+	// retrieve name and namespace from path
+	n := r.PathValue("name")
+	ns := r.PathValue("namespace")
+
+	// build command
+	return &workspace.RenameWorkspaceCommand{
+		Owner:     ns,
+		Workspace: n,
+		NewName:   wr.Name,
+	}, nil
+}
@@ -0,0 +1,150 @@
+package workspace_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/konflux-workspaces/workspaces/server/rest/workspace/mocks"
+
+	coreworkspace "github.com/konflux-workspaces/workspaces/server/core/workspace"
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+	"github.com/konflux-workspaces/workspaces/server/rest/workspace"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("Rename tests", func() {
+	var (
+		ctrl    *gomock.Controller
+		fake    *mocks.MockFakeResponseWriter
+		request *http.Request
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		fake = mocks.NewMockFakeResponseWriter(ctrl)
+		request = buildRenameRequest("bar", "foo", restworkspacesv1alpha1.WorkspaceRename{Name: "baz"})
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	DescribeTable("workspace rename POST handler",
+		func(
+			mapperFunc workspace.PostWorkspaceRenameMapperFunc,
+			renameHandler workspace.RenameWorkspaceCommandHandlerFunc,
+			marshaler marshal.MarshalerProvider,
+			unmarshaler marshal.UnmarshalerProvider,
+			responseFunc func() http.ResponseWriter,
+		) {
+			response := responseFunc()
+			handler := workspace.NewPostWorkspaceRenameHandler(mapperFunc, renameHandler, marshaler, unmarshaler)
+			handler.ServeHTTP(response, request)
+		},
+		Entry("failure in marshal provider", workspace.MapPostWorkspaceRenameHttp, nopRenameHandler, errorMarshalProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			expectStatus(fake, http.StatusBadRequest)
+			return fake
+		}),
+		Entry("failure in unmarshal provider", workspace.MapPostWorkspaceRenameHttp, nopRenameHandler, marshal.DefaultMarshalerProvider, errorUnmarshalProvider, func() http.ResponseWriter {
+			expectStatus(fake, http.StatusBadRequest)
+			return fake
+		}),
+		Entry("no body sent in request", workspace.MapPostWorkspaceRenameHttp, nopRenameHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			request.Body = io.NopCloser(bytes.NewReader([]byte{}))
+			expectStatus(fake, http.StatusBadRequest)
+			return fake
+		}),
+		Entry("no new name in request", workspace.MapPostWorkspaceRenameHttp, nopRenameHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			request = buildRenameRequest("bar", "foo", restworkspacesv1alpha1.WorkspaceRename{})
+			expectStatus(fake, http.StatusBadRequest)
+			return fake
+		}),
+		Entry("failure in rename handler", workspace.MapPostWorkspaceRenameHttp, badRenameHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			expectStatus(fake, http.StatusInternalServerError)
+			return fake
+		}),
+		Entry("rename forbidden", workspace.MapPostWorkspaceRenameHttp, forbiddenRenameHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			expectStatus(fake, http.StatusForbidden)
+			return fake
+		}),
+		Entry("name already in use", workspace.MapPostWorkspaceRenameHttp, alreadyExistsRenameHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			expectStatus(fake, http.StatusConflict)
+			return fake
+		}),
+		Entry("failure marshaling response", workspace.MapPostWorkspaceRenameHttp, nopRenameHandler, badMarshalProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			expectStatus(fake, http.StatusInternalServerError)
+			return fake
+		}),
+		Entry("failure to write response", workspace.MapPostWorkspaceRenameHttp, nopRenameHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			fake.EXPECT().Header().Return(http.Header{})
+			fake.EXPECT().Write(gomock.Any()).Return(0, fmt.Errorf("failed to write response body"))
+			fake.EXPECT().WriteHeader(http.StatusInternalServerError)
+			return fake
+		}),
+		Entry("workspace renamed", workspace.MapPostWorkspaceRenameHttp, nopRenameHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			fake.EXPECT().Header().Return(http.Header{})
+			fake.EXPECT().Write(gomock.Any()).DoAndReturn(func(a any) (int, error) {
+				slice, ok := a.([]byte)
+				Expect(ok).To(BeTrue())
+				return len(slice), nil
+			})
+			return fake
+		}),
+	)
+
+	It("should map the new name from the body", func() {
+		// when
+		c, err := workspace.MapPostWorkspaceRenameHttp(request, marshal.DefaultUnmarshalerProvider)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*c).To(Equal(coreworkspace.RenameWorkspaceCommand{
+			Owner:     "bar",
+			Workspace: "foo",
+			NewName:   "baz",
+		}))
+	})
+})
+
+func badRenameHandler(ctx context.Context, cmd coreworkspace.RenameWorkspaceCommand) (*coreworkspace.RenameWorkspaceResponse, error) {
+	return nil, fmt.Errorf("bad rename handler")
+}
+
+func forbiddenRenameHandler(ctx context.Context, cmd coreworkspace.RenameWorkspaceCommand) (*coreworkspace.RenameWorkspaceResponse, error) {
+	return nil, kerrors.NewForbidden(restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource(), cmd.Workspace, fmt.Errorf("the home workspace can not be renamed"))
+}
+
+func alreadyExistsRenameHandler(ctx context.Context, cmd coreworkspace.RenameWorkspaceCommand) (*coreworkspace.RenameWorkspaceResponse, error) {
+	return nil, kerrors.NewAlreadyExists(restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource(), cmd.NewName)
+}
+
+func nopRenameHandler(_ context.Context, cmd coreworkspace.RenameWorkspaceCommand) (*coreworkspace.RenameWorkspaceResponse, error) {
+	w := restworkspacesv1alpha1.Workspace{}
+	w.SetName(cmd.NewName)
+	w.SetNamespace(cmd.Owner)
+	return &coreworkspace.RenameWorkspaceResponse{
+		Workspace: &w,
+	}, nil
+}
+
+func buildRenameRequest(namespace, name string, wr restworkspacesv1alpha1.WorkspaceRename) *http.Request {
+	byteSlice, err := marshal.DefaultMarshal.Marshal(wr)
+	Expect(err).NotTo(HaveOccurred())
+
+	url := fmt.Sprintf("/apis/workspaces.io/v1alpha1/namespaces/%s/workspaces/%s/rename", namespace, name)
+
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(byteSlice))
+	Expect(err).NotTo(HaveOccurred())
+	request.Header.Add("Content-Type", marshal.DefaultUnmarshal.ContentType())
+	request.Header.Add("Accept", marshal.DefaultMarshal.ContentType())
+	request.SetPathValue("namespace", namespace)
+	request.SetPathValue("name", name)
+	return request
+}
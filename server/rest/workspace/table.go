@@ -0,0 +1,113 @@
+package workspace
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ marshal.TableConvertor = ConvertWorkspacesToTable
+
+// TableMarshalerProvider renders Workspaces as a metav1.Table when requested by the client
+var TableMarshalerProvider = marshal.NewTableMarshalerProvider(ConvertWorkspacesToTable)
+
+var workspaceTableColumns = []metav1.TableColumnDefinition{
+	{Name: "Name", Type: "string", Format: "name", Description: "Name of the workspace"},
+	{Name: "Owner", Type: "string", Description: "Username of the workspace's owner"},
+	{Name: "Visibility", Type: "string", Description: "Visibility of the workspace, community or private"},
+	{Name: "Ready", Type: "string", Description: "Status of the workspace's Ready condition"},
+	{Name: "Target Cluster", Type: "string", Description: "URL of the cluster where the workspace's namespaces live"},
+	{Name: "Age", Type: "string", Description: "Time elapsed since the workspace's creation"},
+	{Name: "Reason", Type: "string", Priority: 1, Description: "Reason of the workspace's Ready condition"},
+	{Name: "Owner Active", Type: "string", Priority: 1, Description: "Status of the workspace's OwnerActive condition"},
+	{Name: "Is Owner", Type: "string", Priority: 1, Description: "Whether the requesting user owns the workspace"},
+	{Name: "Direct Access", Type: "string", Priority: 1, Description: "Whether the workspace is directly shared with the requesting user"},
+}
+
+// ConvertWorkspacesToTable converts a Workspace or a WorkspaceList into a metav1.Table
+func ConvertWorkspacesToTable(v any) (*metav1.Table, error) {
+	t := metav1.Table{ColumnDefinitions: workspaceTableColumns}
+	switch o := v.(type) {
+	case *restworkspacesv1alpha1.Workspace:
+		if o == nil {
+			return nil, fmt.Errorf("can not convert nil Workspace to Table")
+		}
+		t.Rows = []metav1.TableRow{workspaceToTableRow(o)}
+	case restworkspacesv1alpha1.Workspace:
+		t.Rows = []metav1.TableRow{workspaceToTableRow(&o)}
+	case *restworkspacesv1alpha1.WorkspaceList:
+		if o == nil {
+			return nil, fmt.Errorf("can not convert nil WorkspaceList to Table")
+		}
+		t.ListMeta = o.ListMeta
+		t.Rows = workspacesToTableRows(o.Items)
+	case restworkspacesv1alpha1.WorkspaceList:
+		t.ListMeta = o.ListMeta
+		t.Rows = workspacesToTableRows(o.Items)
+	default:
+		return nil, fmt.Errorf("can not convert %T to Table", v)
+	}
+	return &t, nil
+}
+
+func workspacesToTableRows(ww []restworkspacesv1alpha1.Workspace) []metav1.TableRow {
+	rr := make([]metav1.TableRow, 0, len(ww))
+	for i := range ww {
+		rr = append(rr, workspaceToTableRow(&ww[i]))
+	}
+	return rr
+}
+
+func workspaceToTableRow(w *restworkspacesv1alpha1.Workspace) metav1.TableRow {
+	ready, reason := "Unknown", ""
+	if c := meta.FindStatusCondition(w.Status.Conditions, workspacesv1alpha1.ConditionTypeReady); c != nil {
+		ready, reason = string(c.Status), c.Reason
+	}
+	ownerActive := "Unknown"
+	if c := meta.FindStatusCondition(w.Status.Conditions, workspacesv1alpha1.ConditionTypeOwnerActive); c != nil {
+		ownerActive = string(c.Status)
+	}
+	targetCluster := ""
+	if w.Status.Space != nil {
+		targetCluster = w.Status.Space.TargetCluster
+	}
+
+	return metav1.TableRow{
+		Cells: []any{
+			w.Name,
+			w.Namespace,
+			string(w.Spec.Visibility),
+			ready,
+			targetCluster,
+			age(w.CreationTimestamp),
+			reason,
+			ownerActive,
+			labelOrFalse(w, restworkspacesv1alpha1.LabelIsOwner),
+			labelOrFalse(w, restworkspacesv1alpha1.LabelHasDirectAccess),
+		},
+		Object: runtime.RawExtension{Object: w},
+	}
+}
+
+func age(t metav1.Time) string {
+	if t.IsZero() {
+		return "<unknown>"
+	}
+	return duration.HumanDuration(time.Since(t.Time))
+}
+
+func labelOrFalse(w *restworkspacesv1alpha1.Workspace, label string) string {
+	if v, ok := w.Labels[label]; ok {
+		return v
+	}
+	return "false"
+}
@@ -0,0 +1,132 @@
+package workspace_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+	"github.com/konflux-workspaces/workspaces/server/rest/workspace"
+
+	workspacesv1alpha1 "github.com/konflux-workspaces/workspaces/operator/api/v1alpha1"
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("Table", func() {
+	var w restworkspacesv1alpha1.Workspace
+
+	BeforeEach(func() {
+		w = restworkspacesv1alpha1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "foo",
+				Namespace:         "bar",
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-72 * time.Hour)),
+				Labels: map[string]string{
+					restworkspacesv1alpha1.LabelIsOwner:         "true",
+					restworkspacesv1alpha1.LabelHasDirectAccess: "true",
+				},
+			},
+			Spec: restworkspacesv1alpha1.WorkspaceSpec{
+				Visibility: restworkspacesv1alpha1.WorkspaceVisibilityPrivate,
+			},
+			Status: restworkspacesv1alpha1.WorkspaceStatus{
+				Space: &restworkspacesv1alpha1.SpaceInfo{
+					Name:          "foo-abcde",
+					TargetCluster: "https://api.member.example.com:6443",
+				},
+				Conditions: []metav1.Condition{
+					{
+						Type:   workspacesv1alpha1.ConditionTypeReady,
+						Status: metav1.ConditionTrue,
+						Reason: workspacesv1alpha1.ConditionReasonEverythingFine,
+					},
+					{
+						Type:   workspacesv1alpha1.ConditionTypeOwnerActive,
+						Status: metav1.ConditionTrue,
+						Reason: workspacesv1alpha1.ConditionReasonOwnerActive,
+					},
+				},
+			},
+		}
+	})
+
+	It("should convert a workspace into a table row", func() {
+		// when
+		t, err := workspace.ConvertWorkspacesToTable(&w)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(t.ColumnDefinitions).To(HaveLen(10))
+		Expect(t.Rows).To(HaveLen(1))
+		Expect(t.Rows[0].Cells).To(Equal([]any{
+			"foo", "bar", "private", "True", "https://api.member.example.com:6443", "3d",
+			"EverythingFine", "True", "true", "true",
+		}))
+		Expect(t.Rows[0].Object.Object).To(Equal(&w))
+	})
+
+	It("should render missing conditions and labels", func() {
+		// given
+		w.Labels = nil
+		w.Status = restworkspacesv1alpha1.WorkspaceStatus{}
+
+		// when
+		t, err := workspace.ConvertWorkspacesToTable(w)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(t.Rows[0].Cells).To(Equal([]any{
+			"foo", "bar", "private", "Unknown", "", "3d",
+			"", "Unknown", "false", "false",
+		}))
+	})
+
+	It("should convert a workspace list keeping its metadata", func() {
+		// given
+		l := restworkspacesv1alpha1.WorkspaceList{
+			ListMeta: metav1.ListMeta{Continue: "next"},
+			Items:    []restworkspacesv1alpha1.Workspace{w, w},
+		}
+
+		// when
+		t, err := workspace.ConvertWorkspacesToTable(l)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(t.Continue).To(Equal("next"))
+		Expect(t.Rows).To(HaveLen(2))
+	})
+
+	It("should not convert other types", func() {
+		// when
+		_, err := workspace.ConvertWorkspacesToTable(&metav1.Status{})
+
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should render the list as table when requested by the client", func() {
+		// given
+		request := buildListRequest(&w)
+		request.Header.Set("Accept", "application/json;as=Table;v=v1;g=meta.k8s.io,application/json")
+		response := httptest.NewRecorder()
+		handler := workspace.NewDefaultListWorkspaceHandler(nopListHandler)
+
+		// when
+		handler.ServeHTTP(response, request)
+
+		// then
+		Expect(response.Code).To(Equal(http.StatusOK))
+		Expect(response.Header().Get("Content-Type")).To(Equal(marshal.ContentTypeTable))
+		t := metav1.Table{}
+		Expect(json.Unmarshal(response.Body.Bytes(), &t)).To(Succeed())
+		Expect(t.Kind).To(Equal("Table"))
+		Expect(t.ColumnDefinitions).NotTo(BeEmpty())
+	})
+})
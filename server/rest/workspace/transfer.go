@@ -0,0 +1,322 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/konflux-workspaces/workspaces/server/core/workspace"
+	"github.com/konflux-workspaces/workspaces/server/log"
+	"github.com/konflux-workspaces/workspaces/server/rest/header"
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+	"github.com/konflux-workspaces/workspaces/server/rest/status"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var (
+	_ http.Handler = &PutWorkspaceTransferHandler{}
+	_ http.Handler = &DeleteWorkspaceTransferHandler{}
+	_ http.Handler = &PostWorkspaceTransferAcceptHandler{}
+
+	_ PutWorkspaceTransferMapperFunc        = MapPutWorkspaceTransferHttp
+	_ DeleteWorkspaceTransferMapperFunc     = MapDeleteWorkspaceTransferHttp
+	_ PostWorkspaceTransferAcceptMapperFunc = MapPostWorkspaceTransferAcceptHttp
+)
+
+// handler dependencies
+type PutWorkspaceTransferMapperFunc func(*http.Request, marshal.UnmarshalerProvider) (*workspace.ProposeWorkspaceOwnershipTransferCommand, error)
+type ProposeWorkspaceOwnershipTransferCommandHandlerFunc func(context.Context, workspace.ProposeWorkspaceOwnershipTransferCommand) (*workspace.ProposeWorkspaceOwnershipTransferResponse, error)
+
+type DeleteWorkspaceTransferMapperFunc func(*http.Request) (*workspace.CancelWorkspaceOwnershipTransferCommand, error)
+type CancelWorkspaceOwnershipTransferCommandHandlerFunc func(context.Context, workspace.CancelWorkspaceOwnershipTransferCommand) (*workspace.CancelWorkspaceOwnershipTransferResponse, error)
+
+type PostWorkspaceTransferAcceptMapperFunc func(*http.Request) (*workspace.AcceptWorkspaceOwnershipTransferCommand, error)
+type AcceptWorkspaceOwnershipTransferCommandHandlerFunc func(context.Context, workspace.AcceptWorkspaceOwnershipTransferCommand) (*workspace.AcceptWorkspaceOwnershipTransferResponse, error)
+
+// PutWorkspaceTransferHandler the http.Request handler for Put Workspace Transfer endpoint
+type PutWorkspaceTransferHandler struct {
+	MapperFunc     PutWorkspaceTransferMapperFunc
+	CommandHandler ProposeWorkspaceOwnershipTransferCommandHandlerFunc
+
+	MarshalerProvider   marshal.MarshalerProvider
+	UnmarshalerProvider marshal.UnmarshalerProvider
+}
+
+// NewDefaultPutWorkspaceTransferHandler creates a PutWorkspaceTransferHandler
+func NewDefaultPutWorkspaceTransferHandler(
+	handler ProposeWorkspaceOwnershipTransferCommandHandlerFunc,
+) *PutWorkspaceTransferHandler {
+	return NewPutWorkspaceTransferHandler(
+		MapPutWorkspaceTransferHttp,
+		handler,
+		marshal.DefaultMarshalerProvider,
+		marshal.DefaultUnmarshalerProvider,
+	)
+}
+
+// NewPutWorkspaceTransferHandler creates a PutWorkspaceTransferHandler
+func NewPutWorkspaceTransferHandler(
+	mapperFunc PutWorkspaceTransferMapperFunc,
+	commandHandler ProposeWorkspaceOwnershipTransferCommandHandlerFunc,
+	marshalerProvider marshal.MarshalerProvider,
+	unmarshalerProvider marshal.UnmarshalerProvider,
+) *PutWorkspaceTransferHandler {
+	return &PutWorkspaceTransferHandler{
+		MapperFunc:          mapperFunc,
+		CommandHandler:      commandHandler,
+		MarshalerProvider:   marshalerProvider,
+		UnmarshalerProvider: unmarshalerProvider,
+	}
+}
+
+func (h *PutWorkspaceTransferHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l := log.FromContext(r.Context())
+	l.Debug("executing propose ownership transfer")
+
+	// build marshaler for the given request
+	l.Debug("building marshaler for request")
+	m, err := h.MarshalerProvider(r)
+	if err != nil {
+		l.Debug("error building marshaler for request", "error", err)
+		status.WriteBadRequest(w, err)
+		return
+	}
+
+	// map
+	l.Debug("mapping request to propose ownership transfer command")
+	c, err := h.MapperFunc(r, h.UnmarshalerProvider)
+	if err != nil {
+		l.Debug("error mapping request to command", "error", err)
+		status.WriteBadRequest(w, err)
+		return
+	}
+
+	// execute
+	l.Debug("executing propose ownership transfer command", "command", c)
+	cr, err := h.CommandHandler(r.Context(), *c)
+	if err != nil {
+		logHandlerError(l, "error executing propose ownership transfer command", err)
+		status.WriteError(w, err)
+		return
+	}
+
+	replyTransferredWorkspace(w, r, m, cr.Workspace)
+}
+
+// DeleteWorkspaceTransferHandler the http.Request handler for Delete Workspace Transfer endpoint
+type DeleteWorkspaceTransferHandler struct {
+	MapperFunc     DeleteWorkspaceTransferMapperFunc
+	CommandHandler CancelWorkspaceOwnershipTransferCommandHandlerFunc
+
+	MarshalerProvider marshal.MarshalerProvider
+}
+
+// NewDefaultDeleteWorkspaceTransferHandler creates a DeleteWorkspaceTransferHandler
+func NewDefaultDeleteWorkspaceTransferHandler(
+	handler CancelWorkspaceOwnershipTransferCommandHandlerFunc,
+) *DeleteWorkspaceTransferHandler {
+	return NewDeleteWorkspaceTransferHandler(
+		MapDeleteWorkspaceTransferHttp,
+		handler,
+		marshal.DefaultMarshalerProvider,
+	)
+}
+
+// NewDeleteWorkspaceTransferHandler creates a DeleteWorkspaceTransferHandler
+func NewDeleteWorkspaceTransferHandler(
+	mapperFunc DeleteWorkspaceTransferMapperFunc,
+	commandHandler CancelWorkspaceOwnershipTransferCommandHandlerFunc,
+	marshalerProvider marshal.MarshalerProvider,
+) *DeleteWorkspaceTransferHandler {
+	return &DeleteWorkspaceTransferHandler{
+		MapperFunc:        mapperFunc,
+		CommandHandler:    commandHandler,
+		MarshalerProvider: marshalerProvider,
+	}
+}
+
+func (h *DeleteWorkspaceTransferHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l := log.FromContext(r.Context())
+	l.Debug("executing cancel ownership transfer")
+
+	// build marshaler for the given request
+	l.Debug("building marshaler for request")
+	m, err := h.MarshalerProvider(r)
+	if err != nil {
+		l.Debug("error building marshaler for request", "error", err)
+		status.WriteBadRequest(w, err)
+		return
+	}
+
+	// map
+	l.Debug("mapping request to cancel ownership transfer command")
+	c, err := h.MapperFunc(r)
+	if err != nil {
+		l.Debug("error mapping request to command", "error", err)
+		status.WriteBadRequest(w, err)
+		return
+	}
+
+	// execute
+	l.Debug("executing cancel ownership transfer command", "command", c)
+	cr, err := h.CommandHandler(r.Context(), *c)
+	if err != nil {
+		logHandlerError(l, "error executing cancel ownership transfer command", err)
+		status.WriteError(w, err)
+		return
+	}
+
+	replyTransferredWorkspace(w, r, m, cr.Workspace)
+}
+
+// PostWorkspaceTransferAcceptHandler the http.Request handler for Post Workspace Transfer Accept endpoint
+type PostWorkspaceTransferAcceptHandler struct {
+	MapperFunc     PostWorkspaceTransferAcceptMapperFunc
+	CommandHandler AcceptWorkspaceOwnershipTransferCommandHandlerFunc
+
+	MarshalerProvider marshal.MarshalerProvider
+}
+
+// NewDefaultPostWorkspaceTransferAcceptHandler creates a PostWorkspaceTransferAcceptHandler
+func NewDefaultPostWorkspaceTransferAcceptHandler(
+	handler AcceptWorkspaceOwnershipTransferCommandHandlerFunc,
+) *PostWorkspaceTransferAcceptHandler {
+	return NewPostWorkspaceTransferAcceptHandler(
+		MapPostWorkspaceTransferAcceptHttp,
+		handler,
+		marshal.DefaultMarshalerProvider,
+	)
+}
+
+// NewPostWorkspaceTransferAcceptHandler creates a PostWorkspaceTransferAcceptHandler
+func NewPostWorkspaceTransferAcceptHandler(
+	mapperFunc PostWorkspaceTransferAcceptMapperFunc,
+	commandHandler AcceptWorkspaceOwnershipTransferCommandHandlerFunc,
+	marshalerProvider marshal.MarshalerProvider,
+) *PostWorkspaceTransferAcceptHandler {
+	return &PostWorkspaceTransferAcceptHandler{
+		MapperFunc:        mapperFunc,
+		CommandHandler:    commandHandler,
+		MarshalerProvider: marshalerProvider,
+	}
+}
+
+func (h *PostWorkspaceTransferAcceptHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l := log.FromContext(r.Context())
+	l.Debug("executing accept ownership transfer")
+
+	// build marshaler for the given request
+	l.Debug("building marshaler for request")
+	m, err := h.MarshalerProvider(r)
+	if err != nil {
+		l.Debug("error building marshaler for request", "error", err)
+		status.WriteBadRequest(w, err)
+		return
+	}
+
+	// map
+	l.Debug("mapping request to accept ownership transfer command")
+	c, err := h.MapperFunc(r)
+	if err != nil {
+		l.Debug("error mapping request to command", "error", err)
+		status.WriteBadRequest(w, err)
+		return
+	}
+
+	// execute
+	l.Debug("executing accept ownership transfer command", "command", c)
+	cr, err := h.CommandHandler(r.Context(), *c)
+	if err != nil {
+		logHandlerError(l, "error executing accept ownership transfer command", err)
+		status.WriteError(w, err)
+		return
+	}
+
+	replyTransferredWorkspace(w, r, m, cr.Workspace)
+}
+
+// replyTransferredWorkspace marshals the workspace and writes it in the response
+func replyTransferredWorkspace(w http.ResponseWriter, r *http.Request, m marshal.Marshaler, ws *restworkspacesv1alpha1.Workspace) {
+	l := log.FromContext(r.Context())
+
+	// marshal response
+	l.Debug("marshaling response", "response", ws)
+	d, err := m.Marshal(ws)
+	if err != nil {
+		l.Error("unexpected error marshaling response", "error", err)
+		status.WriteError(w, err)
+		return
+	}
+
+	// reply
+	l.Debug("writing response", "response", d)
+	w.Header().Add(header.ContentType, m.ContentType())
+	setETag(w, ws)
+	if _, err := w.Write(d); err != nil {
+		l.Error("unexpected error writing response", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+}
+
+// MapPutWorkspaceTransferHttp maps the request to a ProposeWorkspaceOwnershipTransferCommand.
+// The body is a WorkspaceOwnershipTransfer containing the username of the proposed owner.
+func MapPutWorkspaceTransferHttp(r *http.Request, provider marshal.UnmarshalerProvider) (*workspace.ProposeWorkspaceOwnershipTransferCommand, error) {
+	// build unmarshaler for the given request
+	u, err := provider(r)
+	if err != nil {
+		return nil, fmt.Errorf("error building unmarshaler body: %w", err)
+	}
+
+	// parse request body
+	d, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %w", err)
+	}
+
+	// unmarshal body to WorkspaceOwnershipTransfer
+	wt := restworkspacesv1alpha1.WorkspaceOwnershipTransfer{}
+	if err := u.Unmarshal(d, &wt); err != nil {
+		return nil, fmt.Errorf("error unmarshaling request body: %w", err)
+	}
+	if wt.NewOwner == "" {
+		return nil, fmt.Errorf("the new owner of the workspace is required")
+	}
+
+	// retrieve name and namespace from path
+	n := r.PathValue("name")
+	ns := r.PathValue("namespace")
+
+	// build command
+	return &workspace.ProposeWorkspaceOwnershipTransferCommand{
+		Owner:     ns,
+		Workspace: n,
+		NewOwner:  wt.NewOwner,
+	}, nil
+}
+
+func MapDeleteWorkspaceTransferHttp(r *http.Request) (*workspace.CancelWorkspaceOwnershipTransferCommand, error) {
+	// retrieve name and namespace from path
+	n := r.PathValue("name")
+	ns := r.PathValue("namespace")
+
+	// build command
+	return &workspace.CancelWorkspaceOwnershipTransferCommand{
+		Owner:     ns,
+		Workspace: n,
+	}, nil
+}
+
+func MapPostWorkspaceTransferAcceptHttp(r *http.Request) (*workspace.AcceptWorkspaceOwnershipTransferCommand, error) {
+	// retrieve name and namespace from path
+	n := r.PathValue("name")
+	ns := r.PathValue("namespace")
+
+	// build command
+	return &workspace.AcceptWorkspaceOwnershipTransferCommand{
+		Owner:     ns,
+		Workspace: n,
+	}, nil
+}
@@ -0,0 +1,266 @@
+package workspace_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/konflux-workspaces/workspaces/server/rest/workspace/mocks"
+
+	coreworkspace "github.com/konflux-workspaces/workspaces/server/core/workspace"
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+	"github.com/konflux-workspaces/workspaces/server/rest/workspace"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("Transfer tests", func() {
+	var (
+		ctrl    *gomock.Controller
+		fake    *mocks.MockFakeResponseWriter
+		request *http.Request
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		fake = mocks.NewMockFakeResponseWriter(ctrl)
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	expectWorkspaceWritten := func() {
+		fake.EXPECT().Header().Return(http.Header{})
+		fake.EXPECT().Write(gomock.Any()).DoAndReturn(func(a any) (int, error) {
+			slice, ok := a.([]byte)
+			Expect(ok).To(BeTrue())
+			return len(slice), nil
+		})
+	}
+
+	Describe("Propose", func() {
+		BeforeEach(func() {
+			request = buildTransferRequest(http.MethodPut, "bar", "foo", "", &restworkspacesv1alpha1.WorkspaceOwnershipTransfer{NewOwner: "baz"})
+		})
+
+		DescribeTable("workspace transfer PUT handler",
+			func(
+				mapperFunc workspace.PutWorkspaceTransferMapperFunc,
+				proposeHandler workspace.ProposeWorkspaceOwnershipTransferCommandHandlerFunc,
+				marshaler marshal.MarshalerProvider,
+				unmarshaler marshal.UnmarshalerProvider,
+				responseFunc func() http.ResponseWriter,
+			) {
+				response := responseFunc()
+				handler := workspace.NewPutWorkspaceTransferHandler(mapperFunc, proposeHandler, marshaler, unmarshaler)
+				handler.ServeHTTP(response, request)
+			},
+			Entry("failure in marshal provider", workspace.MapPutWorkspaceTransferHttp, nopProposeTransferHandler, errorMarshalProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+				expectStatus(fake, http.StatusBadRequest)
+				return fake
+			}),
+			Entry("failure in unmarshal provider", workspace.MapPutWorkspaceTransferHttp, nopProposeTransferHandler, marshal.DefaultMarshalerProvider, errorUnmarshalProvider, func() http.ResponseWriter {
+				expectStatus(fake, http.StatusBadRequest)
+				return fake
+			}),
+			Entry("no body sent in request", workspace.MapPutWorkspaceTransferHttp, nopProposeTransferHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+				request.Body = io.NopCloser(bytes.NewReader([]byte{}))
+				expectStatus(fake, http.StatusBadRequest)
+				return fake
+			}),
+			Entry("no new owner in request", workspace.MapPutWorkspaceTransferHttp, nopProposeTransferHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+				request = buildTransferRequest(http.MethodPut, "bar", "foo", "", &restworkspacesv1alpha1.WorkspaceOwnershipTransfer{})
+				expectStatus(fake, http.StatusBadRequest)
+				return fake
+			}),
+			Entry("failure in propose handler", workspace.MapPutWorkspaceTransferHttp, badProposeTransferHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+				expectStatus(fake, http.StatusInternalServerError)
+				return fake
+			}),
+			Entry("transfer forbidden", workspace.MapPutWorkspaceTransferHttp, forbiddenProposeTransferHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+				expectStatus(fake, http.StatusForbidden)
+				return fake
+			}),
+			Entry("failure marshaling response", workspace.MapPutWorkspaceTransferHttp, nopProposeTransferHandler, badMarshalProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+				expectStatus(fake, http.StatusInternalServerError)
+				return fake
+			}),
+			Entry("failure to write response", workspace.MapPutWorkspaceTransferHttp, nopProposeTransferHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+				fake.EXPECT().Header().Return(http.Header{})
+				fake.EXPECT().Write(gomock.Any()).Return(0, fmt.Errorf("failed to write response body"))
+				fake.EXPECT().WriteHeader(http.StatusInternalServerError)
+				return fake
+			}),
+			Entry("transfer proposed", workspace.MapPutWorkspaceTransferHttp, nopProposeTransferHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+				expectWorkspaceWritten()
+				return fake
+			}),
+		)
+
+		It("should map the new owner from the body", func() {
+			// when
+			c, err := workspace.MapPutWorkspaceTransferHttp(request, marshal.DefaultUnmarshalerProvider)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*c).To(Equal(coreworkspace.ProposeWorkspaceOwnershipTransferCommand{
+				Owner:     "bar",
+				Workspace: "foo",
+				NewOwner:  "baz",
+			}))
+		})
+	})
+
+	Describe("Cancel", func() {
+		BeforeEach(func() {
+			request = buildTransferRequest(http.MethodDelete, "bar", "foo", "", nil)
+		})
+
+		DescribeTable("workspace transfer DELETE handler",
+			func(
+				mapperFunc workspace.DeleteWorkspaceTransferMapperFunc,
+				cancelHandler workspace.CancelWorkspaceOwnershipTransferCommandHandlerFunc,
+				marshaler marshal.MarshalerProvider,
+				responseFunc func() http.ResponseWriter,
+			) {
+				response := responseFunc()
+				handler := workspace.NewDeleteWorkspaceTransferHandler(mapperFunc, cancelHandler, marshaler)
+				handler.ServeHTTP(response, request)
+			},
+			Entry("failure in marshal provider", workspace.MapDeleteWorkspaceTransferHttp, nopCancelTransferHandler, errorMarshalProvider, func() http.ResponseWriter {
+				expectStatus(fake, http.StatusBadRequest)
+				return fake
+			}),
+			Entry("no pending transfer", workspace.MapDeleteWorkspaceTransferHttp, notFoundCancelTransferHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
+				expectStatus(fake, http.StatusNotFound)
+				return fake
+			}),
+			Entry("failure marshaling response", workspace.MapDeleteWorkspaceTransferHttp, nopCancelTransferHandler, badMarshalProvider, func() http.ResponseWriter {
+				expectStatus(fake, http.StatusInternalServerError)
+				return fake
+			}),
+			Entry("transfer cancelled", workspace.MapDeleteWorkspaceTransferHttp, nopCancelTransferHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
+				expectWorkspaceWritten()
+				return fake
+			}),
+		)
+	})
+
+	Describe("Accept", func() {
+		BeforeEach(func() {
+			request = buildTransferRequest(http.MethodPost, "bar", "foo", "/accept", nil)
+		})
+
+		DescribeTable("workspace transfer accept POST handler",
+			func(
+				mapperFunc workspace.PostWorkspaceTransferAcceptMapperFunc,
+				acceptHandler workspace.AcceptWorkspaceOwnershipTransferCommandHandlerFunc,
+				marshaler marshal.MarshalerProvider,
+				responseFunc func() http.ResponseWriter,
+			) {
+				response := responseFunc()
+				handler := workspace.NewPostWorkspaceTransferAcceptHandler(mapperFunc, acceptHandler, marshaler)
+				handler.ServeHTTP(response, request)
+			},
+			Entry("failure in marshal provider", workspace.MapPostWorkspaceTransferAcceptHttp, nopAcceptTransferHandler, errorMarshalProvider, func() http.ResponseWriter {
+				expectStatus(fake, http.StatusBadRequest)
+				return fake
+			}),
+			Entry("name already in use", workspace.MapPostWorkspaceTransferAcceptHttp, alreadyExistsAcceptTransferHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
+				expectStatus(fake, http.StatusConflict)
+				return fake
+			}),
+			Entry("failure marshaling response", workspace.MapPostWorkspaceTransferAcceptHttp, nopAcceptTransferHandler, badMarshalProvider, func() http.ResponseWriter {
+				expectStatus(fake, http.StatusInternalServerError)
+				return fake
+			}),
+			Entry("transfer accepted", workspace.MapPostWorkspaceTransferAcceptHttp, nopAcceptTransferHandler, marshal.DefaultMarshalerProvider, func() http.ResponseWriter {
+				expectWorkspaceWritten()
+				return fake
+			}),
+		)
+
+		It("should map the workspace from the path", func() {
+			// when
+			c, err := workspace.MapPostWorkspaceTransferAcceptHttp(request)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*c).To(Equal(coreworkspace.AcceptWorkspaceOwnershipTransferCommand{
+				Owner:     "bar",
+				Workspace: "foo",
+			}))
+		})
+	})
+})
+
+func badProposeTransferHandler(ctx context.Context, cmd coreworkspace.ProposeWorkspaceOwnershipTransferCommand) (*coreworkspace.ProposeWorkspaceOwnershipTransferResponse, error) {
+	return nil, fmt.Errorf("bad propose transfer handler")
+}
+
+func forbiddenProposeTransferHandler(ctx context.Context, cmd coreworkspace.ProposeWorkspaceOwnershipTransferCommand) (*coreworkspace.ProposeWorkspaceOwnershipTransferResponse, error) {
+	return nil, kerrors.NewForbidden(restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource(), cmd.Workspace, fmt.Errorf("the home workspace can not be transferred"))
+}
+
+func nopProposeTransferHandler(_ context.Context, cmd coreworkspace.ProposeWorkspaceOwnershipTransferCommand) (*coreworkspace.ProposeWorkspaceOwnershipTransferResponse, error) {
+	w := restworkspacesv1alpha1.Workspace{}
+	w.SetName(cmd.Workspace)
+	w.SetNamespace(cmd.Owner)
+	w.Spec.OwnershipTransfer = &restworkspacesv1alpha1.WorkspaceOwnershipTransfer{NewOwner: cmd.NewOwner}
+	return &coreworkspace.ProposeWorkspaceOwnershipTransferResponse{
+		Workspace: &w,
+	}, nil
+}
+
+func notFoundCancelTransferHandler(ctx context.Context, cmd coreworkspace.CancelWorkspaceOwnershipTransferCommand) (*coreworkspace.CancelWorkspaceOwnershipTransferResponse, error) {
+	return nil, kerrors.NewNotFound(restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource(), cmd.Workspace)
+}
+
+func nopCancelTransferHandler(_ context.Context, cmd coreworkspace.CancelWorkspaceOwnershipTransferCommand) (*coreworkspace.CancelWorkspaceOwnershipTransferResponse, error) {
+	w := restworkspacesv1alpha1.Workspace{}
+	w.SetName(cmd.Workspace)
+	w.SetNamespace(cmd.Owner)
+	return &coreworkspace.CancelWorkspaceOwnershipTransferResponse{
+		Workspace: &w,
+	}, nil
+}
+
+func alreadyExistsAcceptTransferHandler(ctx context.Context, cmd coreworkspace.AcceptWorkspaceOwnershipTransferCommand) (*coreworkspace.AcceptWorkspaceOwnershipTransferResponse, error) {
+	return nil, kerrors.NewAlreadyExists(restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource(), cmd.Workspace)
+}
+
+func nopAcceptTransferHandler(_ context.Context, cmd coreworkspace.AcceptWorkspaceOwnershipTransferCommand) (*coreworkspace.AcceptWorkspaceOwnershipTransferResponse, error) {
+	w := restworkspacesv1alpha1.Workspace{}
+	w.SetName(cmd.Workspace)
+	w.SetNamespace("new-owner")
+	return &coreworkspace.AcceptWorkspaceOwnershipTransferResponse{
+		Workspace: &w,
+	}, nil
+}
+
+func buildTransferRequest(method, namespace, name, subpath string, wt *restworkspacesv1alpha1.WorkspaceOwnershipTransfer) *http.Request {
+	var body io.Reader
+	if wt != nil {
+		byteSlice, err := marshal.DefaultMarshal.Marshal(wt)
+		Expect(err).NotTo(HaveOccurred())
+		body = bytes.NewReader(byteSlice)
+	}
+
+	url := fmt.Sprintf("/apis/workspaces.io/v1alpha1/namespaces/%s/workspaces/%s/transfer%s", namespace, name, subpath)
+
+	request, err := http.NewRequest(method, url, body)
+	Expect(err).NotTo(HaveOccurred())
+	if wt != nil {
+		request.Header.Add("Content-Type", marshal.DefaultUnmarshal.ContentType())
+	}
+	request.Header.Add("Accept", marshal.DefaultMarshal.ContentType())
+	request.SetPathValue("namespace", namespace)
+	request.SetPathValue("name", name)
+	return request
+}
@@ -2,16 +2,15 @@ package workspace
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
 
-	"github.com/konflux-workspaces/workspaces/server/core"
 	"github.com/konflux-workspaces/workspaces/server/core/workspace"
 	"github.com/konflux-workspaces/workspaces/server/log"
 	"github.com/konflux-workspaces/workspaces/server/rest/header"
 	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+	"github.com/konflux-workspaces/workspaces/server/rest/status"
 
 	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
 )
@@ -71,7 +70,7 @@ func (h *UpdateWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	m, err := h.MarshalerProvider(r)
 	if err != nil {
 		l.Debug("error building marshaler for request", "error", err)
-		w.WriteHeader(http.StatusBadRequest)
+		status.WriteBadRequest(w, err)
 		return
 	}
 
@@ -80,7 +79,7 @@ func (h *UpdateWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	c, err := h.MapperFunc(r, h.UnmarshalerProvider)
 	if err != nil {
 		l.Debug("error mapping request to command", "error", err)
-		w.WriteHeader(http.StatusBadRequest)
+		status.WriteBadRequest(w, err)
 		return
 	}
 
@@ -88,15 +87,9 @@ func (h *UpdateWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	l.Debug("executing update command", "command", c)
 	cr, err := h.CommandHandler(r.Context(), *c)
 	if err != nil {
-		l = l.With("error", err)
-		switch {
-		case errors.Is(err, core.ErrNotFound):
-			l.Debug("error executing update command: resource not found")
-			w.WriteHeader(http.StatusNotFound)
-		default:
-			l.Error("error executing update command")
-			w.WriteHeader(http.StatusInternalServerError)
-		}
+		err = asPreconditionFailed(r, err)
+		logHandlerError(l, "error executing update command", err)
+		status.WriteError(w, err)
 		return
 	}
 
@@ -105,13 +98,14 @@ func (h *UpdateWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	d, err := m.Marshal(cr.Workspace)
 	if err != nil {
 		l.Error("unexpected error marshaling response", "error", err)
-		w.WriteHeader(http.StatusInternalServerError)
+		status.WriteError(w, err)
 		return
 	}
 
 	// reply
 	l.Debug("writing response", "response", d)
 	w.Header().Add(header.ContentType, m.ContentType())
+	setETag(w, cr.Workspace)
 	if _, err := w.Write(d); err != nil {
 		l.Error("unexpected error writing response", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -145,9 +139,33 @@ func MapPutWorkspaceHttp(r *http.Request, provider marshal.UnmarshalerProvider)
 	w.SetName(n)
 	w.SetNamespace(ns)
 
+	// apply the If-Match precondition
+	rv, err := parseIfMatch(r)
+	if err != nil {
+		return nil, err
+	}
+	if rv != "" {
+		if w.ResourceVersion != "" && w.ResourceVersion != rv {
+			return nil, newPreconditionFailed(n, fmt.Sprintf("the resourceVersion %q does not match the If-Match header", w.ResourceVersion))
+		}
+		w.ResourceVersion = rv
+	}
+
+	// unconditional updates must be explicitly requested with `If-Match: *`
+	if w.ResourceVersion == "" && r.Header.Get(header.IfMatch) == "" {
+		return nil, newPreconditionRequired(n)
+	}
+
+	// parse dry run option
+	dr, err := parseDryRun(r)
+	if err != nil {
+		return nil, err
+	}
+
 	// build command
 	return &workspace.UpdateWorkspaceCommand{
 		Workspace: w,
 		Owner:     ns,
+		DryRun:    dr,
 	}, nil
 }
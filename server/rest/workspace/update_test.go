@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/konflux-workspaces/workspaces/server/rest/workspace/mocks"
 
@@ -33,6 +34,7 @@ var _ = Describe("Update tests", func() {
 		w = &restworkspacesv1alpha1.Workspace{}
 		w.Name = "foo"
 		w.Namespace = "bar"
+		w.ResourceVersion = "1"
 
 		request = buildPutRequest(w)
 		fake = mocks.NewMockFakeResponseWriter(ctrl)
@@ -53,38 +55,96 @@ var _ = Describe("Update tests", func() {
 			handler.ServeHTTP(response, request)
 		},
 		Entry("failure in marshal provider", workspace.MapPutWorkspaceHttp, nopUpdateHandler, errorMarshalProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
-			fake.EXPECT().WriteHeader(http.StatusBadRequest)
+			expectStatus(fake, http.StatusBadRequest)
 			return fake
 		}),
 		Entry("failure in unmarshal provider", workspace.MapPutWorkspaceHttp, nopUpdateHandler, marshal.DefaultMarshalerProvider, errorUnmarshalProvider, func() http.ResponseWriter {
-			fake.EXPECT().WriteHeader(http.StatusBadRequest)
+			expectStatus(fake, http.StatusBadRequest)
 			return fake
 		}),
 		Entry("no body sent in request", workspace.MapPutWorkspaceHttp, nopUpdateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
 			request.Body = io.NopCloser(bytes.NewReader([]byte{}))
-			fake.EXPECT().WriteHeader(http.StatusBadRequest)
+			expectStatus(fake, http.StatusBadRequest)
 			return fake
 		}),
 		Entry("failure unmarshaling request", workspace.MapPutWorkspaceHttp, nopUpdateHandler, marshal.DefaultMarshalerProvider, badUnmarshalProvider, func() http.ResponseWriter {
-			fake.EXPECT().WriteHeader(http.StatusBadRequest)
+			expectStatus(fake, http.StatusBadRequest)
+			return fake
+		}),
+		Entry("invalid dryRun", workspace.MapPutWorkspaceHttp, nopUpdateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			request.URL.RawQuery = "dryRun=true"
+			expectStatus(fake, http.StatusBadRequest)
+			return fake
+		}),
+		Entry("invalid If-Match", workspace.MapPutWorkspaceHttp, nopUpdateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			request.Header.Set("If-Match", `W/"1"`)
+			expectStatus(fake, http.StatusBadRequest)
+			return fake
+		}),
+		Entry("If-Match not matching the body", workspace.MapPutWorkspaceHttp, nopUpdateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			w.ResourceVersion = "1"
+			request = buildPutRequest(w)
+			request.Header.Set("If-Match", `"2"`)
+			expectStatus(fake, http.StatusPreconditionFailed)
+			return fake
+		}),
+		Entry("conflict in update handler", workspace.MapPutWorkspaceHttp, conflictUpdateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			expectStatus(fake, http.StatusConflict)
+			return fake
+		}),
+		Entry("conflict in update handler with If-Match", workspace.MapPutWorkspaceHttp, conflictUpdateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			request.Header.Set("If-Match", `"1"`)
+			expectStatus(fake, http.StatusPreconditionFailed)
+			return fake
+		}),
+		Entry("neither resourceVersion nor If-Match", workspace.MapPutWorkspaceHttp, nopUpdateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			w.ResourceVersion = ""
+			request = buildPutRequest(w)
+			expectStatus(fake, http.StatusPreconditionRequired)
+			return fake
+		}),
+		Entry("workspace updated unconditionally with If-Match *", workspace.MapPutWorkspaceHttp, nopUpdateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			w.ResourceVersion = ""
+			request = buildPutRequest(w)
+			request.Header.Set("If-Match", "*")
+			fake.EXPECT().Header().Return(http.Header{})
+			fake.EXPECT().Write(gomock.Any()).DoAndReturn(func(a any) (int, error) {
+				d, ok := a.([]byte)
+				Expect(ok).To(BeTrue())
+				return len(d), nil
+			})
+			return fake
+		}),
+		Entry("workspace updated with If-Match", workspace.MapPutWorkspaceHttp, nopUpdateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
+			w.ResourceVersion = ""
+			request = buildPutRequest(w)
+			request.Header.Set("If-Match", `"3"`)
+			h := http.Header{}
+			fake.EXPECT().Header().Return(h).Times(2)
+			fake.EXPECT().Write(gomock.Any()).DoAndReturn(func(a any) (int, error) {
+				d, ok := a.([]byte)
+				Expect(ok).To(BeTrue())
+				Expect(h.Get("ETag")).To(Equal(`"3"`))
+				return len(d), nil
+			})
 			return fake
 		}),
 		Entry("failure in update handler", workspace.MapPutWorkspaceHttp, badUpdateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
-			fake.EXPECT().WriteHeader(http.StatusInternalServerError)
+			expectStatus(fake, http.StatusInternalServerError)
 			return fake
 		}),
 		Entry("failure marshaling response", workspace.MapPutWorkspaceHttp, nopUpdateHandler, badMarshalProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
-			fake.EXPECT().WriteHeader(http.StatusInternalServerError)
+			expectStatus(fake, http.StatusInternalServerError)
 			return fake
 		}),
 		Entry("failure to write response", workspace.MapPutWorkspaceHttp, nopUpdateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
-			fake.EXPECT().Header().Return(http.Header{})
+			fake.EXPECT().Header().Return(http.Header{}).Times(2)
 			fake.EXPECT().Write(gomock.Any()).Return(0, fmt.Errorf("failed to write response body"))
 			fake.EXPECT().WriteHeader(http.StatusInternalServerError)
 			return fake
 		}),
 		Entry("failure to write response", workspace.MapPutWorkspaceHttp, nopUpdateHandler, marshal.DefaultMarshalerProvider, marshal.DefaultUnmarshalerProvider, func() http.ResponseWriter {
-			fake.EXPECT().Header().Return(http.Header{})
+			fake.EXPECT().Header().Return(http.Header{}).Times(2)
 			fake.EXPECT().Write(gomock.Any()).DoAndReturn(func(a any) (int, error) {
 				slice, ok := a.([]byte)
 				Expect(ok).To(BeTrue())
@@ -99,6 +159,10 @@ func badUpdateHandler(ctx context.Context, cmd coreworkspace.UpdateWorkspaceComm
 	return nil, fmt.Errorf("bad update handler")
 }
 
+func conflictUpdateHandler(ctx context.Context, cmd coreworkspace.UpdateWorkspaceCommand) (*coreworkspace.UpdateWorkspaceResponse, error) {
+	return nil, kerrors.NewConflict(restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource(), cmd.Workspace.Name, fmt.Errorf("conflict"))
+}
+
 func nopUpdateHandler(_ctx context.Context, cmd coreworkspace.UpdateWorkspaceCommand) (*coreworkspace.UpdateWorkspaceResponse, error) {
 	return &coreworkspace.UpdateWorkspaceResponse{
 		Workspace: &cmd.Workspace,
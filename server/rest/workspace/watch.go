@@ -0,0 +1,164 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/konflux-workspaces/workspaces/server/core/workspace"
+	"github.com/konflux-workspaces/workspaces/server/log"
+	"github.com/konflux-workspaces/workspaces/server/rest/header"
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+	"github.com/konflux-workspaces/workspaces/server/rest/status"
+)
+
+var (
+	_ http.Handler = &WatchWorkspaceHandler{}
+
+	_ WatchWorkspaceMapperFunc = MapWatchWorkspaceHttp
+)
+
+// handler dependencies
+type WatchWorkspaceMapperFunc func(*http.Request) (*workspace.WatchWorkspaceQuery, error)
+type WatchWorkspaceQueryHandlerFunc func(context.Context, workspace.WatchWorkspaceQuery) (*workspace.WatchWorkspaceResponse, error)
+
+// WatchWorkspaceHandler the http.Request handler for Watch Workspaces endpoint
+type WatchWorkspaceHandler struct {
+	MapperFunc   WatchWorkspaceMapperFunc
+	QueryHandler WatchWorkspaceQueryHandlerFunc
+
+	MarshalerProvider marshal.MarshalerProvider
+}
+
+// NewDefaultWatchWorkspaceHandler creates a WatchWorkspaceHandler
+func NewDefaultWatchWorkspaceHandler(
+	handler WatchWorkspaceQueryHandlerFunc,
+) *WatchWorkspaceHandler {
+	return NewWatchWorkspaceHandler(
+		MapWatchWorkspaceHttp,
+		handler,
+		marshal.StreamMarshalerProvider,
+	)
+}
+
+// NewWatchWorkspaceHandler creates a WatchWorkspaceHandler
+func NewWatchWorkspaceHandler(
+	mapperFunc WatchWorkspaceMapperFunc,
+	queryHandler WatchWorkspaceQueryHandlerFunc,
+	marshalerProvider marshal.MarshalerProvider,
+) *WatchWorkspaceHandler {
+	return &WatchWorkspaceHandler{
+		MapperFunc:        mapperFunc,
+		QueryHandler:      queryHandler,
+		MarshalerProvider: marshalerProvider,
+	}
+}
+
+func (h *WatchWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l := log.FromContext(r.Context())
+	l.Debug("executing watch")
+
+	// events are streamed to the client, so the response needs to be flushed
+	f, ok := w.(http.Flusher)
+	if !ok {
+		l.Error("response writer does not support streaming")
+		status.WriteError(w, fmt.Errorf("response writer does not support streaming"))
+		return
+	}
+
+	// build marshaler for the given request
+	l.Debug("building marshaler for request")
+	m, err := h.MarshalerProvider(r)
+	if err != nil {
+		l.Debug("error building marshaler for request", "error", err)
+		status.WriteBadRequest(w, err)
+		return
+	}
+
+	// map to query
+	l.Debug("mapping request to watch query")
+	q, err := h.MapperFunc(r)
+	if err != nil {
+		l.Debug("error mapping request to query", "error", err)
+		status.WriteBadRequest(w, err)
+		return
+	}
+
+	// execute
+	l.Debug("executing watch query", "query", q)
+	qr, err := h.QueryHandler(r.Context(), *q)
+	if err != nil {
+		logHandlerError(l, "error executing watch query", err, "query", q)
+		status.WriteError(w, err)
+		return
+	}
+	defer qr.Watcher.Stop()
+
+	// reply
+	w.Header().Add(header.ContentType, m.ContentType())
+	w.WriteHeader(http.StatusOK)
+	f.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			l.Debug("request done, stop watching")
+			return
+		case e, ok := <-qr.Watcher.ResultChan():
+			if !ok {
+				l.Debug("watch closed")
+				return
+			}
+
+			// marshal event
+			o, err := m.Marshal(e.Object)
+			if err != nil {
+				l.Error("error marshaling watched object", "error", err)
+				return
+			}
+			d, err := m.Marshal(metav1.WatchEvent{Type: string(e.Type), Object: runtime.RawExtension{Raw: o}})
+			if err != nil {
+				l.Error("error marshaling watch event", "error", err)
+				return
+			}
+
+			// send event
+			l.Debug("writing watch event", "type", e.Type)
+			if _, err := w.Write(append(d, '\n')); err != nil {
+				l.Error("error writing watch event", "error", err)
+				return
+			}
+			f.Flush()
+		}
+	}
+}
+
+// MapWatchWorkspaceHttp maps the namespace path value and the resourceVersion, labelSelector, fieldSelector, and timeoutSeconds query parameters to a WatchWorkspaceQuery
+func MapWatchWorkspaceHttp(r *http.Request) (*workspace.WatchWorkspaceQuery, error) {
+	q := workspace.WatchWorkspaceQuery{
+		Namespace:       r.PathValue("namespace"),
+		ResourceVersion: r.URL.Query().Get("resourceVersion"),
+	}
+
+	// selectors
+	ls, fs, err := mapSelectors(r)
+	if err != nil {
+		return nil, err
+	}
+	q.LabelSelector, q.FieldSelector = ls, fs
+
+	// timeout
+	if t := r.URL.Query().Get("timeoutSeconds"); t != "" {
+		timeout, err := strconv.ParseInt(t, 10, 64)
+		if err != nil || timeout < 0 {
+			return nil, fmt.Errorf("invalid timeoutSeconds %q: must be a non-negative integer", t)
+		}
+		q.TimeoutSeconds = &timeout
+	}
+
+	return &q, nil
+}
@@ -0,0 +1,204 @@
+package workspace_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/konflux-workspaces/workspaces/server/rest/workspace/mocks"
+
+	coreworkspace "github.com/konflux-workspaces/workspaces/server/core/workspace"
+	"github.com/konflux-workspaces/workspaces/server/rest/marshal"
+	"github.com/konflux-workspaces/workspaces/server/rest/workspace"
+
+	restworkspacesv1alpha1 "github.com/konflux-workspaces/workspaces/server/api/v1alpha1"
+)
+
+var _ = Describe("Watch", func() {
+	var (
+		ctrl    *gomock.Controller
+		request *http.Request
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		request = buildWatchRequest("bar", "42")
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	It("fails if the response writer does not support streaming", func() {
+		// given
+		fake := mocks.NewMockFakeResponseWriter(ctrl)
+		expectStatus(fake, http.StatusInternalServerError)
+		handler := workspace.NewDefaultWatchWorkspaceHandler(eventsWatchHandler())
+
+		// when
+		handler.ServeHTTP(fake, request)
+	})
+
+	DescribeTable("workspace GET handler: watch failures",
+		func(
+			watchHandler workspace.WatchWorkspaceQueryHandlerFunc,
+			marshaler marshal.MarshalerProvider,
+			expectedStatusCode int,
+		) {
+			// given
+			response := httptest.NewRecorder()
+			handler := workspace.NewWatchWorkspaceHandler(workspace.MapWatchWorkspaceHttp, watchHandler, marshaler)
+
+			// when
+			handler.ServeHTTP(response, request)
+
+			// then
+			Expect(response.Code).To(Equal(expectedStatusCode))
+			s := metav1.Status{}
+			Expect(json.Unmarshal(response.Body.Bytes(), &s)).To(Succeed())
+			Expect(s.Code).To(BeEquivalentTo(expectedStatusCode))
+		},
+		Entry("failure in marshal provider", eventsWatchHandler(), errorMarshalProvider, http.StatusBadRequest),
+		Entry("failure in watch handler", badWatchHandler, marshal.DefaultMarshalerProvider, http.StatusInternalServerError),
+		Entry("invalid watch request", badRequestWatchHandler, marshal.DefaultMarshalerProvider, http.StatusBadRequest),
+		Entry("watch not supported", notSupportedWatchHandler, marshal.DefaultMarshalerProvider, http.StatusMethodNotAllowed),
+	)
+
+	It("streams the events", func() {
+		// given
+		ws := restworkspacesv1alpha1.Workspace{}
+		ws.Name = "foo"
+		ws.Namespace = "bar"
+		response := httptest.NewRecorder()
+		handler := workspace.NewDefaultWatchWorkspaceHandler(eventsWatchHandler(
+			watch.Event{Type: watch.Added, Object: ws.DeepCopy()},
+			watch.Event{Type: watch.Modified, Object: ws.DeepCopy()},
+			watch.Event{Type: watch.Deleted, Object: ws.DeepCopy()},
+		))
+
+		// when
+		handler.ServeHTTP(response, request)
+
+		// then
+		Expect(response.Code).To(Equal(http.StatusOK))
+		Expect(response.Header().Get("Content-Type")).To(Equal(marshal.ContentTypeJson))
+
+		types := []string{}
+		s := bufio.NewScanner(response.Body)
+		for s.Scan() {
+			e := metav1.WatchEvent{}
+			Expect(json.Unmarshal(s.Bytes(), &e)).To(Succeed())
+			rws := restworkspacesv1alpha1.Workspace{}
+			Expect(json.Unmarshal(e.Object.Raw, &rws)).To(Succeed())
+			Expect(rws).To(Equal(ws))
+			types = append(types, e.Type)
+		}
+		Expect(types).To(Equal([]string{"ADDED", "MODIFIED", "DELETED"}))
+	})
+
+	It("stops streaming when the request is done", func() {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		response := httptest.NewRecorder()
+		fw := watch.NewFake()
+		handler := workspace.NewDefaultWatchWorkspaceHandler(func(context.Context, coreworkspace.WatchWorkspaceQuery) (*coreworkspace.WatchWorkspaceResponse, error) {
+			return &coreworkspace.WatchWorkspaceResponse{Watcher: fw}, nil
+		})
+
+		// when
+		cancel()
+		handler.ServeHTTP(response, request.WithContext(ctx))
+
+		// then
+		Expect(response.Code).To(Equal(http.StatusOK))
+		Expect(fw.IsStopped()).To(BeTrue())
+	})
+
+	It("maps the request to the query", func() {
+		// when
+		q, err := workspace.MapWatchWorkspaceHttp(request)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*q).To(Equal(coreworkspace.WatchWorkspaceQuery{Namespace: "bar", ResourceVersion: "42"}))
+	})
+
+	It("maps the selectors and the timeout to the query", func() {
+		// given
+		q := request.URL.Query()
+		q.Set("labelSelector", "team=platform")
+		q.Set("fieldSelector", "spec.visibility=private")
+		q.Set("timeoutSeconds", "30")
+		request.URL.RawQuery = q.Encode()
+
+		// when
+		wq, err := workspace.MapWatchWorkspaceHttp(request)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wq.LabelSelector.String()).To(Equal("team=platform"))
+		Expect(wq.FieldSelector.String()).To(Equal("spec.visibility=private"))
+		Expect(wq.TimeoutSeconds).To(HaveValue(BeEquivalentTo(30)))
+	})
+
+	DescribeTable("refuses invalid query parameters",
+		func(parameter, value string) {
+			// given
+			q := request.URL.Query()
+			q.Set(parameter, value)
+			request.URL.RawQuery = q.Encode()
+
+			// when
+			_, err := workspace.MapWatchWorkspaceHttp(request)
+
+			// then
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("label selector", "labelSelector", "team in (platform"),
+		Entry("field selector", "fieldSelector", "spec.visibility"),
+		Entry("non numeric timeout", "timeoutSeconds", "forever"),
+		Entry("negative timeout", "timeoutSeconds", "-1"),
+	)
+})
+
+// eventsWatchHandler returns a handler streaming the provided events and closing the watch afterwards
+func eventsWatchHandler(events ...watch.Event) workspace.WatchWorkspaceQueryHandlerFunc {
+	return func(context.Context, coreworkspace.WatchWorkspaceQuery) (*coreworkspace.WatchWorkspaceResponse, error) {
+		fw := watch.NewFakeWithChanSize(len(events), false)
+		for _, e := range events {
+			fw.Action(e.Type, e.Object)
+		}
+		fw.Stop()
+		return &coreworkspace.WatchWorkspaceResponse{Watcher: fw}, nil
+	}
+}
+
+func badWatchHandler(context.Context, coreworkspace.WatchWorkspaceQuery) (*coreworkspace.WatchWorkspaceResponse, error) {
+	return nil, fmt.Errorf("bad watch handler")
+}
+
+func badRequestWatchHandler(context.Context, coreworkspace.WatchWorkspaceQuery) (*coreworkspace.WatchWorkspaceResponse, error) {
+	return nil, kerrors.NewBadRequest("invalid resource version")
+}
+
+func notSupportedWatchHandler(context.Context, coreworkspace.WatchWorkspaceQuery) (*coreworkspace.WatchWorkspaceResponse, error) {
+	return nil, kerrors.NewMethodNotSupported(restworkspacesv1alpha1.GroupVersion.WithResource("workspaces").GroupResource(), "watch")
+}
+
+func buildWatchRequest(namespace, resourceVersion string) *http.Request {
+	url := fmt.Sprintf("/apis/workspaces.io/v1alpha1/namespaces/%s/workspaces?watch=true&resourceVersion=%s", namespace, resourceVersion)
+
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	Expect(err).NotTo(HaveOccurred())
+	request.Header.Add("Accept", marshal.DefaultMarshal.ContentType())
+	request.SetPathValue("namespace", namespace)
+	return request
+}
@@ -0,0 +1,95 @@
+// Package telemetry configures the OpenTelemetry tracing of the REST API server
+package telemetry
+
+import (
+	"context"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the tracer used by the server
+const TracerName = "github.com/konflux-workspaces/workspaces/server"
+
+// ServiceName is the name the server reports its traces with
+const ServiceName = "workspaces-rest-api"
+
+// Environment Variables enabling the OTLP exporter, as defined by the OpenTelemetry specification
+const (
+	EnvOtlpEndpoint       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	EnvOtlpTracesEndpoint = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+)
+
+// Enabled returns true if an OTLP endpoint is configured via Environment Variables
+func Enabled() bool {
+	return os.Getenv(EnvOtlpEndpoint) != "" || os.Getenv(EnvOtlpTracesEndpoint) != ""
+}
+
+// NewOtlpTracerProvider builds a TracerProvider exporting the spans via OTLP over HTTP.
+// The exporter is configured with the OTEL_EXPORTER_OTLP_* Environment Variables.
+func NewOtlpTracerProvider(ctx context.Context) (*sdktrace.TracerProvider, error) {
+	exp, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewTracerProvider(sdktrace.WithBatcher(exp)), nil
+}
+
+// NewTracerProvider builds a TracerProvider identifying the server as the
+// service the spans are produced by
+func NewTracerProvider(opts ...sdktrace.TracerProviderOption) *sdktrace.TracerProvider {
+	r := resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(ServiceName))
+	return sdktrace.NewTracerProvider(append([]sdktrace.TracerProviderOption{sdktrace.WithResource(r)}, opts...)...)
+}
+
+// SetTracerProvider sets the global TracerProvider and
+// propagates the trace context via the W3C headers
+func SetTracerProvider(tp trace.TracerProvider) {
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+}
+
+// Tracer returns the server's tracer from the global TracerProvider
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// StartSpan starts a span with the server's tracer.
+// If tracing is not enabled, the span is a no-op and the context is returned unchanged.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	sctx, span := Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+	if !span.SpanContext().IsValid() {
+		return ctx, span
+	}
+	return sctx, span
+}
+
+// EndSpan records the error, if any, and ends the span
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Traced wraps the core handler with a span with the given name
+func Traced[Q any, R any](name string, handle func(context.Context, Q) (*R, error)) func(context.Context, Q) (*R, error) {
+	return func(ctx context.Context, q Q) (*R, error) {
+		ctx, span := StartSpan(ctx, name)
+		r, err := handle(ctx, q)
+		EndSpan(span, err)
+		return r, err
+	}
+}
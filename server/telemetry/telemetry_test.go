@@ -0,0 +1,77 @@
+package telemetry_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+
+	"github.com/konflux-workspaces/workspaces/server/telemetry"
+)
+
+type query struct{ Name string }
+type response struct{ Name string }
+
+var _ = Describe("Traced", func() {
+	var exporter *tracetest.InMemoryExporter
+	var tracerProvider *sdktrace.TracerProvider
+
+	BeforeEach(func() {
+		exporter = tracetest.NewInMemoryExporter()
+		tracerProvider = telemetry.NewTracerProvider(sdktrace.WithSyncer(exporter))
+		telemetry.SetTracerProvider(tracerProvider)
+	})
+
+	AfterEach(func() {
+		Expect(tracerProvider.Shutdown(context.Background())).To(Succeed())
+	})
+
+	It("wraps the handler in a span", func() {
+		// given
+		h := telemetry.Traced("ReadSomething", func(ctx context.Context, q query) (*response, error) {
+			_, span := telemetry.StartSpan(ctx, "ReadClient.ReadSomething")
+			span.End()
+			return &response{Name: q.Name}, nil
+		})
+
+		// when
+		r, err := h(context.Background(), query{Name: "my-name"})
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r).To(Equal(&response{Name: "my-name"}))
+
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(2))
+		persistence, handler := spans[0], spans[1]
+		Expect(handler.Name).To(Equal("ReadSomething"))
+		Expect(handler.Status.Code).To(Equal(codes.Unset))
+		Expect(handler.Resource.Attributes()).To(ContainElement(semconv.ServiceName(telemetry.ServiceName)))
+		Expect(persistence.Name).To(Equal("ReadClient.ReadSomething"))
+		Expect(persistence.Parent.SpanID()).To(Equal(handler.SpanContext.SpanID()))
+	})
+
+	It("records the error returned by the handler", func() {
+		// given
+		h := telemetry.Traced("ReadSomething", func(ctx context.Context, q query) (*response, error) {
+			return nil, errors.New("my-error")
+		})
+
+		// when
+		_, err := h(context.Background(), query{})
+
+		// then
+		Expect(err).To(MatchError("my-error"))
+
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Status.Code).To(Equal(codes.Error))
+		Expect(spans[0].Status.Description).To(Equal("my-error"))
+		Expect(spans[0].Events).To(ContainElement(HaveField("Name", "exception")))
+	})
+})